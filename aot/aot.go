@@ -0,0 +1,162 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Package aot provides ahead-of-time generated Thrift Binary codecs.
+//
+// Generate writes Go source for the encoders and decoders frugal would otherwise JIT-compile at runtime,
+// and the generated file registers them with this package when imported. Each generated function carries
+// the fingerprint of the program it was generated from, and is only used when the program compiled at
+// runtime still matches it, so a stale file falls back to the JIT instead of producing wrong results.
+//
+// The generated code relies on the runtime support in this package, which is not covered by any compatibility
+// guarantees, the file must be regenerated after upgrading frugal.
+package aot
+
+import (
+    `log`
+    `math`
+    `reflect`
+    `sync`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+type (
+    Type        = rt.GoType
+    DecodeState = decoder.RuntimeState
+    EncodeState = encoder.RuntimeState
+)
+
+// An Entry is a generated encoder or decoder of a type.
+type Entry struct {
+    Type   *Type
+    Hash   uint64
+    Encode encoder.Encoder
+    Decode decoder.Decoder
+}
+
+var (
+    loadLock sync.Mutex
+    loadList []func() []Entry
+)
+
+func init() {
+    decoder.SetGeneratedLoader(load)
+    encoder.SetGeneratedLoader(load)
+}
+
+// Register adds a loader of generated entries, it is called by the init function of generated files.
+// Loaders are invoked lazily before the first type is compiled, after all the packages are initialized,
+// so that codecs registered with frugal.RegisterTypeCodec are visible to them.
+func Register(fn func() []Entry) {
+    loadLock.Lock()
+    loadList = append(loadList, fn)
+    loadLock.Unlock()
+}
+
+func load() {
+    loadLock.Lock()
+    defer loadLock.Unlock()
+
+    /* run all the pending loaders */
+    for len(loadList) != 0 {
+        fn := loadList[0]
+        loadList = loadList[1:]
+        loadEntries(fn)
+    }
+}
+
+func loadEntries(fn func() []Entry) {
+    defer func() {
+        if v := recover(); v != nil {
+            log.Printf("frugal: cannot load generated code, falling back to JIT: %v", v)
+        }
+    }()
+
+    /* register every entry */
+    for _, v := range fn() {
+        if v.Encode != nil { encoder.RegisterGenerated(v.Type, v.Hash, v.Encode) }
+        if v.Decode != nil { decoder.RegisterGenerated(v.Type, v.Hash, v.Decode) }
+    }
+}
+
+// TypeOf returns the runtime type handle of t.
+func TypeOf(t reflect.Type) *Type {
+    return rt.UnpackType(t)
+}
+
+// WireOf returns the wire type of t registered with frugal.RegisterTypeCodec.
+func WireOf(t reflect.Type) reflect.Type {
+    if cc := defs.LookupCodec(t); cc == nil {
+        panic("aot: no codec registered for " + t.String())
+    } else {
+        return cc.Wire
+    }
+}
+
+// InitializerOf returns the default value initializer of struct t.
+func InitializerOf(t reflect.Type) (fn func(unsafe.Pointer)) {
+    if fp, err := defs.GetDefaultInitializer(t); err != nil {
+        panic(err)
+    } else if fp == nil {
+        panic("aot: no default initializer for " + t.String())
+    } else {
+        *(*unsafe.Pointer)(unsafe.Pointer(&fn)) = unsafe.Pointer(&fp)
+        return
+    }
+}
+
+// MapLen returns the number of elements of the map pointed by p.
+func MapLen(p unsafe.Pointer) int {
+    if m := *(**rt.GoMap)(p); m == nil {
+        return 0
+    } else {
+        return m.Count
+    }
+}
+
+// Widen loads a float32 from p and returns the bits of it as a float64.
+func Widen(p unsafe.Pointer) uint64 {
+    return math.Float64bits(float64(*(*float32)(p)))
+}
+
+// Store8 stores v at byte i of p.
+func Store8(p unsafe.Pointer, i int, v uint8) {
+    *(*uint8)(unsafe.Pointer(uintptr(p) + uintptr(i))) = v
+}
+
+// Store16 stores v at byte i of p in big-endian.
+func Store16(p unsafe.Pointer, i int, v uint16) {
+    b := (*[2]byte)(unsafe.Pointer(uintptr(p) + uintptr(i)))
+    b[0], b[1] = byte(v >> 8), byte(v)
+}
+
+// Store32 stores v at byte i of p in big-endian.
+func Store32(p unsafe.Pointer, i int, v uint32) {
+    b := (*[4]byte)(unsafe.Pointer(uintptr(p) + uintptr(i)))
+    b[0], b[1], b[2], b[3] = byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)
+}
+
+// Store64 stores v at byte i of p in big-endian.
+func Store64(p unsafe.Pointer, i int, v uint64) {
+    Store32(p, i, uint32(v >> 32))
+    Store32(p, i + 4, uint32(v))
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package aot
+
+import (
+    `bytes`
+    `reflect`
+    `strings`
+    `testing`
+    `unsafe`
+
+    `github.com/stretchr/testify/require`
+)
+
+type GenerateTestStruct struct {
+    A int64               `frugal:"1,default,i64"`
+    B []string            `frugal:"2,default,list<string>"`
+    C *GenerateTestStruct `frugal:"3,optional,GenerateTestStruct"`
+}
+
+func TestStore(t *testing.T) {
+    buf := make([]byte, 15)
+    Store8(unsafe.Pointer(&buf[0]), 0, 0x01)
+    Store16(unsafe.Pointer(&buf[0]), 1, 0x0203)
+    Store32(unsafe.Pointer(&buf[0]), 3, 0x04050607)
+    Store64(unsafe.Pointer(&buf[0]), 7, 0x08090a0b0c0d0e0f)
+    require.Equal(t, []byte { 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15 }, buf)
+}
+
+func TestGenerate(t *testing.T) {
+    var buf bytes.Buffer
+    require.NoError(t, Generate(&buf, "github.com/cloudwego/frugal/aot", "aot", reflect.TypeOf(GenerateTestStruct{})))
+    src := buf.String()
+    require.True(t, strings.HasPrefix(src, _AOT_header))
+    require.Contains(t, src, "reflect.TypeOf((*GenerateTestStruct)(nil))")
+    require.Contains(t, src, "func _frugal_dec_0(")
+    require.Contains(t, src, "func _frugal_enc_0(")
+}
+
+func TestGenerateInvalidTypes(t *testing.T) {
+    var buf bytes.Buffer
+    require.Error(t, Generate(&buf, "main", "main", reflect.TypeOf(0)))
+    require.Error(t, Generate(&buf, "main", "main", reflect.TypeOf(struct { A int `frugal:"1,default,i64"` } {})))
+    require.Error(t, Generate(&buf, "main", "main", reflect.TypeOf(generateTestPrivate{})))
+    require.Zero(t, buf.Len())
+}
+
+type generateTestPrivate struct {
+    A int64 `frugal:"1,default,i64"`
+}
@@ -0,0 +1,511 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package aot
+
+import (
+    `bytes`
+    `fmt`
+    `sort`
+    `strings`
+    `unicode`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+const (
+    _PtrSize = int64(unsafe.Sizeof(uintptr(0)))
+)
+
+type block struct {
+    buf  bytes.Buffer
+    ret  string
+    vars map[string]string
+}
+
+func newBlock(ret string) *block {
+    return &block {
+        ret  : ret,
+        vars : make(map[string]string),
+    }
+}
+
+func (self *block) use(name string, vt string) {
+    self.vars[name] = vt
+}
+
+func (self *block) line(format string, args ...interface{}) {
+    _, _ = fmt.Fprintf(&self.buf, format, args...)
+    self.buf.WriteByte('\n')
+}
+
+func (self *block) label(i int) {
+    fmt.Fprintf(&self.buf, "L_%d:\n", i)
+}
+
+func (self *block) call(lhs string, format string, args ...interface{}) {
+    self.use("err", "error")
+    self.line("if %s = %s; err != nil {", lhs, fmt.Sprintf(format, args...))
+    self.line(self.ret, "err")
+    self.line("}")
+}
+
+func (self *block) fail(format string, args ...interface{}) {
+    self.line(self.ret, fmt.Sprintf(format, args...))
+}
+
+func (self *block) function(w *bytes.Buffer, proto string) {
+    vars := make([]string, 0, len(self.vars))
+    for v := range self.vars {
+        vars = append(vars, v)
+    }
+
+    /* declare all the variables at the beginning, so that goto never jumps over them */
+    sort.Strings(vars)
+    fmt.Fprintf(w, "\n%s {\n", proto)
+
+    /* add the variables */
+    for _, v := range vars {
+        fmt.Fprintf(w, "\tvar %s %s\n", v, self.vars[v])
+    }
+
+    /* add the function body */
+    w.Write(self.buf.Bytes())
+    w.WriteString("}\n")
+}
+
+func isExported(name string) bool {
+    for _, c := range name {
+        return unicode.IsUpper(c)
+    }
+    return false
+}
+
+func offset(p string, off int64) string {
+    if off == 0 {
+        return p
+    } else if off < 0 {
+        return fmt.Sprintf("unsafe.Pointer(uintptr(%s) - %d)", p, -off)
+    } else {
+        return fmt.Sprintf("unsafe.Pointer(uintptr(%s) + %d)", p, off)
+    }
+}
+
+func loadSize(nb int64) string {
+    switch nb {
+        case 1  : return "U8"
+        case 2  : return "U16"
+        case 4  : return "U32"
+        case 8  : return "U64"
+        default : panic("can only load 1, 2, 4 or 8 bytes at a time")
+    }
+}
+
+func uintMax(size uintptr, nb int64) uint64 {
+    nv := uint64(1) << (nb * 8 - 1) - 1
+    ng := ^uint64(0) >> (64 - size * 8)
+
+    /* the value must fit both the wire type and the Go type */
+    if ng < nv {
+        return ng
+    } else {
+        return nv
+    }
+}
+
+func bitmap(ids []int) (ret [decoder.MaxBitmap]int64) {
+    for _, i := range ids {
+        ret[i / 64] |= 1 << (i % 64)
+    }
+    return
+}
+
+func flow(n int, succ func(int) (bool, []int)) (map[int]bool, []bool) {
+    ret := make(map[int]bool)
+    vis := make([]bool, n)
+    todo := []int { 0 }
+
+    /* walk through the program from the entry */
+    for len(todo) != 0 {
+        i := todo[len(todo) - 1]
+        todo = todo[:len(todo) - 1]
+
+        /* check for visited instructions */
+        if vis[i] {
+            continue
+        }
+
+        /* mark as visited, and add all the successors */
+        next, to := succ(i)
+        vis[i] = true
+
+        /* all the branch targets need a label */
+        for _, v := range to {
+            ret[v] = true
+            todo = append(todo, v)
+        }
+
+        /* the next instruction is reachable if this one does not terminate */
+        if next {
+            todo = append(todo, i + 1)
+        }
+    }
+
+    /* instructions not visited are not emitted */
+    return ret, vis
+}
+
+/** Decoder Generator **/
+
+func decoderFlow(p decoder.Program) func(int) (bool, []int) {
+    return func(i int) (bool, []int) {
+        switch v := p[i]; v.Op {
+            case decoder.OP_halt              : return false, nil
+            case decoder.OP_goto              : return false, []int { v.To }
+            case decoder.OP_ctr_is_zero       : return true, []int { v.To }
+            case decoder.OP_struct_is_stop    : return true, []int { v.To }
+            case decoder.OP_struct_check_type : return true, []int { v.To }
+            case decoder.OP_struct_switch     : return true, switchTargets(v.IntSeq())
+            default                           : return true, nil
+        }
+    }
+}
+
+func switchTargets(tab []int) []int {
+    ret := make([]int, 0, len(tab))
+    for _, v := range tab {
+        if v >= 0 {
+            ret = append(ret, v)
+        }
+    }
+    return ret
+}
+
+func (self *generator) decoder(fn *function) {
+    p := newBlock("return ic, %s")
+    tab, vis := flow(len(fn.dp), decoderFlow(fn.dp))
+
+    /* reset the buffer at the beginning */
+    p.line("rs.Reset(buf, nb)")
+
+    /* emit every reachable instruction */
+    for i, v := range fn.dp {
+        if vis[i] {
+            if tab[i] {
+                p.label(i)
+            }
+            self.decode(p, v)
+        }
+    }
+
+    /* add the function */
+    p.function(&self.body, fmt.Sprintf(
+        "func _frugal_dec_%d(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error)",
+        fn.id,
+    ))
+}
+
+func (self *generator) decode(p *block, v decoder.Instr) {
+    switch v.Op {
+        case decoder.OP_int: {
+            p.line("*(*uint%d)(wp) = rs.%s(ic)", v.Iv * 8, loadSize(v.Iv))
+            p.line("ic += %d", v.Iv)
+        }
+        case decoder.OP_str                 : p.call("ic, err", "rs.String(ic, wp, false)")
+        case decoder.OP_str_nocopy          : p.call("ic, err", "rs.String(ic, wp, true)")
+        case decoder.OP_bin                 : p.call("ic, err", "rs.Binary(ic, wp, false)")
+        case decoder.OP_bin_nocopy          : p.call("ic, err", "rs.Binary(ic, wp, true)")
+        case decoder.OP_enum: {
+            p.line("*(*int64)(wp) = int64(int32(rs.U32(ic)))")
+            p.line("ic += 4")
+        }
+        case decoder.OP_uint: {
+            p.use("tr", "uint64")
+            p.line("tr = uint64(rs.%s(ic))", loadSize(v.Iv))
+            p.line("if tr > %d {", uintMax(v.Vt.Size, v.Iv))
+            p.fail("rs.RangeError(%s, tr, %d)", self.handle(v.Vt), v.Iv)
+            p.line("}")
+            p.line("*(*uint%d)(wp) = uint%d(tr)", v.Vt.Size * 8, v.Vt.Size * 8)
+            p.line("ic += %d", v.Iv)
+        }
+        case decoder.OP_float: {
+            p.call("err", "rs.Float(ic, wp)")
+            p.line("ic += 8")
+        }
+        case decoder.OP_fixed               : p.call("ic, err", "rs.Fixed(ic, wp, %s)", self.handle(v.Vt))
+        case decoder.OP_size                : p.call("ic, err", "rs.Check(ic, %d)", v.Iv)
+        case decoder.OP_type: {
+            p.line("if tv := rs.U8(ic); tv != %d {", v.Tx)
+            p.fail("rs.TypeError(%d, tv)", v.Tx)
+            p.line("}")
+            p.line("ic += 1")
+        }
+        case decoder.OP_seek                : if v.Iv != 0 { p.line("wp = %s", offset("wp", v.Iv)) }
+        case decoder.OP_deref               : p.call("wp, err", "rs.Deref(wp, %s)", self.handle(v.Vt))
+        case decoder.OP_ctr_load            : p.call("ic, err", "rs.Container(ic, st, %d)", v.Iv)
+        case decoder.OP_ctr_decr            : p.line("rs.State(st).Nb--")
+        case decoder.OP_ctr_is_zero         : p.line("if rs.State(st).Nb == 0 {\ngoto L_%d\n}", v.To)
+        case decoder.OP_map_alloc           : p.call("err", "rs.MapAlloc(wp, st, %s)", self.handle(v.Vt))
+        case decoder.OP_map_close           : p.line("rs.State(st).Mp = nil")
+        case decoder.OP_map_set_i8          : p.line("ic, wp = rs.MapSetInt(ic, st, %s, 1)", self.handle(v.Vt))
+        case decoder.OP_map_set_i16         : p.line("ic, wp = rs.MapSetInt(ic, st, %s, 2)", self.handle(v.Vt))
+        case decoder.OP_map_set_i32         : p.line("ic, wp = rs.MapSetInt(ic, st, %s, 4)", self.handle(v.Vt))
+        case decoder.OP_map_set_i64         : p.line("ic, wp = rs.MapSetInt(ic, st, %s, 8)", self.handle(v.Vt))
+        case decoder.OP_map_set_str         : p.call("ic, wp, err", "rs.MapSetString(ic, st, %s)", self.handle(v.Vt))
+        case decoder.OP_map_set_enum        : p.line("ic, wp = rs.MapSetEnum(ic, st, %s)", self.handle(v.Vt))
+        case decoder.OP_map_set_pointer     : p.line("wp = rs.MapSetPointer(wp, st, %s)", self.handle(v.Vt))
+        case decoder.OP_list_alloc          : p.call("wp, err", "rs.ListAlloc(wp, st, %s)", self.handle(v.Vt))
+        case decoder.OP_array_check         : p.call("err", "rs.ArrayCheck(st, %s)", self.handle(v.Vt))
+        case decoder.OP_struct_skip: {
+            p.use("tg", "uint8")
+            p.call("ic, err", "rs.Skip(ic, tg)")
+        }
+        case decoder.OP_struct_ignore: {
+            p.use("tg", "uint8")
+            p.line("tg = %d", defs.T_struct)
+            p.call("ic, err", "rs.Skip(ic, tg)")
+        }
+        case decoder.OP_struct_unknown: {
+            p.use("tg", "uint8")
+            p.use("id", "int")
+            p.call("ic, err", "rs.Unknown(ic, tg, id, %s)", offset("wp", v.Iv))
+        }
+        case decoder.OP_struct_unknown_init: {
+            p.line("*(*[]byte)(%s) = (*(*[]byte)(%s))[:0]", offset("wp", v.Iv), offset("wp", v.Iv))
+        }
+        case decoder.OP_struct_union_init   : p.line("rs.State(st).Nb = 0")
+        case decoder.OP_struct_union_reset: {
+            p.line("*(*unsafe.Pointer)(wp) = nil")
+            for i := _PtrSize; i < int64(v.Vt.Size); i += _PtrSize {
+                p.line("*(*uintptr)(%s) = 0", offset("wp", i))
+            }
+        }
+        case decoder.OP_struct_union_mark   : p.call("err", "rs.UnionMark(st, %d, %s)", v.Id, self.handle(v.Vt))
+        case decoder.OP_struct_bitmap: {
+            var nb []string
+            var bm = bitmap(v.IntSeq())
+
+            /* clear all the words containing required fields */
+            for i, w := range bm {
+                if w != 0 {
+                    nb = append(nb, fmt.Sprint(i))
+                }
+            }
+
+            /* allocate the bitmap */
+            if len(nb) == 0 {
+                p.line("rs.Bitmap(st)")
+            } else {
+                p.line("rs.Bitmap(st, %s)", strings.Join(nb, ", "))
+            }
+        }
+        case decoder.OP_struct_require: {
+            for i, w := range bitmap(v.IntSeq()) {
+                if w != 0 {
+                    p.call("err", "rs.Require(st, %s, %d, %d)", self.handle(v.Vt), i, w)
+                }
+            }
+            p.line("rs.Release(st)")
+        }
+        case decoder.OP_struct_is_stop: {
+            p.use("tg", "uint8")
+            p.line("if tg == 0 {\ngoto L_%d\n}", v.To)
+        }
+        case decoder.OP_struct_mark_tag     : p.line("rs.State(st).Fm[%d] |= %d", v.Iv / 64, int64(1) << (v.Iv % 64))
+        case decoder.OP_struct_read_type: {
+            p.use("tg", "uint8")
+            p.line("tg = rs.U8(ic)")
+            p.line("ic += 1")
+        }
+        case decoder.OP_struct_check_type: {
+            p.use("tg", "uint8")
+            p.line("if tg != %d {\ngoto L_%d\n}", v.Tx, v.To)
+        }
+        case decoder.OP_struct_switch: {
+            p.use("id", "int")
+            p.line("id = int(rs.U16(ic))")
+            p.line("ic += 2")
+            p.line("switch id {")
+
+            /* add all the cases */
+            for i, to := range v.IntSeq() {
+                if to >= 0 {
+                    p.line("case %d: goto L_%d", i, to)
+                }
+            }
+
+            /* end of switch */
+            p.line("}")
+        }
+        case decoder.OP_make_state          : p.call("st, err", "rs.Push(st, wp)")
+        case decoder.OP_drop_state          : p.line("st, wp = rs.Pop(st)")
+        case decoder.OP_construct           : p.call("wp, err", "rs.New(%s)", self.handle(v.Vt))
+        case decoder.OP_custom              : p.call("err", "rs.Unmarshal(st, %s, wp)", self.handleOf((*defs.Codec)(v.Fn).Type))
+        case decoder.OP_initialize          : p.line("%s(wp)", self.initializer(v.Fn))
+        case decoder.OP_defer               : p.call("ic, err", "rs.Decode(%s, ic, wp, st)", self.handle(v.Vt))
+        case decoder.OP_goto                : p.line("goto L_%d", v.To)
+        case decoder.OP_halt                : p.line("return ic, nil")
+        default                             : panic("aot: unsupported decoder instruction: " + v.Disassemble())
+    }
+}
+
+/** Encoder Generator **/
+
+func encoderFlow(p encoder.Program) func(int) (bool, []int) {
+    return func(i int) (bool, []int) {
+        switch v := p[i]; v.Op {
+            case encoder.OP_halt          : return false, nil
+            case encoder.OP_goto          : return false, []int { v.To }
+            case encoder.OP_map_if_next   : fallthrough
+            case encoder.OP_map_if_empty  : fallthrough
+            case encoder.OP_list_if_next  : fallthrough
+            case encoder.OP_list_if_empty : fallthrough
+            case encoder.OP_if_nil        : fallthrough
+            case encoder.OP_if_hasbuf     : fallthrough
+            case encoder.OP_if_eq_imm     : fallthrough
+            case encoder.OP_if_eq_str     : return true, []int { v.To }
+            default                       : return true, nil
+        }
+    }
+}
+
+func (self *generator) encoder(fn *function) {
+    p := newBlock("return rs.Return(rp, rc, rl, %s)")
+    tab, vis := flow(len(fn.ep), encoderFlow(fn.ep))
+
+    /* the output length is always used */
+    p.use("rl", "int")
+
+    /* emit every reachable instruction */
+    for i, v := range fn.ep {
+        if vis[i] {
+            if tab[i] {
+                p.label(i)
+            }
+            self.encode(p, v)
+        }
+    }
+
+    /* add the function */
+    p.function(&self.body, fmt.Sprintf(
+        "func _frugal_enc_%d(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error)",
+        fn.id,
+    ))
+}
+
+func (self *generator) encode(p *block, v encoder.Instr) {
+    switch v.Op {
+        case encoder.OP_size_check: {
+            p.line("if rl + %d > rc {", v.Iv)
+            p.call("rp, rl, rc, err", "rs.Flush(rp, rl, %d)", v.Iv)
+            p.line("}")
+        }
+        case encoder.OP_size_const          : p.line("rl += %d", v.Iv)
+        case encoder.OP_size_dyn            : p.line("rl += *(*int)(%s) * %d", offset("wp", int64(v.Uv)), v.Iv)
+        case encoder.OP_size_map            : p.line("rl += aot.MapLen(wp) * %d", v.Iv)
+        case encoder.OP_size_defer: {
+            p.use("nb", "int")
+            p.call("nb, err", "rs.Size(%s, wp, st)", self.handle(v.Vt()))
+            p.line("rl += nb")
+        }
+        case encoder.OP_byte                : p.store(8, fmt.Sprintf("0x%02x", uint8(v.Iv)))
+        case encoder.OP_word                : p.store(16, fmt.Sprintf("0x%04x", uint16(v.Iv)))
+        case encoder.OP_long                : p.store(32, fmt.Sprintf("0x%08x", uint32(v.Iv)))
+        case encoder.OP_quad                : p.store(64, fmt.Sprintf("0x%016x", uint64(v.Iv)))
+        case encoder.OP_sint                : p.store(v.Iv * 8, fmt.Sprintf("*(*uint%d)(wp)", v.Iv * 8))
+        case encoder.OP_uint: {
+            p.use("tr", "uint64")
+            p.line("tr = uint64(*(*uint%d)(wp))", v.Vt().Size * 8)
+
+            /* the value must fit the signed wire type */
+            if int64(v.Vt().Size) >= v.Iv {
+                p.line("if tr > %d {", uint64(1) << (v.Iv * 8 - 1) - 1)
+                p.fail("rs.RangeError(%s, tr, %d)", self.handle(v.Vt()), v.Iv)
+                p.line("}")
+            }
+
+            /* store the value */
+            p.store(v.Iv * 8, fmt.Sprintf("uint%d(tr)", v.Iv * 8))
+        }
+        case encoder.OP_float               : p.store(64, "aot.Widen(wp)")
+        case encoder.OP_length              : p.store(32, fmt.Sprintf("uint32(*(*int)(%s))", offset("wp", v.Iv)))
+        case encoder.OP_memcpy              : p.call("rp, rl, rc, err", "rs.Memcpy(rp, rl, rc, wp, %d)", v.Iv)
+        case encoder.OP_memcpy_be           : p.call("rp, rl, rc, err", "rs.MemcpyBE(rp, rl, rc, mem, wp, %d, %d)", v.Uv, v.Iv)
+        case encoder.OP_seek                : if v.Iv != 0 { p.line("wp = %s", offset("wp", v.Iv)) }
+        case encoder.OP_deref               : p.line("wp = *(*unsafe.Pointer)(wp)")
+        case encoder.OP_custom              : p.call("wp, err", "rs.Marshal(%s, wp)", self.handleOf((*defs.Codec)(v.Pr).Type))
+        case encoder.OP_defer               : p.call("rp, rl, rc, err", "rs.Encode(%s, rp, rl, rc, mem, wp, st)", self.handle(v.Vt()))
+        case encoder.OP_map_len             : p.store(32, "uint32(aot.MapLen(wp))")
+        case encoder.OP_map_key             : p.line("wp = rs.State(st).Mi.K")
+        case encoder.OP_map_value           : p.line("wp = rs.State(st).Mi.V")
+        case encoder.OP_map_next            : p.line("rs.MapNext(st)")
+        case encoder.OP_map_begin           : p.line("rs.MapBegin(st, %s, wp)", self.handle(v.Vt()))
+        case encoder.OP_map_if_next         : p.line("if rs.State(st).Mi.K != nil {\ngoto L_%d\n}", v.To)
+        case encoder.OP_map_if_empty        : p.line("if aot.MapLen(wp) == 0 {\ngoto L_%d\n}", v.To)
+        case encoder.OP_list_decr           : p.line("rs.State(st).Ln--")
+        case encoder.OP_list_begin: {
+            p.line("rs.State(st).Ln = uintptr(*(*int)(%s))", offset("wp", _PtrSize))
+            p.line("wp = *(*unsafe.Pointer)(wp)")
+        }
+        case encoder.OP_array_begin         : p.line("rs.State(st).Ln = %d", v.Iv)
+        case encoder.OP_list_if_next        : p.line("if rs.State(st).Ln != 0 {\ngoto L_%d\n}", v.To)
+        case encoder.OP_list_if_empty       : p.line("if *(*int)(%s) == 0 {\ngoto L_%d\n}", offset("wp", _PtrSize), v.To)
+        case encoder.OP_unique              : p.call("err", "rs.Unique(wp, %s)", self.handle(v.Vt()))
+        case encoder.OP_goto                : p.line("goto L_%d", v.To)
+        case encoder.OP_if_nil              : p.line("if *(*unsafe.Pointer)(wp) == nil {\ngoto L_%d\n}", v.To)
+        case encoder.OP_if_hasbuf           : p.line("if rp != nil {\ngoto L_%d\n}", v.To)
+        case encoder.OP_if_eq_imm           : p.line("if *(*int%d)(wp) == %d {\ngoto L_%d\n}", v.Uv * 8, immediate(v), v.To)
+        case encoder.OP_if_eq_str           : p.line("if *(*string)(wp) == %q {\ngoto L_%d\n}", rt.StringFrom(v.Pr, int(v.Iv)), v.To)
+        case encoder.OP_union_begin: {
+            p.use("nb", "int")
+            p.line("nb = 0")
+        }
+        case encoder.OP_union_count: {
+            p.use("nb", "int")
+            p.line("if *(*unsafe.Pointer)(%s) != nil {\nnb++\n}", offset("wp", v.Iv))
+        }
+        case encoder.OP_union_check: {
+            p.use("nb", "int")
+            p.line("if nb != 1 {")
+            p.fail("rs.UnionError(%s, nb)", self.handle(v.Vt()))
+            p.line("}")
+        }
+        case encoder.OP_make_state          : p.call("st, err", "rs.Push(st, wp)")
+        case encoder.OP_drop_state          : p.line("st, wp = rs.Pop(st)")
+        case encoder.OP_halt                : p.line("return rs.Return(rp, rc, rl, nil)")
+        default                             : panic("aot: unsupported encoder instruction: " + v.Disassemble())
+    }
+}
+
+func immediate(v encoder.Instr) int64 {
+    switch v.Uv {
+        case 1  : return int64(int8(v.Iv))
+        case 2  : return int64(int16(v.Iv))
+        case 4  : return int64(int32(v.Iv))
+        case 8  : return v.Iv
+        default : panic("invalid imm size")
+    }
+}
+
+func (self *block) store(bits int64, val string) {
+    self.line("aot.Store%d(rp, rl, %s)", bits, val)
+    self.line("rl += %d", bits / 8)
+}
@@ -0,0 +1,426 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package aot
+
+import (
+    `bytes`
+    `fmt`
+    `go/format`
+    `io`
+    `reflect`
+    `sort`
+    `strings`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+const (
+    _AOT_header = "// Code generated by frugal gen. DO NOT EDIT.\n\n"
+)
+
+type typeRef struct {
+    vt reflect.Type
+    up int
+    to string
+}
+
+type function struct {
+    vt reflect.Type
+    id int
+    fp uint64
+    dp decoder.Program
+    ep encoder.Program
+}
+
+type generator struct {
+    path  string
+    name  string
+    body  bytes.Buffer
+    pkgs  map[string]string
+    refs  []typeRef
+    refm  map[reflect.Type]int
+    decs  []*function
+    encs  []*function
+    decm  map[reflect.Type]*function
+    encm  map[reflect.Type]*function
+    hdls  map[reflect.Type]int
+    fnps  map[unsafe.Pointer]int
+    fnrs  map[unsafe.Pointer]int
+}
+
+// Generate writes the Go source of the Thrift Binary encoders and decoders of types and all the types they depend on
+// into w. The source belongs to package name with import path path, the types must either belong to the same package
+// or be exported from other packages.
+//
+// Only one generated file is allowed in each package, importing the package registers the generated code.
+func Generate(w io.Writer, path string, name string, types ...reflect.Type) error {
+    g := &generator {
+        path: path,
+        name: name,
+        pkgs: make(map[string]string),
+        refm: make(map[reflect.Type]int),
+        decm: make(map[reflect.Type]*function),
+        encm: make(map[reflect.Type]*function),
+        hdls: make(map[reflect.Type]int),
+        fnps: make(map[unsafe.Pointer]int),
+        fnrs: make(map[unsafe.Pointer]int),
+    }
+
+    /* add all the root types */
+    for _, vt := range types {
+        if err := g.root(vt); err != nil {
+            return err
+        }
+    }
+
+    /* compile all the types */
+    if err := g.compile(); err != nil {
+        return err
+    }
+
+    /* emit every function */
+    for _, fn := range g.decs { g.decoder(fn) }
+    for _, fn := range g.encs { g.encoder(fn) }
+
+    /* generate the file and format it */
+    if src, err := g.source(); err != nil {
+        return err
+    } else if buf, err := format.Source(src); err != nil {
+        return fmt.Errorf("aot: cannot format the generated code: %v", err)
+    } else {
+        _, err = w.Write(buf)
+        return err
+    }
+}
+
+func (self *generator) root(vt reflect.Type) error {
+    if vt.Kind() == reflect.Ptr {
+        vt = vt.Elem()
+    }
+
+    /* the type must be nameable */
+    if vt.Kind() != reflect.Struct {
+        return fmt.Errorf("aot: %s is not a struct", vt)
+    } else if vt.Name() == "" || strings.ContainsRune(vt.Name(), '[') {
+        return fmt.Errorf("aot: %s is not a named non-generic type", vt)
+    } else if _, ok := self.refm[vt]; ok {
+        return nil
+    }
+
+    /* types from other packages must be exported */
+    if vt.PkgPath() != self.path && !isExported(vt.Name()) {
+        return fmt.Errorf("aot: %s is not exported from %s", vt, vt.PkgPath())
+    }
+
+    /* add the pointer type and the type itself as roots of the type graph */
+    np := len(self.refs)
+    self.refs = append(self.refs, typeRef { vt: reflect.PtrTo(vt), up: -1, to: fmt.Sprintf("reflect.TypeOf((*%s)(nil))", self.qualify(vt)) })
+    self.refs = append(self.refs, typeRef { vt: vt, up: np, to: ".Elem()" })
+    self.refm[reflect.PtrTo(vt)] = np
+    self.refm[vt] = np + 1
+
+    /* decode into the struct, and encode both the value and the pointer */
+    self.addDecoder(vt)
+    self.addEncoder(vt)
+    self.addEncoder(reflect.PtrTo(vt))
+    return nil
+}
+
+func (self *generator) qualify(vt reflect.Type) string {
+    if vt.PkgPath() == self.path {
+        return vt.Name()
+    } else if name, ok := self.pkgs[vt.PkgPath()]; ok {
+        return name + "." + vt.Name()
+    }
+
+    /* the package name is the prefix of the type string */
+    name := strings.TrimSuffix(vt.String(), "." + vt.Name())
+    used := make(map[string]bool, len(self.pkgs))
+
+    /* find an unused import name */
+    for _, v := range self.pkgs {
+        used[v] = true
+    }
+
+    /* avoid conflicts with the generated file itself */
+    for _, v := range []string { "aot", "iov", "reflect", "unsafe", self.name } {
+        used[v] = true
+    }
+
+    /* add a suffix if needed */
+    for i, s := 1, name; used[name]; i++ {
+        name = fmt.Sprintf("%s%d", s, i)
+    }
+
+    /* add the import */
+    self.pkgs[vt.PkgPath()] = name
+    return name + "." + vt.Name()
+}
+
+func (self *generator) addDecoder(vt reflect.Type) {
+    if _, ok := self.decm[vt]; !ok {
+        fn := &function { vt: vt, id: len(self.decs) }
+        self.decm[vt] = fn
+        self.decs = append(self.decs, fn)
+    }
+}
+
+func (self *generator) addEncoder(vt reflect.Type) {
+    if _, ok := self.encm[vt]; !ok {
+        fn := &function { vt: vt, id: len(self.encs) }
+        self.encm[vt] = fn
+        self.encs = append(self.encs, fn)
+    }
+}
+
+func (self *generator) compile() error {
+    var err error
+
+    /* compile all the decoders, deferred types are appended to the queue */
+    for i := 0; i < len(self.decs); i++ {
+        fn := self.decs[i]
+
+        /* compile the type */
+        if fn.dp, err = decoder.CreateCompiler().CompileAndFree(fn.vt); err != nil {
+            return err
+        }
+
+        /* add the deferred types */
+        for _, v := range fn.dp {
+            if v.Op == decoder.OP_defer {
+                self.addDecoder(v.Vt.Pack())
+            }
+        }
+
+        /* save the fingerprint */
+        fn.fp = fn.dp.Fingerprint()
+    }
+
+    /* compile all the encoders, deferred types are appended to the queue */
+    for i := 0; i < len(self.encs); i++ {
+        fn := self.encs[i]
+
+        /* compile the type */
+        if fn.ep, err = encoder.CreateCompiler().CompileAndFree(fn.vt); err != nil {
+            return err
+        }
+
+        /* add the deferred types */
+        for _, v := range fn.ep {
+            if v.Op == encoder.OP_defer || v.Op == encoder.OP_size_defer {
+                self.addEncoder(v.Vt().Pack())
+            }
+        }
+
+        /* save the fingerprint */
+        fn.fp = fn.ep.Fingerprint()
+    }
+
+    /* all done */
+    return nil
+}
+
+func (self *generator) handle(vt *rt.GoType) string {
+    return self.handleOf(vt.Pack())
+}
+
+func (self *generator) handleOf(vt reflect.Type) string {
+    if id, ok := self.hdls[vt]; ok {
+        return fmt.Sprintf("_frugal_t%d", id)
+    } else {
+        self.hdls[vt] = len(self.hdls)
+        return fmt.Sprintf("_frugal_t%d", len(self.hdls) - 1)
+    }
+}
+
+func (self *generator) initializer(fp unsafe.Pointer) string {
+    if id, ok := self.fnps[fp]; ok {
+        return fmt.Sprintf("_frugal_i%d", id)
+    } else {
+        self.fnps[fp] = len(self.fnps)
+        return fmt.Sprintf("_frugal_i%d", len(self.fnps) - 1)
+    }
+}
+
+func (self *generator) resolve() ([]int, error) {
+    for i := 0; i < len(self.refs); i++ {
+        vt := self.refs[i].vt
+
+        /* codecs are resolved through the wire type */
+        if cc := defs.LookupCodec(vt); cc != nil {
+            self.edge(i, cc.Wire, "")
+            continue
+        }
+
+        /* walk through the type */
+        switch vt.Kind() {
+            case reflect.Ptr    : self.edge(i, vt.Elem(), ".Elem()")
+            case reflect.Array  : self.edge(i, vt.Elem(), ".Elem()")
+            case reflect.Slice  : self.edge(i, vt.Elem(), ".Elem()")
+            case reflect.Map    : self.edge(i, vt.Key(), ".Key()"); self.edge(i, vt.Elem(), ".Elem()")
+            case reflect.Struct : self.fields(i, vt)
+        }
+    }
+
+    /* find all the initializers */
+    inits := make(map[unsafe.Pointer]int, len(self.fnps))
+    for i, v := range self.refs {
+        if v.vt.Kind() == reflect.Struct {
+            if fp, err := defs.GetDefaultInitializer(v.vt); err == nil && fp != nil {
+                if _, ok := inits[fp]; !ok {
+                    inits[fp] = i
+                }
+            }
+        }
+    }
+
+    /* mark all the types being used */
+    used := make([]int, 0, len(self.hdls) + len(self.fnps))
+    for vt := range self.hdls {
+        if i, ok := self.refm[vt]; !ok {
+            return nil, fmt.Errorf("aot: type %s is not reachable from the root types", vt)
+        } else {
+            used = append(used, i)
+        }
+    }
+
+    /* initializers are resolved with the struct types */
+    for fp := range self.fnps {
+        if i, ok := inits[fp]; !ok {
+            return nil, fmt.Errorf("aot: initializer %s is not reachable from the root types", rt.FuncName(fp))
+        } else {
+            used = append(used, i)
+            self.fnrs[fp] = i
+        }
+    }
+
+    /* all done */
+    return used, nil
+}
+
+func (self *generator) fields(up int, vt reflect.Type) {
+    for i := 0; i < vt.NumField(); i++ {
+        self.edge(up, vt.Field(i).Type, fmt.Sprintf(".Field(%d).Type", i))
+    }
+}
+
+func (self *generator) edge(up int, vt reflect.Type, to string) {
+    if _, ok := self.refm[vt]; !ok {
+        self.refm[vt] = len(self.refs)
+        self.refs = append(self.refs, typeRef { vt: vt, up: up, to: to })
+    }
+}
+
+func (self *generator) source() ([]byte, error) {
+    var err error
+    var buf bytes.Buffer
+    var used []int
+
+    /* the entries refer to the types of every function */
+    for _, fn := range self.decs { self.handleOf(fn.vt) }
+    for _, fn := range self.encs { self.handleOf(fn.vt) }
+
+    /* resolve all the types */
+    if used, err = self.resolve(); err != nil {
+        return nil, err
+    }
+
+    /* sort the import paths */
+    pkgs := make([]string, 0, len(self.pkgs))
+    for v := range self.pkgs {
+        pkgs = append(pkgs, v)
+    }
+
+    /* file header */
+    sort.Strings(pkgs)
+    buf.WriteString(_AOT_header)
+    fmt.Fprintf(&buf, "package %s\n\n", self.name)
+    fmt.Fprintf(&buf, "import (\n\t\"reflect\"\n\t\"unsafe\"\n\n\t\"github.com/cloudwego/frugal/aot\"\n\t\"github.com/cloudwego/frugal/iov\"\n")
+
+    /* other packages */
+    if len(pkgs) != 0 {
+        buf.WriteByte('\n')
+    }
+
+    /* add the imports */
+    for _, v := range pkgs {
+        fmt.Fprintf(&buf, "\t%s %q\n", self.pkgs[v], v)
+    }
+
+    /* type handles and initializers */
+    fmt.Fprintf(&buf, ")\n\nvar (\n")
+    for i := 0; i < len(self.hdls); i++ { fmt.Fprintf(&buf, "\t_frugal_t%d *aot.Type\n", i) }
+    for i := 0; i < len(self.fnps); i++ { fmt.Fprintf(&buf, "\t_frugal_i%d func(unsafe.Pointer)\n", i) }
+    fmt.Fprintf(&buf, ")\n\nfunc init() {\n\taot.Register(_frugal_load)\n}\n\n")
+
+    /* mark the paths of all the types being used */
+    refs := make([]bool, len(self.refs))
+    for _, i := range used {
+        for ; i >= 0 && !refs[i]; i = self.refs[i].up {
+            refs[i] = true
+        }
+    }
+
+    /* the loader resolves the types from the roots */
+    fmt.Fprintf(&buf, "func _frugal_load() []aot.Entry {\n")
+    for i, v := range self.refs {
+        if refs[i] {
+            switch {
+                case v.up < 0  : fmt.Fprintf(&buf, "\tr%d := %s\n", i, v.to)
+                case v.to == "": fmt.Fprintf(&buf, "\tr%d := aot.WireOf(r%d)\n", i, v.up)
+                default        : fmt.Fprintf(&buf, "\tr%d := r%d%s\n", i, v.up, v.to)
+            }
+        }
+    }
+
+    /* sort the handles by ID */
+    hdls := make([]reflect.Type, len(self.hdls))
+    for vt, i := range self.hdls {
+        hdls[i] = vt
+    }
+
+    /* load the type handles */
+    for i, vt := range hdls {
+        fmt.Fprintf(&buf, "\t_frugal_t%d = aot.TypeOf(r%d)\n", i, self.refm[vt])
+    }
+
+    /* sort the initializers by ID */
+    fnps := make([]unsafe.Pointer, len(self.fnps))
+    for fp, i := range self.fnps {
+        fnps[i] = fp
+    }
+
+    /* load the initializers */
+    for i, fp := range fnps {
+        fmt.Fprintf(&buf, "\t_frugal_i%d = aot.InitializerOf(r%d)\n", i, self.fnrs[fp])
+    }
+
+    /* all the entries */
+    fmt.Fprintf(&buf, "\treturn []aot.Entry {\n")
+    for _, fn := range self.decs { fmt.Fprintf(&buf, "\t\t{ Type: %s, Hash: 0x%016x, Decode: _frugal_dec_%d },\n", self.handleOf(fn.vt), fn.fp, fn.id) }
+    for _, fn := range self.encs { fmt.Fprintf(&buf, "\t\t{ Type: %s, Hash: 0x%016x, Encode: _frugal_enc_%d },\n", self.handleOf(fn.vt), fn.fp, fn.id) }
+    fmt.Fprintf(&buf, "\t}\n}\n")
+
+    /* add all the functions */
+    buf.Write(self.body.Bytes())
+    return buf.Bytes(), nil
+}
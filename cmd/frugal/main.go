@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Command frugal generates ahead-of-time Thrift Binary codecs.
+//
+// Usage:
+//
+//     frugal gen [-o file] <package> <type> ...
+//
+// Types are exported struct types of the package, or of other packages when written as "import/path.Type".
+// The generated file is written to frugal_gen.go in the package directory unless -o is specified, and the
+// codecs are used automatically once the package is imported.
+package main
+
+import (
+    `bytes`
+    `flag`
+    `fmt`
+    `io/ioutil`
+    `os`
+    `os/exec`
+    `path/filepath`
+    `strings`
+)
+
+const (
+    _GenHeader = "// Code generated by frugal gen. DO NOT EDIT."
+)
+
+type pkgInfo struct {
+    Dir        string
+    Name       string
+    ImportPath string
+}
+
+func usage() {
+    fmt.Fprintf(os.Stderr, "usage: frugal gen [-o file] <package> <type> ...\n")
+    os.Exit(2)
+}
+
+func fatal(err error) {
+    fmt.Fprintf(os.Stderr, "frugal: %v\n", err)
+    os.Exit(1)
+}
+
+func main() {
+    if len(os.Args) < 2 || os.Args[1] != "gen" {
+        usage()
+    }
+
+    /* parse the command line */
+    fs := flag.NewFlagSet("gen", flag.ExitOnError)
+    out := fs.String("o", "", "output file, defaults to frugal_gen.go in the package directory")
+
+    /* must have a package and at least one type */
+    if fs.Usage = usage; fs.Parse(os.Args[2:]) != nil || fs.NArg() < 2 {
+        usage()
+    }
+
+    /* find the package */
+    pkg, err := listPackage(fs.Arg(0))
+    if err != nil {
+        fatal(err)
+    }
+
+    /* the default output file */
+    if *out == "" {
+        *out = filepath.Join(pkg.Dir, "frugal_gen.go")
+    } else if *out, err = filepath.Abs(*out); err != nil {
+        fatal(err)
+    }
+
+    /* the previous output is ignored by the compiler while generating, it might be outdated */
+    if err = hideOutput(*out); err != nil {
+        fatal(err)
+    }
+
+    /* run the generator, and restore the previous output on failure */
+    if err = generate(pkg, fs.Args()[1:], *out); err != nil {
+        restoreOutput(*out)
+        fatal(err)
+    }
+
+    /* remove the previous output */
+    _ = os.Remove(hiddenName(*out))
+}
+
+func hiddenName(fn string) string {
+    return filepath.Join(filepath.Dir(fn), "_" + filepath.Base(fn))
+}
+
+func hideOutput(fn string) error {
+    buf, err := ioutil.ReadFile(fn)
+
+    /* nothing to hide if the file does not exist */
+    if os.IsNotExist(err) {
+        return nil
+    } else if err != nil {
+        return err
+    }
+
+    /* never touch files not generated by frugal */
+    if !bytes.HasPrefix(buf, []byte(_GenHeader)) {
+        return fmt.Errorf("%s exists and was not generated by frugal", fn)
+    } else {
+        return os.Rename(fn, hiddenName(fn))
+    }
+}
+
+func restoreOutput(fn string) {
+    if _, err := os.Stat(hiddenName(fn)); err == nil {
+        _ = os.Rename(hiddenName(fn), fn)
+    }
+}
+
+func listPackage(name string) (*pkgInfo, error) {
+    var out bytes.Buffer
+    var cmd = exec.Command("go", "list", "-f", "{{.Dir}}\n{{.Name}}\n{{.ImportPath}}", name)
+
+    /* run the command */
+    cmd.Stdout = &out
+    cmd.Stderr = os.Stderr
+
+    /* parse the output */
+    if err := cmd.Run(); err != nil {
+        return nil, fmt.Errorf("cannot find package %s: %v", name, err)
+    } else if fv := strings.Split(strings.TrimSpace(out.String()), "\n"); len(fv) != 3 {
+        return nil, fmt.Errorf("cannot find package %s", name)
+    } else {
+        return &pkgInfo { Dir: fv[0], Name: fv[1], ImportPath: fv[2] }, nil
+    }
+}
+
+func splitType(pkg *pkgInfo, name string) (string, string) {
+    if i := strings.LastIndexByte(name, '.'); i < 0 {
+        return pkg.ImportPath, name
+    } else {
+        return name[:i], name[i + 1:]
+    }
+}
+
+func generate(pkg *pkgInfo, types []string, out string) error {
+    var src bytes.Buffer
+    var pkgs = make(map[string]string)
+
+    /* generator driver */
+    src.WriteString("package main\n\n")
+    src.WriteString("import (\n\t\"bytes\"\n\t\"io/ioutil\"\n\t\"os\"\n\t\"reflect\"\n\n\t\"github.com/cloudwego/frugal/aot\"\n")
+
+    /* import all the packages of the types */
+    for _, tv := range types {
+        if path, _ := splitType(pkg, tv); pkgs[path] == "" {
+            pkgs[path] = fmt.Sprintf("p%d", len(pkgs))
+            fmt.Fprintf(&src, "\t%s %q\n", pkgs[path], path)
+        }
+    }
+
+    /* generate into a buffer, and only write the file on success */
+    src.WriteString(")\n\nfunc main() {\n\tvar buf bytes.Buffer\n")
+    fmt.Fprintf(&src, "\terr := aot.Generate(&buf, %q, %q,\n", pkg.ImportPath, pkg.Name)
+
+    /* add all the types */
+    for _, tv := range types {
+        path, name := splitType(pkg, tv)
+        fmt.Fprintf(&src, "\t\treflect.TypeOf((*%s.%s)(nil)).Elem(),\n", pkgs[path], name)
+    }
+
+    /* write the output file */
+    src.WriteString("\t)\n\tif err == nil {\n")
+    fmt.Fprintf(&src, "\t\terr = ioutil.WriteFile(%q, buf.Bytes(), 0644)\n", out)
+    src.WriteString("\t}\n\tif err != nil {\n\t\tos.Stderr.WriteString(\"frugal: \" + err.Error() + \"\\n\")\n\t\tos.Exit(1)\n\t}\n}\n")
+
+    /* the driver must be within the current module to import the packages */
+    dir, err := ioutil.TempDir(".", "_frugal_gen")
+    if err != nil {
+        return err
+    }
+
+    /* remove the driver when done */
+    defer os.RemoveAll(dir)
+    fn := filepath.Join(dir, "main.go")
+
+    /* write the driver */
+    if err = ioutil.WriteFile(fn, src.Bytes(), 0644); err != nil {
+        return err
+    }
+
+    /* run the driver */
+    cmd := exec.Command("go", "run", "./" + filepath.ToSlash(fn))
+    cmd.Stdout = os.Stdout
+    cmd.Stderr = os.Stderr
+
+    /* check for errors */
+    if err = cmd.Run(); err != nil {
+        return fmt.Errorf("cannot generate code for %s: %v", pkg.ImportPath, err)
+    } else {
+        return nil
+    }
+}
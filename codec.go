@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+// RegisterTypeCodec maps a Go type that Thrift cannot represent, such as time.Time, onto a type that frugal
+// can serialize natively.
+//
+// marshal must be a function of type `func(T) (W, error)`, and unmarshal must be a function of type
+// `func(W) (T, error)`, where T is the custom type and W is the wire type, which can be any non-pointer type
+// supported by frugal, like int64, string, []byte or a struct. Fields of type T are tagged with the wire type
+// of W, and are always serialized unless they are pointers.
+//
+// Codecs must be registered before the first encoding or decoding of any type that references T, usually in
+// an init function, since the compiled programs are cached.
+func RegisterTypeCodec(marshal interface{}, unmarshal interface{}) error {
+    if cc, err := defs.NewCodec(marshal, unmarshal); err != nil {
+        return err
+    } else {
+        return defs.RegisterCodec(cc)
+    }
+}
@@ -17,6 +17,8 @@
 package debug
 
 import (
+    `sync/atomic`
+
     `github.com/cloudwego/frugal/internal/binary/decoder`
     `github.com/cloudwego/frugal/internal/binary/encoder`
     `github.com/cloudwego/frugal/internal/loader`
@@ -37,27 +39,36 @@ type MemStats struct {
 
 // A CacheStats records statistics about the type cache.
 type CacheStats struct {
-    Hit  int
-    Miss int
-    Size int
+    Hit       int
+    Miss      int
+    Size      int
+    Generated int     // types served by ahead-of-time generated code, see package aot
+    Cached    int     // types loaded from the on-disk machine code cache, see frugal.SetCacheDir
+    Emulated  int     // calls that ran in the emulator, see frugal.SetAsyncCompileWorkers
 }
 
 // GetStats returns statistics of the JIT compiler.
 func GetStats() Stats {
     return Stats {
         Memory: MemStats {
-            Count: int(loader.FnCount),
-            Alloc: int(loader.LoadSize),
+            Count: int(atomic.LoadUint32(&loader.FnCount)),
+            Alloc: int(atomic.LoadUintptr(&loader.LoadSize)),
         },
         Encoder: CacheStats {
-            Hit  : int(encoder.HitCount),
-            Miss : int(encoder.MissCount),
-            Size : int(encoder.TypeCount),
+            Hit       : int(atomic.LoadUint64(&encoder.HitCount)),
+            Miss      : int(atomic.LoadUint64(&encoder.MissCount)),
+            Size      : int(atomic.LoadUint64(&encoder.TypeCount)),
+            Generated : int(atomic.LoadUint64(&encoder.GeneratedCount)),
+            Cached    : int(atomic.LoadUint64(&encoder.CachedCount)),
+            Emulated  : int(atomic.LoadUint64(&encoder.EmulatedCount)),
         },
         Decoder: CacheStats {
-            Hit  : int(decoder.HitCount),
-            Miss : int(decoder.MissCount),
-            Size : int(decoder.TypeCount),
+            Hit       : int(atomic.LoadUint64(&decoder.HitCount)),
+            Miss      : int(atomic.LoadUint64(&decoder.MissCount)),
+            Size      : int(atomic.LoadUint64(&decoder.TypeCount)),
+            Generated : int(atomic.LoadUint64(&decoder.GeneratedCount)),
+            Cached    : int(atomic.LoadUint64(&decoder.CachedCount)),
+            Emulated  : int(atomic.LoadUint64(&decoder.EmulatedCount)),
         },
     }
 }
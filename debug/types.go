@@ -0,0 +1,139 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+    `expvar`
+    `fmt`
+    `reflect`
+    `sort`
+    `time`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/internal/utils`
+)
+
+// A TypeStats records statistics about a single type, pointers to a type
+// share the same statistics with the type itself.
+type TypeStats struct {
+    Type    reflect.Type `json:"-"`
+    Encoder CodecStats
+    Decoder CodecStats
+}
+
+// A CodecStats records statistics about the encoder or the decoder of a type.
+//
+// The time spent in each compilation phase is accumulated over all the compilations
+// of the type, e.g. both Binary and Compact, or both the emulated and the native code
+// when asynchronous compilation is enabled. Translate is the lowering from the opcode
+// program into HIR, Codegen covers generating and loading the machine code (or the
+// on-disk cache lookup, see frugal.SetCacheDir), and is zero with the emulator.
+//
+// The code generator works on HIR directly, the SSA form is only built by the experimental
+// SSA backend when the compilation pipeline is dumped (see frugal.SetDumpDir), so SSA is
+// zero unless the type is dumped, and excludes the time spent writing the dump files.
+//
+// Calls and Bytes are estimated from the sampled calls, and are always zero unless
+// sampling is enabled, see frugal.SetStatsSampleRate.
+type CodecStats struct {
+    Frontend  time.Duration
+    Translate time.Duration
+    SSA       time.Duration
+    Codegen   time.Duration
+    CodeSize  int   // size of the machine code, zero with the emulator
+    Inlined   int   // struct types compiled inline
+    Deferred  int   // out-of-line calls to other compiled types (OP_defer)
+    Calls     int
+    Bytes     int
+}
+
+func codecStats(st utils.TypeStats) CodecStats {
+    return CodecStats {
+        Frontend  : time.Duration(st.Frontend),
+        Translate : time.Duration(st.Translate),
+        SSA       : time.Duration(st.SSA),
+        Codegen   : time.Duration(st.Codegen),
+        CodeSize  : int(st.CodeSize),
+        Inlined   : int(st.Inlined),
+        Deferred  : int(st.Deferred),
+        Calls     : int(st.Calls),
+        Bytes     : int(st.Bytes),
+    }
+}
+
+// GetTypeStats returns statistics of all the compiled types, sorted by the type name.
+func GetTypeStats() []TypeStats {
+    var ret []TypeStats
+    var idx = make(map[*rt.GoType]int)
+
+    /* find or add the type */
+    add := func(vt *rt.GoType) *TypeStats {
+        if i, ok := idx[vt]; ok {
+            return &ret[i]
+        } else {
+            idx[vt] = len(ret)
+            ret = append(ret, TypeStats { Type: vt.Pack() })
+            return &ret[len(ret) - 1]
+        }
+    }
+
+    /* merge the encoder and decoder statistics */
+    encoder.TypeStats.Range(func(vt *rt.GoType, st utils.TypeStats) { add(vt).Encoder = codecStats(st) })
+    decoder.TypeStats.Range(func(vt *rt.GoType, st utils.TypeStats) { add(vt).Decoder = codecStats(st) })
+
+    /* sort by type name */
+    sort.SliceStable(ret, func(i int, j int) bool {
+        return ret[i].Type.String() < ret[j].Type.String()
+    })
+
+    /* all done */
+    return ret
+}
+
+// Publish exports the statistics as an expvar variable with the given name, the
+// variable is a JSON object with the overall statistics under "Stats", and the
+// per-type statistics keyed by the type name under "Types".
+//
+// Like expvar.Publish, it panics if the name is already registered.
+func Publish(name string) {
+    expvar.Publish(name, expvar.Func(publish))
+}
+
+func publish() interface{} {
+    ts := GetTypeStats()
+    tm := make(map[string]TypeStats, len(ts))
+
+    /* distinct types might have the same name */
+    for _, v := range ts {
+        key := v.Type.String()
+        for i := 2; tm[key].Type != nil; i++ {
+            key = fmt.Sprintf("%s#%d", v.Type, i)
+        }
+        tm[key] = v
+    }
+
+    /* build the variable */
+    return struct {
+        Stats Stats
+        Types map[string]TypeStats
+    } {
+        Stats: GetStats(),
+        Types: tm,
+    }
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+)
+
+// LimitKind identifies which decoding limit has been exceeded.
+type LimitKind = decoder.LimitKind
+
+const (
+    LimitAllocSize     = decoder.LimitAllocSize
+    LimitContainerSize = decoder.LimitContainerSize
+    LimitStringSize    = decoder.LimitStringSize
+    LimitNestingDepth  = decoder.LimitNestingDepth
+)
+
+// LimitError is returned by the decoders when the input exceeds one of the
+// decoding limits, Value is the offending value and Limit is the configured limit.
+type LimitError = decoder.LimitError
+
+// WireType is the Thrift wire type of a value, the same as TType in the Thrift library.
+type WireType = decoder.WireType
+
+// DecodeError is returned by the decoders when the input cannot be decoded, it
+// describes where the error happened, and the underlying error is kept as Cause.
+//
+// Offset is the byte offset into the input where the error was detected. FieldPath
+// is like "Req.Items[3].Name", it's only available when decoding from a contiguous
+// buffer. Expected and Actual are the wire types of a type mismatch, and are zero
+// for other errors.
+//
+// Errors that are wrapped by DecodeError, such as *LimitError, can be matched with
+// errors.As or errors.Is.
+type DecodeError = decoder.DecodeError
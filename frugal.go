@@ -17,6 +17,7 @@
 package frugal
 
 import (
+    `github.com/cloudwego/frugal/generic`
     `github.com/cloudwego/frugal/internal/binary/decoder`
     `github.com/cloudwego/frugal/internal/binary/encoder`
     `github.com/cloudwego/frugal/iov`
@@ -37,3 +38,70 @@ func EncodeObject(buf []byte, mem iov.BufferWriter, val interface{}) (int, error
 func DecodeObject(buf []byte, val interface{}) (int, error) {
     return decoder.DecodeObject(buf, val)
 }
+
+// DecodeObjectWithOptions is like DecodeObject, but with the decoding limits overridden by options.
+func DecodeObjectWithOptions(buf []byte, val interface{}, options ...Option) (int, error) {
+    return decoder.DecodeObjectWithOptions(buf, val, applyOptions(options))
+}
+
+// CompactEncodedSize measures the encoded size of val with Thrift Compact Protocol.
+func CompactEncodedSize(val interface{}) int {
+    return encoder.CompactEncodedSize(val)
+}
+
+// EncodeCompact serializes val into buf with Thrift Compact Protocol, with optional Zero-Copy iov.BufferWriter.
+// buf must be large enough to contain the entire serialization result.
+func EncodeCompact(buf []byte, mem iov.BufferWriter, val interface{}) (int, error) {
+    return encoder.EncodeCompact(buf, mem, val)
+}
+
+// DecodeCompact deserializes buf into val with Thrift Compact Protocol.
+func DecodeCompact(buf []byte, val interface{}) (int, error) {
+    return decoder.DecodeCompact(buf, val)
+}
+
+// DecodeCompactWithOptions is like DecodeCompact, but with the decoding limits overridden by options.
+func DecodeCompactWithOptions(buf []byte, val interface{}, options ...Option) (int, error) {
+    return decoder.DecodeCompactWithOptions(buf, val, applyOptions(options))
+}
+
+// DecodeReader deserializes the bytes from r into val with Thrift Binary Protocol, the decoded bytes are consumed from r.
+//
+// Fields marked as "nocopy" refer to the segments of r directly if they do not straddle segment boundaries, so the
+// segments must not be modified or reused while val is still in use.
+func DecodeReader(r iov.BufferReader, val interface{}) (int, error) {
+    return decoder.DecodeReader(r, val)
+}
+
+// DecodeReaderWithOptions is like DecodeReader, but with the decoding limits overridden by options.
+func DecodeReaderWithOptions(r iov.BufferReader, val interface{}, options ...Option) (int, error) {
+    return decoder.DecodeReaderWithOptions(r, val, applyOptions(options))
+}
+
+// DecodeCompactReader is like DecodeReader, but with Thrift Compact Protocol.
+func DecodeCompactReader(r iov.BufferReader, val interface{}) (int, error) {
+    return decoder.DecodeCompactReader(r, val)
+}
+
+// DecodeCompactReaderWithOptions is like DecodeCompactReader, but with the decoding limits overridden by options.
+func DecodeCompactReaderWithOptions(r iov.BufferReader, val interface{}, options ...Option) (int, error) {
+    return decoder.DecodeCompactReaderWithOptions(r, val, applyOptions(options))
+}
+
+// DecodeGeneric deserializes a struct from buf with Thrift Binary Protocol into a generic value tree,
+// which requires no Go type, the field IDs and the wire types are all that is known about the values.
+func DecodeGeneric(buf []byte) (generic.Struct, int, error) {
+    return decoder.DecodeGeneric(buf)
+}
+
+// GenericEncodedSize measures the encoded size of a generic value tree.
+func GenericEncodedSize(val generic.Struct) int {
+    return encoder.GenericEncodedSize(val)
+}
+
+// EncodeGeneric serializes a generic value tree into buf with Thrift Binary Protocol, it is the inverse of
+// DecodeGeneric, except that fields are always written in the order of ascending IDs.
+// buf must be large enough to contain the entire serialization result.
+func EncodeGeneric(buf []byte, val generic.Struct) (int, error) {
+    return encoder.EncodeGeneric(buf, val)
+}
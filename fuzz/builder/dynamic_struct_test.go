@@ -15,21 +15,27 @@
 package main
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"reflect"
 	"testing"
 
-	"github.com/cloudwego/thriftgo/parser"
-	"github.com/cloudwego/thriftgo/semantic"
+	"github.com/cloudwego/frugal"
 )
 
 var StructThrift = `
+struct Baz {
+	1: required string a,
+	2: optional i64 b,
+}
+
 struct Bar {
-	1: required list<Bar> a,
-	2: optional list<Bar> b,
-	3: required map<string,Bar> c,
-	4: optional map<string,Bar> d,
-	5: required set<Bar> e,
-	6: optional set<Bar> f,
+	1: required list<Baz> a,
+	2: optional list<Baz> b,
+	3: required map<string,Baz> c,
+	4: optional map<string,Baz> d,
+	5: required set<Baz> e,
+	6: optional set<Baz> f,
 }
 
 struct Foo {
@@ -61,25 +67,21 @@ struct Foo {
 `
 
 func TestBuildStructFromThrift(t *testing.T) {
-	tree, err := parser.ParseString("root.thrift", StructThrift)
-	if err != nil {
+	file := filepath.Join(t.TempDir(), "root.thrift")
+	if err := ioutil.WriteFile(file, []byte(StructThrift), 0o644); err != nil {
 		t.Fatal(err)
 	}
-	checker := semantic.NewChecker(semantic.Options{FixWarnings: true})
-	_, err = checker.CheckAll(tree)
+	types, err := BuildThriftStruct(file)
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = semantic.ResolveSymbols(tree)
-	if err != nil {
-		t.Fatal(err)
+	if len(types) != 3 {
+		t.Fatalf("expected 3 types, got %d", len(types))
 	}
-	builder := NewStructBuilder()
-	for _, st := range tree.GetStructLikes() {
-		ts, err := builder.buildStructFromAST(tree, st)
-		if err != nil {
+	for _, st := range types {
+		v := reflect.New(st.Elem()).Interface()
+		if _, err := frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v); err != nil {
 			t.Fatal(err)
 		}
-		t.Logf("%#v", reflect.New(ts.Type).Interface())
 	}
 }
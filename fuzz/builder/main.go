@@ -110,13 +110,12 @@ func main() {
 	flag.Parse()
 	checkArgs()
 	for thrift := range ThriftSearcher() {
-		builder := NewStructBuilder()
-		flow, err := builder.BuildThriftStruct(thrift)
+		types, err := BuildThriftStruct(thrift)
 		if err != nil {
 			log.Println(fmt.Errorf("build struct for %s failed: %w", thrift, err))
 			continue
 		}
-		for st := range flow {
+		for _, st := range types {
 			data := reflect.New(st.Elem()).Interface()
 			// FIXME: prevent duplicate elements in sets
 			err = gofakeit.Struct(data)
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package generic defines a dynamic value tree of Thrift Binary Protocol data, which is used
+// by frugal.DecodeGeneric and frugal.EncodeGeneric to inspect and rewrite payloads without
+// any Go type.
+//
+// Only the wire types are known without the IDL, so the values are represented as:
+//
+//     bool, byte, i16, i32, i64, double  ->  Bool, I8, I16, I32, I64, Double
+//     string, binary                     ->  Binary
+//     struct, union, exception           ->  Struct
+//     list<T>, set<T>, map<K, V>         ->  List, Set, Map
+//
+// Containers carry their element types, so empty containers survive a round-trip.
+package generic
+
+import (
+    `fmt`
+)
+
+// Type is the Thrift wire type of a value.
+type Type uint8
+
+const (
+    TypeBool   Type = 2
+    TypeI8     Type = 3
+    TypeDouble Type = 4
+    TypeI16    Type = 6
+    TypeI32    Type = 8
+    TypeI64    Type = 10
+    TypeBinary Type = 11
+    TypeStruct Type = 12
+    TypeMap    Type = 13
+    TypeSet    Type = 14
+    TypeList   Type = 15
+)
+
+var typeNames = [256]string {
+    TypeBool   : "bool",
+    TypeI8     : "i8",
+    TypeDouble : "double",
+    TypeI16    : "i16",
+    TypeI32    : "i32",
+    TypeI64    : "i64",
+    TypeBinary : "binary",
+    TypeStruct : "struct",
+    TypeMap    : "map",
+    TypeSet    : "set",
+    TypeList   : "list",
+}
+
+// Valid reports whether t is a valid wire type.
+func (self Type) Valid() bool {
+    return typeNames[self] != ""
+}
+
+func (self Type) String() string {
+    if self.Valid() {
+        return typeNames[self]
+    } else {
+        return fmt.Sprintf("Type(%d)", uint8(self))
+    }
+}
+
+// Value is a node in the value tree, which is one of Bool, I8, I16, I32, I64, Double, Binary,
+// Struct, List, Set and Map.
+type Value interface {
+    Type() Type
+}
+
+type (
+    Bool   bool
+    I8     int8
+    I16    int16
+    I32    int32
+    I64    int64
+    Double float64
+    Binary []byte
+)
+
+// Struct is a Thrift struct, with values indexed by the field IDs.
+//
+// The wire order of fields is not kept, fields are encoded in the order of ascending IDs.
+type Struct struct {
+    Fields map[int16]Value
+}
+
+// List is a Thrift list, all the values must be of the type Elem.
+type List struct {
+    Elem   Type
+    Values []Value
+}
+
+// Set is a Thrift set, all the values must be of the type Elem.
+//
+// Sets are kept as-is, no deduplication is performed.
+type Set struct {
+    Elem   Type
+    Values []Value
+}
+
+// Map is a Thrift map, all the keys and values must be of the type Key and Elem respectively.
+//
+// Keys can be of any type, including the ones that are not comparable in Go, so the map is kept
+// as a list of key-value pairs in the wire order.
+type Map struct {
+    Key   Type
+    Elem  Type
+    Pairs []Pair
+}
+
+// Pair is a key-value pair of a Map.
+type Pair struct {
+    Key   Value
+    Value Value
+}
+
+func (Bool)   Type() Type { return TypeBool }
+func (I8)     Type() Type { return TypeI8 }
+func (I16)    Type() Type { return TypeI16 }
+func (I32)    Type() Type { return TypeI32 }
+func (I64)    Type() Type { return TypeI64 }
+func (Double) Type() Type { return TypeDouble }
+func (Binary) Type() Type { return TypeBinary }
+func (Struct) Type() Type { return TypeStruct }
+func (List)   Type() Type { return TypeList }
+func (Set)    Type() Type { return TypeSet }
+func (Map)    Type() Type { return TypeMap }
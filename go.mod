@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/chenzhuoyu/iasm v0.9.0
+	github.com/cloudwego/thriftgo v0.2.4
 	github.com/davecgh/go-spew v1.1.1
 	github.com/klauspost/cpuid/v2 v2.2.4
 	github.com/oleiade/lane v1.0.1
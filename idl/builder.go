@@ -0,0 +1,366 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idl
+
+import (
+    `fmt`
+    `reflect`
+    `strings`
+
+    `github.com/cloudwego/thriftgo/parser`
+    `github.com/cloudwego/thriftgo/semantic`
+)
+
+var (
+    enumType  = reflect.TypeOf(Enum(0))
+    bytesType = reflect.TypeOf([]byte(nil))
+)
+
+/* unionMarker marks the struct as a union, it must not take part in the field indices */
+var unionMarker = reflect.StructField {
+    Name    : "_",
+    PkgPath : reflect.TypeOf(IDL{}).PkgPath(),
+    Type    : reflect.TypeOf(struct{}{}),
+    Tag     : `frugal:"_union"`,
+}
+
+var scalarTypes = map[parser.Category]reflect.Type {
+    parser.Category_Bool   : reflect.TypeOf(false),
+    parser.Category_Byte   : reflect.TypeOf(int8(0)),
+    parser.Category_I16    : reflect.TypeOf(int16(0)),
+    parser.Category_I32    : reflect.TypeOf(int32(0)),
+    parser.Category_I64    : reflect.TypeOf(int64(0)),
+    parser.Category_Double : reflect.TypeOf(float64(0)),
+    parser.Category_String : reflect.TypeOf(""),
+}
+
+type _Builder struct {
+    ret     *IDL
+    ast     *parser.Thrift
+    names   map[*parser.Thrift]string
+    order   []*parser.Thrift
+    busy    map[interface{}]bool
+    consts  map[*parser.Constant]reflect.Value
+    structs map[*parser.StructLike]reflect.Type
+}
+
+func newBuilder(ast *parser.Thrift) *_Builder {
+    return &_Builder {
+        ast     : ast,
+        names   : make(map[*parser.Thrift]string),
+        busy    : make(map[interface{}]bool),
+        consts  : make(map[*parser.Constant]reflect.Value),
+        structs : make(map[*parser.StructLike]reflect.Type),
+        ret     : &IDL {
+            types  : make(map[string]reflect.Type),
+            enums  : make(map[string]map[string]Enum),
+            consts : make(map[string]reflect.Value),
+            funcs  : make(map[string]map[string]Function),
+            values : make(map[reflect.Type][]_Default),
+        },
+    }
+}
+
+func (self *_Builder) build() (ret *IDL, err error) {
+    defer self.rescue(&err)
+    self.prefix(self.ast, "")
+
+    /* enums first, other definitions might refer to them */
+    for _, ast := range self.order {
+        for _, ev := range ast.Enums {
+            self.enum(ast, ev)
+        }
+    }
+
+    /* then all the definitions */
+    for _, ast := range self.order {
+        for _, sl := range ast.GetStructLikes() {
+            self.ret.types[self.names[ast] + sl.Name] = self.structOf(ast, sl)
+        }
+        for _, cv := range ast.Constants {
+            self.ret.consts[self.names[ast] + cv.Name] = self.constant(ast, cv)
+        }
+        for _, sv := range ast.Services {
+            self.ret.funcs[self.names[ast] + sv.Name] = self.service(ast, sv)
+        }
+    }
+
+    /* all done */
+    return self.ret, nil
+}
+
+func (self *_Builder) rescue(ep *error) {
+    if val := recover(); val != nil {
+        if err, ok := val.(error); ok {
+            *ep = err
+        } else {
+            panic(val)
+        }
+    }
+}
+
+func (self *_Builder) prefix(ast *parser.Thrift, name string) {
+    if _, ok := self.names[ast]; !ok {
+        self.names[ast] = name
+        self.order = append(self.order, ast)
+
+        /* included files are qualified with their names */
+        for _, inc := range ast.Includes {
+            self.prefix(inc.Reference, semantic.IDLPrefix(inc.Path) + ".")
+        }
+    }
+}
+
+func (self *_Builder) enum(ast *parser.Thrift, ev *parser.Enum) {
+    ret := make(map[string]Enum, len(ev.Values))
+    for _, v := range ev.Values { ret[v.Name] = Enum(v.Value) }
+    self.ret.enums[self.names[ast] + ev.Name] = ret
+}
+
+func (self *_Builder) structOf(ast *parser.Thrift, sl *parser.StructLike) reflect.Type {
+    if vt, ok := self.structs[sl]; ok {
+        return vt
+    }
+
+    /* reflect.StructOf cannot build recursive types */
+    if self.busy[sl] {
+        panic(fmt.Errorf("idl: recursive type %s%s is not supported", self.names[ast], sl.Name))
+    }
+
+    /* all the fields of a union are optional */
+    fvs := sl.Fields
+    union := sl.Category == "union"
+    if union { fvs = optional(fvs) }
+
+    /* build all the fields, the union marker goes last to keep the field indices */
+    self.busy[sl] = true
+    sf := self.fields(ast, fvs)
+    if union { sf = append(sf, unionMarker) }
+    vt := reflect.StructOf(sf)
+
+    /* resolve the default values */
+    for i, fv := range sl.Fields {
+        if fv.Default != nil {
+            self.ret.values[vt] = append(self.ret.values[vt], _Default { i, self.valueOf(ast, fv.Type, ast, fv.Default) })
+        }
+    }
+
+    /* add to the cache */
+    delete(self.busy, sl)
+    self.structs[sl] = vt
+    return vt
+}
+
+func (self *_Builder) fields(ast *parser.Thrift, fvs []*parser.Field) []reflect.StructField {
+    ret := make([]reflect.StructField, 0, len(fvs))
+    used := make(map[string]bool, len(fvs))
+
+    /* build each field */
+    for _, fv := range fvs {
+        vt, td := self.typeOf(ast, fv.Type)
+        rx, tx := requiredness(fv.Requiredness)
+
+        /* optional scalars must be pointers to tell if they are set */
+        if fv.Requiredness == parser.FieldType_Optional && isScalar(vt) {
+            vt = reflect.PtrTo(vt)
+        }
+
+        /* add the field */
+        ret = append(ret, reflect.StructField {
+            Name : fieldName(fv.Name, used),
+            Type : vt,
+            Tag  : reflect.StructTag(fmt.Sprintf(`frugal:"%d,%s,%s" thrift:"%s,%d%s"`, fv.ID, rx, td, fv.Name, fv.ID, tx)),
+        })
+    }
+
+    /* all done */
+    return ret
+}
+
+func (self *_Builder) typeOf(ast *parser.Thrift, t *parser.Type) (reflect.Type, string) {
+    ast, t = self.deref(ast, t)
+    vt := scalarTypes[t.Category]
+
+    /* check for the type category */
+    switch t.Category {
+        case parser.Category_Binary    : return bytesType, "binary"
+        case parser.Category_Enum      : return enumType, enumType.Name()
+        case parser.Category_Map       : return self.mapOf(ast, t)
+        case parser.Category_List      : return self.sliceOf(ast, t, "list")
+        case parser.Category_Set       : return self.sliceOf(ast, t, "set")
+        case parser.Category_Struct    : return reflect.PtrTo(self.structOf(ast, self.lookup(ast, t.Name))), "struct"
+        case parser.Category_Union     : return reflect.PtrTo(self.structOf(ast, self.lookup(ast, t.Name))), "struct"
+        case parser.Category_Exception : return reflect.PtrTo(self.structOf(ast, self.lookup(ast, t.Name))), "struct"
+    }
+
+    /* must be a scalar type */
+    if vt == nil {
+        panic(fmt.Errorf("idl: unsupported type %s in %s", t.Name, ast.Filename))
+    } else {
+        return vt, t.Name
+    }
+}
+
+func (self *_Builder) mapOf(ast *parser.Thrift, t *parser.Type) (reflect.Type, string) {
+    kt, kd := self.typeOf(ast, t.KeyType)
+    vt, vd := self.typeOf(ast, t.ValueType)
+
+    /* binary and container keys are not comparable in Go */
+    if !kt.Comparable() {
+        panic(fmt.Errorf("idl: unsupported map key type %s in %s", t.KeyType.Name, ast.Filename))
+    } else {
+        return reflect.MapOf(kt, vt), fmt.Sprintf("map<%s:%s>", kd, vd)
+    }
+}
+
+func (self *_Builder) sliceOf(ast *parser.Thrift, t *parser.Type, kind string) (reflect.Type, string) {
+    et, ed := self.typeOf(ast, t.ValueType)
+    return reflect.SliceOf(et), fmt.Sprintf("%s<%s>", kind, ed)
+}
+
+func (self *_Builder) deref(ast *parser.Thrift, t *parser.Type) (*parser.Thrift, *parser.Type) {
+    if ast, t, err := semantic.Deref(ast, t); err != nil {
+        panic(fmt.Errorf("idl: %w", err))
+    } else {
+        return ast, t
+    }
+}
+
+func (self *_Builder) lookup(ast *parser.Thrift, name string) *parser.StructLike {
+    for _, sl := range ast.GetStructLikes() {
+        if sl.Name == name {
+            return sl
+        }
+    }
+    panic(fmt.Errorf("idl: type %s is not defined in %s", name, ast.Filename))
+}
+
+func (self *_Builder) service(ast *parser.Thrift, sv *parser.Service) map[string]Function {
+    ret := make(map[string]Function)
+    self.functions(ast, sv, ret)
+    return ret
+}
+
+func (self *_Builder) functions(ast *parser.Thrift, sv *parser.Service, ret map[string]Function) {
+    for _, fn := range sv.Functions {
+        if _, ok := ret[fn.Name]; !ok {
+            ret[fn.Name] = self.function(ast, fn)
+        }
+    }
+
+    /* no base services */
+    if sv.Extends == "" {
+        return
+    }
+
+    /* find the base service */
+    ok := false
+    name := sv.Extends
+
+    /* it might be defined in another file */
+    if ref := sv.Reference; ref != nil {
+        ast = ast.Includes[ref.Index].Reference
+        sv, ok = ast.GetService(ref.Name)
+    } else {
+        sv, ok = ast.GetService(name)
+    }
+
+    /* add the functions of the base service */
+    if !ok {
+        panic(fmt.Errorf("idl: base service %s is not defined in %s", name, ast.Filename))
+    } else {
+        self.functions(ast, sv, ret)
+    }
+}
+
+func (self *_Builder) function(ast *parser.Thrift, fn *parser.Function) Function {
+    ret := Function {
+        Name   : fn.Name,
+        Oneway : fn.Oneway,
+        Args   : reflect.StructOf(self.fields(ast, fn.Arguments)),
+    }
+
+    /* oneway functions have no results */
+    if fn.Oneway {
+        return ret
+    }
+
+    /* the result struct is a union of the return value and the exceptions */
+    rv := make([]*parser.Field, 0, len(fn.Throws) + 1)
+
+    /* the return value is field 0 named "success" */
+    if !fn.Void {
+        rv = append(rv, &parser.Field {
+            ID           : 0,
+            Name         : "success",
+            Type         : fn.FunctionType,
+            Requiredness : parser.FieldType_Optional,
+        })
+    }
+
+    /* build the result type, all the exceptions are optional */
+    ret.Result = reflect.StructOf(self.fields(ast, append(rv, optional(fn.Throws)...)))
+    return ret
+}
+
+func optional(fvs []*parser.Field) []*parser.Field {
+    ret := make([]*parser.Field, len(fvs))
+
+    /* copy the fields, the AST is left untouched */
+    for i, fv := range fvs {
+        ret[i] = new(parser.Field)
+        *ret[i] = *fv
+        ret[i].Requiredness = parser.FieldType_Optional
+    }
+
+    /* all done */
+    return ret
+}
+
+func requiredness(rx parser.FieldType) (string, string) {
+    switch rx {
+        case parser.FieldType_Required : return "required", ",required"
+        case parser.FieldType_Optional : return "optional", ",optional"
+        default                        : return "default", ""
+    }
+}
+
+func isScalar(vt reflect.Type) bool {
+    switch vt.Kind() {
+        case reflect.Ptr   : return false
+        case reflect.Map   : return false
+        case reflect.Slice : return false
+        default            : return true
+    }
+}
+
+func fieldName(name string, used map[string]bool) string {
+    if name[0] == '_' {
+        name = "F" + name
+    } else {
+        name = strings.ToUpper(name[:1]) + name[1:]
+    }
+
+    /* distinct names in the IDL might be the same after capitalized */
+    for used[name] {
+        name += "_"
+    }
+
+    /* mark as used */
+    used[name] = true
+    return name
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package idl loads Thrift IDL files at runtime, and builds Go types that can be used
+// with frugal.EncodeObject and frugal.DecodeObject, without generating any code.
+//
+// Since named types cannot be created at runtime, every struct, union and exception is
+// built as an anonymous struct type with reflect.StructOf, and every enum is represented
+// by the Enum type. The Go types follow the same conventions as the code generated by
+// thriftgo:
+//
+//     bool, byte, i16, i32, i64, double, string  ->  bool, int8, int16, int32, int64, float64, string
+//     binary                                     ->  []byte
+//     enums                                      ->  idl.Enum
+//     list<T>, set<T>                            ->  []T
+//     map<K, V>                                  ->  map[K]V
+//     structs, unions and exceptions             ->  *struct { ... }
+//
+// Optional fields of scalar types are pointers. Field names are the names in the IDL with
+// the first letter capitalized. All the fields of a union are optional, and a blank field
+// tagged with `frugal:"_union"` is added after them, so exactly one of them must be set.
+//
+// Recursive types cannot be built with reflect.StructOf, so IDLs containing them are rejected.
+package idl
+
+import (
+    `fmt`
+    `reflect`
+    `sort`
+    `strconv`
+
+    `github.com/cloudwego/thriftgo/parser`
+    `github.com/cloudwego/thriftgo/semantic`
+)
+
+// Enum is the Go type of all the Thrift enums.
+type Enum int64
+
+// String returns the numeric value of the enum, the names are available from IDL.Enum.
+func (self Enum) String() string {
+    return strconv.FormatInt(int64(self), 10)
+}
+
+// Function describes a function of a Thrift service, Args and Result are the struct types
+// of the arguments and the result, which are serialized into the Thrift messages.
+type Function struct {
+    Name   string
+    Oneway bool
+    Args   reflect.Type
+    Result reflect.Type
+}
+
+// IDL is the result of loading a Thrift IDL and all of its includes.
+//
+// Definitions in the loaded file are named as-is, definitions in included files are
+// qualified with the name of the file, just like how they are referenced in the IDL,
+// e.g. "base.Base" for the "Base" struct defined in "base.thrift".
+type IDL struct {
+    types  map[string]reflect.Type
+    enums  map[string]map[string]Enum
+    consts map[string]reflect.Value
+    funcs  map[string]map[string]Function
+    values map[reflect.Type][]_Default
+}
+
+type _Default struct {
+    i int
+    v reflect.Value
+}
+
+// Load parses the Thrift IDL at path, resolving its includes in the directory of the
+// including file first, then in includeDirs.
+func Load(path string, includeDirs ...string) (*IDL, error) {
+    var err error
+    var ast *parser.Thrift
+
+    /* parse the IDL recursively */
+    if ast, err = parser.ParseFile(path, includeDirs, true); err != nil {
+        return nil, fmt.Errorf("idl: cannot parse %s: %w", path, err)
+    }
+
+    /* resolve all the symbols */
+    if err = semantic.ResolveSymbols(ast); err != nil {
+        return nil, fmt.Errorf("idl: cannot resolve %s: %w", path, err)
+    }
+
+    /* build all the types */
+    return newBuilder(ast).build()
+}
+
+// Type returns the struct type (not the pointer type) of the struct, union or exception
+// with the given name, or nil if not found.
+func (self *IDL) Type(name string) reflect.Type {
+    return self.types[name]
+}
+
+// Types returns all the struct types keyed by their names.
+func (self *IDL) Types() map[string]reflect.Type {
+    ret := make(map[string]reflect.Type, len(self.types))
+    for k, v := range self.types { ret[k] = v }
+    return ret
+}
+
+// TypeNames returns the sorted names of all the struct types.
+func (self *IDL) TypeNames() []string {
+    ret := make([]string, 0, len(self.types))
+    for k := range self.types { ret = append(ret, k) }
+    sort.Strings(ret)
+    return ret
+}
+
+// Enum returns the values of the enum with the given name keyed by their names.
+func (self *IDL) Enum(name string) (map[string]Enum, bool) {
+    if vm, ok := self.enums[name]; !ok {
+        return nil, false
+    } else {
+        ret := make(map[string]Enum, len(vm))
+        for k, v := range vm { ret[k] = v }
+        return ret, true
+    }
+}
+
+// Constant returns the value of the constant with the given name, the type of the value
+// is the Go type of the constant type.
+func (self *IDL) Constant(name string) (interface{}, bool) {
+    if v, ok := self.consts[name]; !ok {
+        return nil, false
+    } else {
+        return clone(v).Interface(), true
+    }
+}
+
+// Function returns the function of the service with the given names, including the
+// functions inherited from the base services.
+func (self *IDL) Function(service string, name string) (Function, bool) {
+    fn, ok := self.funcs[service][name]
+    return fn, ok
+}
+
+// New allocates a new value of the struct type with the given name, with all the default
+// values declared in the IDL, and returns the pointer to it.
+//
+// Note that only New initializes the default values, values allocated by the decoder
+// (e.g. nested structs) start with zero values.
+func (self *IDL) New(name string) (interface{}, error) {
+    if vt, ok := self.types[name]; !ok {
+        return nil, fmt.Errorf("idl: type %s is not defined", name)
+    } else {
+        return self.alloc(vt).Interface(), nil
+    }
+}
+
+func (self *IDL) alloc(vt reflect.Type) reflect.Value {
+    ret := reflect.New(vt)
+    val := ret.Elem()
+
+    /* fill all the default values */
+    for _, dv := range self.values[vt] {
+        assign(val.Field(dv.i), clone(dv.v))
+    }
+
+    /* all done */
+    return ret
+}
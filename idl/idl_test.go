@@ -0,0 +1,169 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idl
+
+import (
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+func loadTestIDL(t *testing.T) *IDL {
+    ret, err := Load("testdata/main.thrift")
+    require.NoError(t, err)
+    return ret
+}
+
+func roundTrip(t *testing.T, v interface{}) {
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    r := reflect.New(reflect.TypeOf(v).Elem())
+    _, err = frugal.DecodeObject(buf, r.Interface())
+    require.NoError(t, err)
+    require.Equal(t, v, r.Interface())
+}
+
+func TestIDL_Types(t *testing.T) {
+    p := loadTestIDL(t)
+    require.Equal(t, []string {
+        "Item",
+        "Request",
+        "Response",
+        "Target",
+        "base.Base",
+        "base.BaseResp",
+        "base.Error",
+    }, p.TypeNames())
+    vt := p.Type("Item")
+    require.Equal(t, reflect.TypeOf(int64(0)), vt.Field(0).Type)
+    require.Equal(t, reflect.TypeOf((*Enum)(nil)), vt.Field(1).Type)
+    require.Equal(t, reflect.TypeOf([]byte(nil)), vt.Field(2).Type)
+    require.Equal(t, reflect.TypeOf([]string(nil)), vt.Field(3).Type)
+    require.Equal(t, `frugal:"4,default,set<string>" thrift:"tags,4"`, string(vt.Field(3).Tag))
+    require.Equal(t, "Id", vt.Field(0).Name)
+    require.Nil(t, p.Type("Node"))
+    require.Equal(t, reflect.PtrTo(p.Type("base.Base")), p.Type("Request").Field(9).Type)
+}
+
+func TestIDL_Values(t *testing.T) {
+    p := loadTestIDL(t)
+    ev, ok := p.Enum("base.Status")
+    require.True(t, ok)
+    require.Equal(t, map[string]Enum { "OK": 0, "Failed": 1, "Retry": 5 }, ev)
+    cv, ok := p.Constant("Admins")
+    require.True(t, ok)
+    require.Equal(t, []int64 { 1, 2, 3 }, cv)
+    cv, ok = p.Constant("StatusNames")
+    require.True(t, ok)
+    require.Equal(t, map[string]Enum { "ok": 0, "retry": 5 }, cv)
+    cv, ok = p.Constant("Ratio")
+    require.True(t, ok)
+    require.Equal(t, 1.0, cv)
+    cv, ok = p.Constant("Limit")
+    require.True(t, ok)
+    require.Equal(t, int32(100), cv)
+    v, err := p.New("Request")
+    require.NoError(t, err)
+    rv := reflect.ValueOf(v).Elem()
+    require.Equal(t, int32(100), rv.FieldByName("Limit").Elem().Interface())
+    require.Equal(t, []int64 { 1, 2, 3 }, rv.FieldByName("Admins").Interface())
+    require.Equal(t, Enum(5), rv.FieldByName("Status").Interface())
+    require.Equal(t, Enum(2), rv.FieldByName("Kind").Interface())
+    require.Equal(t, true, rv.FieldByName("Flag").Interface())
+    require.Equal(t, "default", rv.FieldByName("Base").Elem().Field(0).Interface())
+    require.Equal(t, "gateway", rv.FieldByName("Base").Elem().Field(1).Elem().Interface())
+    rv.FieldByName("Admins").Index(0).SetInt(100)
+    v, err = p.New("Request")
+    require.NoError(t, err)
+    require.Equal(t, []int64 { 1, 2, 3 }, reflect.ValueOf(v).Elem().FieldByName("Admins").Interface())
+    _, err = p.New("Node")
+    require.Error(t, err)
+}
+
+func TestIDL_Codec(t *testing.T) {
+    p := loadTestIDL(t)
+    v, err := p.New("Request")
+    require.NoError(t, err)
+    rv := reflect.ValueOf(v).Elem()
+    rv.FieldByName("Name").SetString("foo")
+    item, err := p.New("Item")
+    require.NoError(t, err)
+    iv := reflect.ValueOf(item).Elem()
+    iv.FieldByName("Id").SetInt(123)
+    iv.FieldByName("Data").SetBytes([]byte("bar"))
+    iv.FieldByName("Tags").Set(reflect.ValueOf([]string { "a", "b" }))
+    rv.FieldByName("Items").Set(reflect.Append(rv.FieldByName("Items"), reflect.ValueOf(item)))
+    rv.FieldByName("Index").Set(reflect.MakeMap(rv.FieldByName("Index").Type()))
+    rv.FieldByName("Index").SetMapIndex(reflect.ValueOf(int64(123)), reflect.ValueOf(item))
+    target, err := p.New("Target")
+    require.NoError(t, err)
+    reflect.ValueOf(target).Elem().FieldByName("Users").Set(reflect.ValueOf([]int64 { 4, 5 }))
+    rv.FieldByName("Target").Set(reflect.ValueOf(target))
+    roundTrip(t, v)
+}
+
+func TestIDL_Union(t *testing.T) {
+    p := loadTestIDL(t)
+    vt := p.Type("Target")
+    require.Equal(t, `frugal:"2,optional,list<i64>" thrift:"users,2,optional"`, string(vt.Field(1).Tag))
+    require.Equal(t, `frugal:"_union"`, string(vt.Field(2).Tag))
+    v, err := p.New("Target")
+    require.NoError(t, err)
+    _, err = frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+    require.Error(t, err)
+    item, err := p.New("Item")
+    require.NoError(t, err)
+    rv := reflect.ValueOf(v).Elem()
+    rv.FieldByName("Item").Set(reflect.ValueOf(item))
+    _, err = frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+    require.NoError(t, err)
+    rv.FieldByName("Users").Set(reflect.ValueOf([]int64 { 1 }))
+    _, err = frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+    require.Error(t, err)
+}
+
+func TestIDL_Function(t *testing.T) {
+    p := loadTestIDL(t)
+    fn, ok := p.Function("UserService", "Query")
+    require.True(t, ok)
+    require.False(t, fn.Oneway)
+    require.Equal(t, reflect.PtrTo(p.Type("Request")), fn.Args.Field(0).Type)
+    require.Equal(t, `frugal:"0,optional,struct" thrift:"success,0,optional"`, string(fn.Result.Field(0).Tag))
+    require.Equal(t, reflect.PtrTo(p.Type("base.Error")), fn.Result.Field(1).Type)
+    fn, ok = p.Function("UserService", "Notify")
+    require.True(t, ok)
+    require.True(t, fn.Oneway)
+    require.Nil(t, fn.Result)
+    fn, ok = p.Function("UserService", "Ping")
+    require.True(t, ok)
+    require.Equal(t, 0, fn.Result.NumField())
+    _, ok = p.Function("base.BaseService", "Ping")
+    require.True(t, ok)
+    _, ok = p.Function("base.BaseService", "Query")
+    require.False(t, ok)
+    roundTrip(t, reflect.New(fn.Args).Interface())
+}
+
+func TestIDL_Recursive(t *testing.T) {
+    _, err := Load("testdata/recursive.thrift")
+    require.Error(t, err)
+    _, err = Load("testdata/notfound.thrift")
+    require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+namespace go base
+
+const i32 MaxItems = 100
+
+enum Status {
+    OK = 0,
+    Failed = 1,
+    Retry = 5,
+}
+
+struct Base {
+    1: string LogID = ""
+    2: optional string Caller
+    3: optional map<string, string> Extra
+}
+
+struct BaseResp {
+    1: string StatusMessage = "ok"
+    2: Status StatusCode = Status.OK
+}
+
+exception Error {
+    1: required i32 code
+    2: optional string message
+}
+
+service BaseService {
+    void Ping()
+}
@@ -0,0 +1,55 @@
+namespace go main
+
+include "base.thrift"
+
+typedef i64 UserID
+typedef list<UserID> UserIDs
+typedef base.Status Status
+
+const UserID Admin = 1
+const UserIDs Admins = [Admin, 2, 3]
+const map<string, Status> StatusNames = { "ok": base.Status.OK, "retry": base.Status.Retry }
+const base.Base DefaultBase = { "LogID": "default", "Caller": "gateway" }
+const double Ratio = 1
+const i32 Limit = base.MaxItems
+
+enum Kind {
+    User = 1,
+    Group = 2,
+}
+
+struct Item {
+    1: required UserID id
+    2: optional Kind kind
+    3: binary data
+    4: set<string> tags
+    5: optional double score = 0.5
+}
+
+union Target {
+    1: Item item
+    2: UserIDs users
+}
+
+struct Request {
+    1: required string name
+    2: optional i32 limit = Limit
+    3: list<Item> items
+    4: map<i64, Item> index
+    5: Target target
+    6: UserIDs admins = Admins
+    7: base.Status status = base.Status.Retry
+    8: Kind kind = Kind.Group
+    9: bool flag = true
+    255: base.Base Base = DefaultBase
+}
+
+struct Response {
+    1: list<Item> items
+    255: base.BaseResp BaseResp
+}
+
+service UserService extends base.BaseService {
+    Response Query(1: Request req) throws (1: base.Error err)
+    oneway void Notify(1: UserID id)
+}
@@ -0,0 +1,4 @@
+struct Node {
+    1: i64 value
+    2: optional Node next
+}
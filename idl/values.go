@@ -0,0 +1,295 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idl
+
+import (
+    `fmt`
+    `reflect`
+
+    `github.com/cloudwego/thriftgo/parser`
+)
+
+func (self *_Builder) constant(ast *parser.Thrift, cv *parser.Constant) reflect.Value {
+    if rv, ok := self.consts[cv]; ok {
+        return rv
+    }
+
+    /* constants cannot refer to themselves */
+    if self.busy[cv] {
+        panic(fmt.Errorf("idl: recursive constant %s%s", self.names[ast], cv.Name))
+    }
+
+    /* evaluate the constant */
+    self.busy[cv] = true
+    rv := self.valueOf(ast, cv.Type, ast, cv.Value)
+
+    /* add to the cache */
+    delete(self.busy, cv)
+    self.consts[cv] = rv
+    return rv
+}
+
+// valueOf converts a constant value into the Go type of a Thrift type, since types and values
+// might come from different files, identifiers in the type are resolved within `ta`, while
+// identifiers in the value are resolved within `va`.
+func (self *_Builder) valueOf(ta *parser.Thrift, t *parser.Type, va *parser.Thrift, cv *parser.ConstValue) reflect.Value {
+    vt, _ := self.typeOf(ta, t)
+    ta, t = self.deref(ta, t)
+
+    /* check for value types */
+    switch cv.Type {
+        case parser.ConstType_ConstIdentifier : return self.identOf(vt, t, va, cv)
+        case parser.ConstType_ConstInt        : return intOf(vt, t, cv, cv.TypedValue.GetInt())
+        case parser.ConstType_ConstDouble     : return doubleOf(vt, t, cv)
+        case parser.ConstType_ConstLiteral    : return literalOf(vt, t, cv)
+        case parser.ConstType_ConstList       : return self.listOf(vt, ta, t, va, cv)
+        case parser.ConstType_ConstMap        : return self.mapValueOf(vt, ta, t, va, cv)
+        default                               : panic(mismatch(t, cv))
+    }
+}
+
+func (self *_Builder) identOf(vt reflect.Type, t *parser.Type, va *parser.Thrift, cv *parser.ConstValue) reflect.Value {
+    ok := false
+    id := cv.TypedValue.GetIdentifier()
+
+    /* boolean values */
+    if id == "true" || id == "false" {
+        if vt.Kind() != reflect.Bool {
+            panic(mismatch(t, cv))
+        } else {
+            return reflect.ValueOf(id == "true").Convert(vt)
+        }
+    }
+
+    /* must be resolved by now */
+    if cv.Extra == nil {
+        panic(fmt.Errorf("idl: undefined value %s in %s", id, va.Filename))
+    }
+
+    /* it might be defined in another file */
+    if cv.Extra.Index >= 0 {
+        va = va.Includes[cv.Extra.Index].Reference
+    }
+
+    /* values of other constants */
+    if !cv.Extra.IsEnum {
+        var rc *parser.Constant
+        var rv reflect.Value
+
+        /* evaluate the constant */
+        if rc, ok = va.GetConstant(cv.Extra.Name); !ok {
+            panic(fmt.Errorf("idl: undefined constant %s in %s", id, va.Filename))
+        } else if rv = self.constant(va, rc); rv.Type() == vt {
+            return rv
+        } else if isScalar(vt) && isScalar(rv.Type()) && rv.Type().ConvertibleTo(vt) {
+            return rv.Convert(vt)
+        } else {
+            panic(mismatch(t, cv))
+        }
+    }
+
+    /* find the enum */
+    ev, ok := va.GetEnum(cv.Extra.Sel)
+    if !ok {
+        panic(fmt.Errorf("idl: undefined enum %s in %s", cv.Extra.Sel, va.Filename))
+    }
+
+    /* find the enum value */
+    for _, v := range ev.Values {
+        if v.Name == cv.Extra.Name {
+            return intOf(vt, t, cv, v.Value)
+        }
+    }
+
+    /* the resolver guarantees it exists */
+    panic(fmt.Errorf("idl: undefined enum value %s in %s", id, va.Filename))
+}
+
+func (self *_Builder) listOf(vt reflect.Type, ta *parser.Thrift, t *parser.Type, va *parser.Thrift, cv *parser.ConstValue) reflect.Value {
+    if vt.Kind() != reflect.Slice || vt == bytesType {
+        panic(mismatch(t, cv))
+    }
+
+    /* convert every element */
+    ret := reflect.MakeSlice(vt, 0, len(cv.TypedValue.List))
+    for _, ev := range cv.TypedValue.List {
+        ret = reflect.Append(ret, self.valueOf(ta, t.ValueType, va, ev))
+    }
+
+    /* all done */
+    return ret
+}
+
+func (self *_Builder) mapValueOf(vt reflect.Type, ta *parser.Thrift, t *parser.Type, va *parser.Thrift, cv *parser.ConstValue) reflect.Value {
+    if vt.Kind() == reflect.Ptr {
+        return self.structValueOf(ta, t, va, cv)
+    } else if vt.Kind() != reflect.Map {
+        panic(mismatch(t, cv))
+    }
+
+    /* convert every key and value */
+    ret := reflect.MakeMapWithSize(vt, len(cv.TypedValue.Map))
+    for _, kv := range cv.TypedValue.Map {
+        ret.SetMapIndex(self.valueOf(ta, t.KeyType, va, kv.Key), self.valueOf(ta, t.ValueType, va, kv.Value))
+    }
+
+    /* all done */
+    return ret
+}
+
+func (self *_Builder) structValueOf(ta *parser.Thrift, t *parser.Type, va *parser.Thrift, cv *parser.ConstValue) reflect.Value {
+    sl := self.lookup(ta, t.Name)
+    rv := self.ret.alloc(self.structOf(ta, sl))
+
+    /* struct values are maps of field names to values */
+    for _, kv := range cv.TypedValue.Map {
+        fv := -1
+        fn := kv.Key.TypedValue.GetLiteral()
+
+        /* find the field */
+        for i, f := range sl.Fields {
+            if kv.Key.Type == parser.ConstType_ConstLiteral && f.Name == fn {
+                fv = i
+                break
+            }
+        }
+
+        /* set the field value */
+        if fv < 0 {
+            panic(fmt.Errorf("idl: struct %s does not have field %s", sl.Name, kv.Key))
+        } else {
+            assign(rv.Elem().Field(fv), self.valueOf(ta, sl.Fields[fv].Type, va, kv.Value))
+        }
+    }
+
+    /* all done */
+    return rv
+}
+
+func intOf(vt reflect.Type, t *parser.Type, cv *parser.ConstValue, iv int64) reflect.Value {
+    rv := reflect.New(vt).Elem()
+
+    /* integers can be used as booleans and doubles */
+    switch vt.Kind() {
+        case reflect.Bool    : rv.SetBool(iv != 0)
+        case reflect.Int8    : rv.SetInt(iv)
+        case reflect.Int16   : rv.SetInt(iv)
+        case reflect.Int32   : rv.SetInt(iv)
+        case reflect.Int64   : rv.SetInt(iv)
+        case reflect.Float64 : rv.SetFloat(float64(iv))
+        default              : panic(mismatch(t, cv))
+    }
+
+    /* check for overflows */
+    if rv.Kind() != reflect.Bool && rv.Kind() != reflect.Float64 && rv.Int() != iv {
+        panic(fmt.Errorf("idl: value %d overflows %s", iv, t.Name))
+    } else {
+        return rv
+    }
+}
+
+func doubleOf(vt reflect.Type, t *parser.Type, cv *parser.ConstValue) reflect.Value {
+    if vt.Kind() != reflect.Float64 {
+        panic(mismatch(t, cv))
+    } else {
+        return reflect.ValueOf(cv.TypedValue.GetDouble()).Convert(vt)
+    }
+}
+
+func literalOf(vt reflect.Type, t *parser.Type, cv *parser.ConstValue) reflect.Value {
+    if vt.Kind() != reflect.String && vt != bytesType {
+        panic(mismatch(t, cv))
+    } else {
+        return reflect.ValueOf(cv.TypedValue.GetLiteral()).Convert(vt)
+    }
+}
+
+func mismatch(t *parser.Type, cv *parser.ConstValue) error {
+    return fmt.Errorf("idl: cannot use %s as %s", cv, t)
+}
+
+func assign(fv reflect.Value, rv reflect.Value) {
+    if fv.Kind() != reflect.Ptr || rv.Kind() == reflect.Ptr {
+        fv.Set(rv)
+    } else {
+        fv.Set(reflect.New(rv.Type()))
+        fv.Elem().Set(rv)
+    }
+}
+
+func clone(rv reflect.Value) reflect.Value {
+    switch rv.Kind() {
+        case reflect.Ptr    : return clonePtr(rv)
+        case reflect.Map    : return cloneMap(rv)
+        case reflect.Slice  : return cloneSlice(rv)
+        case reflect.Struct : return cloneStruct(rv)
+        default             : return rv
+    }
+}
+
+func clonePtr(rv reflect.Value) reflect.Value {
+    if rv.IsNil() {
+        return rv
+    } else {
+        ret := reflect.New(rv.Type().Elem())
+        ret.Elem().Set(clone(rv.Elem()))
+        return ret
+    }
+}
+
+func cloneMap(rv reflect.Value) reflect.Value {
+    if rv.IsNil() {
+        return rv
+    }
+
+    /* create a new map */
+    it := rv.MapRange()
+    ret := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+
+    /* copy every key and value */
+    for it.Next() {
+        ret.SetMapIndex(clone(it.Key()), clone(it.Value()))
+    }
+
+    /* all done */
+    return ret
+}
+
+func cloneSlice(rv reflect.Value) reflect.Value {
+    if rv.IsNil() {
+        return rv
+    }
+
+    /* copy every element */
+    ret := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+    for i := 0; i < rv.Len(); i++ {
+        ret.Index(i).Set(clone(rv.Index(i)))
+    }
+
+    /* all done */
+    return ret
+}
+
+func cloneStruct(rv reflect.Value) reflect.Value {
+    ret := reflect.New(rv.Type()).Elem()
+    for i := 0; i < rv.NumField(); i++ {
+        if ret.Field(i).CanSet() {
+            ret.Field(i).Set(clone(rv.Field(i)))
+        }
+    }
+    return ret
+}
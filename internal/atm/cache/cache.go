@@ -0,0 +1,456 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cache
+
+import (
+    `encoding/binary`
+    `errors`
+    `fmt`
+    `hash`
+    `hash/fnv`
+    `io/ioutil`
+    `os`
+    `path/filepath`
+    `reflect`
+    `runtime`
+    `runtime/debug`
+    `sync`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/** Machine Code Cache
+ *
+ *  Relocatable machine code is saved into the cache directory together with its frame metadata.
+ *  Every absolute address embedded in the code is saved as a symbol, which is resolved again when
+ *  the code is loaded by another process, since the addresses might be different.
+ *
+ *  Objects are keyed by the program fingerprint, the layout of the type, the frugal version and
+ *  the Go version. Anything that does not match is ignored and compiled again.
+ */
+
+const (
+    _ModulePath = "github.com/cloudwego/frugal"
+    _FileMagic  = "FRUGALMC"
+    _FileFormat = 1
+)
+
+const (
+    _R_symbol uint8 = iota
+    _R_call
+    _R_runtime
+)
+
+var (
+    errInvalidObject = errors.New("cache: invalid object")
+)
+
+var (
+    layoutCache   sync.Map
+    versionOnce   sync.Once
+    versionString string
+)
+
+type Symbols struct {
+    refs []uintptr
+    smap map[uintptr]int
+}
+
+func (self *Symbols) Add(p unsafe.Pointer) {
+    self.refs = append(self.refs, uintptr(p))
+}
+
+func (self *Symbols) find(ref uintptr) int {
+    if self.smap == nil {
+        self.index()
+    }
+
+    /* lookup the first symbol with this address */
+    if i, ok := self.smap[ref]; ok {
+        return i
+    } else {
+        return -1
+    }
+}
+
+func (self *Symbols) index() {
+    self.smap = make(map[uintptr]int, len(self.refs))
+    for i := len(self.refs) - 1; i >= 0; i-- {
+        self.smap[self.refs[i]] = i
+    }
+}
+
+func (self *Symbols) lookup(i uint32) uintptr {
+    if int(i) >= len(self.refs) {
+        return 0
+    } else {
+        return self.refs[i]
+    }
+}
+
+type Reloc struct {
+    Off  uint32
+    Kind uint8
+    Sym  uint32
+    Name string
+}
+
+type Object struct {
+    Key    string
+    Code   []byte
+    Frame  rt.Frame
+    Relocs []Reloc
+}
+
+func Enabled() bool {
+    return opts.CacheDir != ""
+}
+
+func Key(kind string, vt *rt.GoType, fp uint64) string {
+    return fmt.Sprintf(
+        "%s %016x %016x %s %s %s/%s",
+        kind,
+        fp,
+        Layout(vt),
+        version(),
+        runtime.Version(),
+        runtime.GOOS,
+        runtime.GOARCH,
+    )
+}
+
+func Path(key string) string {
+    h := fnv.New64a()
+    _, _ = h.Write([]byte(key))
+    return filepath.Join(opts.CacheDir, fmt.Sprintf("%016x.%d.bin", h.Sum64(), _FileFormat))
+}
+
+func version() string {
+    versionOnce.Do(func() { versionString = frugalVersion() })
+    return versionString
+}
+
+func frugalVersion() string {
+    if bi, ok := debug.ReadBuildInfo(); ok {
+        for _, m := range bi.Deps {
+            if m.Path == _ModulePath {
+                if m.Replace != nil {
+                    m = m.Replace
+                }
+                if m.Version != "" && m.Version != "(devel)" {
+                    return m.Version + "@" + m.Sum
+                }
+            }
+        }
+    }
+
+    /* development builds, the cache is only valid for this very executable */
+    if fn, err := os.Executable(); err != nil {
+        return "(devel)"
+    } else if st, err := os.Stat(fn); err != nil {
+        return "(devel)"
+    } else {
+        return fmt.Sprintf("(devel)@%s:%d:%d", fn, st.Size(), st.ModTime().UnixNano())
+    }
+}
+
+/** Type Layout **/
+
+func Layout(vt *rt.GoType) uint64 {
+    if v, ok := layoutCache.Load(vt); ok {
+        return v.(uint64)
+    } else {
+        h := fnv.New64a()
+        layout(h, vt.Pack(), make(map[reflect.Type]int))
+        layoutCache.Store(vt, h.Sum64())
+        return h.Sum64()
+    }
+}
+
+func layout(h hash.Hash64, vt reflect.Type, seen map[reflect.Type]int) {
+    var p []byte
+    var t *rt.GoType
+
+    /* recursive types refer to the earlier definition */
+    if i, ok := seen[vt]; ok {
+        _, _ = h.Write([]byte(fmt.Sprintf("@%d;", i)))
+        return
+    }
+
+    /* basic layout of the type */
+    t = rt.UnpackType(vt)
+    p = []byte(fmt.Sprintf("%d:%d:%d:%d:%d;", vt.Kind(), t.Size, t.PtrData, t.Align, t.FieldAlign))
+
+    /* add to the hash */
+    seen[vt] = len(seen)
+    _, _ = h.Write(p)
+
+    /* layout of the element types */
+    switch vt.Kind() {
+        case reflect.Ptr    : layout(h, vt.Elem(), seen)
+        case reflect.Slice  : layout(h, vt.Elem(), seen)
+        case reflect.Array  : _, _ = h.Write([]byte(fmt.Sprintf("[%d]", vt.Len()))); layout(h, vt.Elem(), seen)
+        case reflect.Map    : layout(h, vt.Key(), seen); layout(h, vt.Elem(), seen)
+        case reflect.Struct : layoutStruct(h, vt, seen)
+    }
+}
+
+func layoutStruct(h hash.Hash64, vt reflect.Type, seen map[reflect.Type]int) {
+    for i := 0; i < vt.NumField(); i++ {
+        _, _ = h.Write([]byte(fmt.Sprintf("+%d", vt.Field(i).Offset)))
+        layout(h, vt.Field(i).Type, seen)
+    }
+}
+
+/** Object Encoding **/
+
+func (self *Object) Encode() []byte {
+    buf := make([]byte, 0, len(self.Code) + 1024)
+    buf = appendString(buf, self.Key)
+    buf = appendBytes(buf, self.Code)
+    buf = appendUint(buf, uint64(self.Frame.ArgSize))
+    buf = appendUint(buf, uint64(len(self.Frame.SpTab)))
+
+    /* PC-SP table */
+    for _, v := range self.Frame.SpTab {
+        buf = appendUint(buf, uint64(v.Sp))
+        buf = appendUint(buf, uint64(v.Nb))
+    }
+
+    /* pointer maps of arguments and locals */
+    buf = appendStackMap(buf, self.Frame.ArgPtrs)
+    buf = appendStackMap(buf, self.Frame.LocalPtrs)
+    buf = appendUint(buf, uint64(len(self.Relocs)))
+
+    /* relocations */
+    for _, v := range self.Relocs {
+        buf = appendUint(buf, uint64(v.Off))
+        buf = appendUint(buf, uint64(v.Kind))
+        buf = appendUint(buf, uint64(v.Sym))
+        buf = appendString(buf, v.Name)
+    }
+
+    /* checksum of the entire object */
+    h := fnv.New64a()
+    m := make([]byte, len(_FileMagic) + 8, len(_FileMagic) + 8 + len(buf))
+    _, _ = h.Write(buf)
+
+    /* add the file header */
+    copy(m, _FileMagic)
+    binary.LittleEndian.PutUint64(m[len(_FileMagic):], h.Sum64())
+    return append(m, buf...)
+}
+
+func (self *Object) Decode(src []byte) error {
+    var rd _Reader
+
+    /* check the file magic */
+    if len(src) < len(_FileMagic) + 8 || string(src[:len(_FileMagic)]) != _FileMagic {
+        return errInvalidObject
+    }
+
+    /* verify the checksum */
+    h := fnv.New64a()
+    rd.buf = src[len(_FileMagic) + 8:]
+    _, _ = h.Write(rd.buf)
+
+    /* must match the checksum */
+    if h.Sum64() != binary.LittleEndian.Uint64(src[len(_FileMagic):]) {
+        return errInvalidObject
+    }
+
+    /* decode the object */
+    self.Key = rd.str()
+    self.Code = rd.bytes()
+    self.Frame.ArgSize = uintptr(rd.uint())
+    self.Frame.SpTab = make([]rt.Stack, rd.count())
+
+    /* PC-SP table */
+    for i := range self.Frame.SpTab {
+        self.Frame.SpTab[i].Sp = uintptr(rd.uint())
+        self.Frame.SpTab[i].Nb = uintptr(rd.uint())
+    }
+
+    /* pointer maps of arguments and locals */
+    self.Frame.ArgPtrs = rd.stackmap()
+    self.Frame.LocalPtrs = rd.stackmap()
+    self.Relocs = make([]Reloc, rd.count())
+
+    /* relocations */
+    for i := range self.Relocs {
+        self.Relocs[i].Off = uint32(rd.uint())
+        self.Relocs[i].Kind = uint8(rd.uint())
+        self.Relocs[i].Sym = uint32(rd.uint())
+        self.Relocs[i].Name = rd.str()
+    }
+
+    /* check for errors and trailing bytes */
+    if rd.err || len(rd.buf) != 0 {
+        return errInvalidObject
+    }
+
+    /* all relocations must be within the code */
+    for _, v := range self.Relocs {
+        if uint64(v.Off) + 8 > uint64(len(self.Code)) {
+            return errInvalidObject
+        }
+    }
+
+    /* all done */
+    return nil
+}
+
+func appendUint(buf []byte, v uint64) []byte {
+    var m [binary.MaxVarintLen64]byte
+    return append(buf, m[:binary.PutUvarint(m[:], v)]...)
+}
+
+func appendBytes(buf []byte, v []byte) []byte {
+    return append(appendUint(buf, uint64(len(v))), v...)
+}
+
+func appendString(buf []byte, v string) []byte {
+    return append(appendUint(buf, uint64(len(v))), v...)
+}
+
+func appendStackMap(buf []byte, v *rt.StackMap) []byte {
+    var i uintptr
+    var bv rt.BitVec
+    var bm []byte
+
+    /* pgen always generates stack maps with exactly 1 bitmap */
+    if v == nil || v.N != 1 {
+        panic("cache: invalid stack map")
+    }
+
+    /* dump every bit */
+    for bv = v.Get(0); i < bv.N; i++ {
+        bm = append(bm, bv.Bit(i))
+    }
+
+    /* add to the buffer */
+    return appendBytes(buf, bm)
+}
+
+type _Reader struct {
+    buf []byte
+    err bool
+}
+
+func (self *_Reader) uint() uint64 {
+    if v, n := binary.Uvarint(self.buf); n <= 0 {
+        self.err = true
+        self.buf = nil
+        return 0
+    } else {
+        self.buf = self.buf[n:]
+        return v
+    }
+}
+
+func (self *_Reader) count() int {
+    if n := self.uint(); n > uint64(len(self.buf)) {
+        self.err = true
+        self.buf = nil
+        return 0
+    } else {
+        return int(n)
+    }
+}
+
+func (self *_Reader) bytes() []byte {
+    n := self.count()
+    v := self.buf[:n:n]
+    self.buf = self.buf[n:]
+    return v
+}
+
+func (self *_Reader) str() string {
+    return string(self.bytes())
+}
+
+func (self *_Reader) stackmap() *rt.StackMap {
+    var sb rt.StackMapBuilder
+    var bm = self.bytes()
+
+    /* rebuild the stack map bit-by-bit */
+    for _, v := range bm {
+        sb.AddField(v != 0)
+    }
+
+    /* build the stack map */
+    return sb.Build()
+}
+
+/** Object Storage **/
+
+func Load(key string) (*Object, error) {
+    var err error
+    var buf []byte
+    var ret Object
+
+    /* read and decode the object */
+    if buf, err = ioutil.ReadFile(Path(key)); err != nil {
+        return nil, err
+    } else if err = ret.Decode(buf); err != nil {
+        return nil, err
+    } else if ret.Key != key {
+        return nil, errInvalidObject
+    } else {
+        return &ret, nil
+    }
+}
+
+func Store(obj *Object) error {
+    var err error
+    var ret *os.File
+
+    /* create the cache directory if needed */
+    if err = os.MkdirAll(opts.CacheDir, 0755); err != nil {
+        return err
+    }
+
+    /* write to a temporary file first */
+    if ret, err = ioutil.TempFile(opts.CacheDir, ".frugal-*.tmp"); err != nil {
+        return err
+    }
+
+    /* write the object */
+    if _, err = ret.Write(obj.Encode()); err != nil {
+        _ = ret.Close()
+        _ = os.Remove(ret.Name())
+        return err
+    }
+
+    /* close the file */
+    if err = ret.Close(); err != nil {
+        _ = os.Remove(ret.Name())
+        return err
+    }
+
+    /* then atomically replace the object */
+    if err = os.Rename(ret.Name(), Path(obj.Key)); err != nil {
+        _ = os.Remove(ret.Name())
+        return err
+    }
+
+    /* all done */
+    return nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cache
+
+import (
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/stretchr/testify/require`
+)
+
+func TestCache_Object(t *testing.T) {
+    var sb rt.StackMapBuilder
+    sb.AddFields(3, false)
+    sb.AddField(true)
+    obj := &Object {
+        Key    : "test",
+        Code   : []byte { 0x90, 0x90, 0x48, 0xb8, 1, 2, 3, 4, 5, 6, 7, 8, 0xc3 },
+        Relocs : []Reloc {{ Off: 4, Kind: _R_call, Name: "foo" }, { Off: 4, Kind: _R_symbol, Sym: 7 }},
+        Frame  : rt.Frame {
+            SpTab     : []rt.Stack {{ Sp: 0, Nb: 2 }, { Sp: 16, Nb: 10 }, { Sp: 0, Nb: 0 }},
+            ArgSize   : 24,
+            ArgPtrs   : sb.Build(),
+            LocalPtrs : new(rt.StackMapBuilder).Build(),
+        },
+    }
+    var ret Object
+    buf := obj.Encode()
+    require.NoError(t, ret.Decode(buf))
+    require.Equal(t, obj.Key, ret.Key)
+    require.Equal(t, obj.Code, ret.Code)
+    require.Equal(t, obj.Relocs, ret.Relocs)
+    require.Equal(t, obj.Frame.SpTab, ret.Frame.SpTab)
+    require.Equal(t, obj.Frame.ArgSize, ret.Frame.ArgSize)
+    require.Equal(t, obj.Frame.ArgPtrs.String(), ret.Frame.ArgPtrs.String())
+    require.Equal(t, obj.Frame.LocalPtrs.String(), ret.Frame.LocalPtrs.String())
+    for i := range buf {
+        buf[i] ^= 0xff
+        require.Error(t, new(Object).Decode(buf), "byte %d", i)
+        buf[i] ^= 0xff
+    }
+    require.Error(t, new(Object).Decode(buf[:len(buf) - 1]))
+}
+
+func TestCache_Layout(t *testing.T) {
+    type Tree struct {
+        L *Tree
+        R *Tree
+        V []int32
+    }
+    type Node struct {
+        A *Node
+        B *Node
+        C []int32
+    }
+    type Other struct {
+        L *Other
+        R *Other
+        V []int64
+    }
+    require.Equal(t, Layout(rt.UnpackType(reflect.TypeOf(Tree{}))), Layout(rt.UnpackType(reflect.TypeOf(Node{}))))
+    require.NotEqual(t, Layout(rt.UnpackType(reflect.TypeOf(Tree{}))), Layout(rt.UnpackType(reflect.TypeOf(Other{}))))
+    require.NotEqual(t, Layout(rt.UnpackType(reflect.TypeOf([2]int{}))), Layout(rt.UnpackType(reflect.TypeOf([3]int{}))))
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cache
+
+import (
+    `encoding/binary`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/atm/pgen`
+    `github.com/cloudwego/frugal/internal/atm/rtx`
+    `github.com/cloudwego/frugal/internal/loader`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+var runtimeSymbols = map[string]uintptr {
+    "runtime.memmove"          : uintptr(rtx.F_memmove),
+    "runtime.morestack_noctxt" : uintptr(rtx.F_morestack_noctxt),
+    "runtime.writeBarrier"     : uintptr(rtx.V_pWriteBarrier),
+    "runtime.gcWriteBarrier"   : uintptr(rtx.F_gcWriteBarrier),
+}
+
+// Link loads the machine code of a program from the cache, or generates
+// it with `gen` and saves it into the cache. The second return value reports
+// whether the cached code was used.
+func Link(kind string, vt *rt.GoType, fp uint64, syms *Symbols, gen func() *pgen.Func) (loader.Function, bool) {
+    key := Key(kind, vt, fp)
+    obj, err := Load(key)
+
+    /* the cached object might not be usable in this process */
+    if err == nil {
+        if fn := obj.link(kind + "_" + vt.String(), syms); fn != nil {
+            return fn, true
+        }
+    }
+
+    /* generate the code, and save it for later, failing to do so is not an error */
+    ret := gen()
+    obj = newObject(key, ret, syms)
+
+    /* some addresses might not be relocatable */
+    if obj != nil {
+        _ = Store(obj)
+    }
+
+    /* load the generated code */
+    return loader.Loader(ret.Code).LoadCollectable(kind + "_" + vt.String(), ret.Frame), false
+}
+
+func newObject(key string, fn *pgen.Func, syms *Symbols) *Object {
+    ret := &Object {
+        Key   : key,
+        Code  : fn.Code,
+        Frame : fn.Frame,
+    }
+
+    /* convert all the addresses to symbols */
+    for _, v := range fn.Relocs {
+        if rel, ok := symbolize(v, syms); !ok {
+            return nil
+        } else {
+            ret.Relocs = append(ret.Relocs, rel)
+        }
+    }
+
+    /* all done */
+    return ret
+}
+
+func symbolize(v pgen.Reloc, syms *Symbols) (Reloc, bool) {
+    if i := syms.find(v.Ref); i >= 0 {
+        return Reloc { Off: uint32(v.Off), Kind: _R_symbol, Sym: uint32(i) }, true
+    }
+
+    /* runtime functions and variables */
+    for name, ref := range runtimeSymbols {
+        if ref == v.Ref {
+            return Reloc { Off: uint32(v.Off), Kind: _R_runtime, Name: name }, true
+        }
+    }
+
+    /* functions that can be found by name */
+    if v.Call != nil {
+        if fp := hir.LookupCallByName(v.Call.Name()); fp != nil && fp.Func == v.Call.Func {
+            return Reloc { Off: uint32(v.Off), Kind: _R_call, Name: v.Call.Name() }, true
+        }
+    }
+
+    /* not relocatable */
+    return Reloc{}, false
+}
+
+func resolve(v Reloc, syms *Symbols) uintptr {
+    switch v.Kind {
+        case _R_symbol  : return syms.lookup(v.Sym)
+        case _R_runtime : return runtimeSymbols[v.Name]
+        case _R_call    : return callAddr(hir.LookupCallByName(v.Name))
+        default         : return 0
+    }
+}
+
+func callAddr(fp *hir.CallHandle) uintptr {
+    if fp == nil {
+        return 0
+    } else {
+        return uintptr(fp.Func)
+    }
+}
+
+func (self *Object) link(name string, syms *Symbols) loader.Function {
+    for _, v := range self.Relocs {
+        if ref := resolve(v, syms); ref == 0 {
+            return nil
+        } else {
+            binary.LittleEndian.PutUint64(self.Code[v.Off:], uint64(ref))
+        }
+    }
+    return loader.Loader(self.Code).LoadCollectable(name, self.Frame)
+}
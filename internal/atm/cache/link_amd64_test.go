@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cache
+
+import (
+    `reflect`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/atm/pgen`
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/stretchr/testify/require`
+)
+
+func TestCache_Relocation(t *testing.T) {
+    dir := opts.CacheDir
+    opts.CacheDir = t.TempDir()
+    defer func() { opts.CacheDir = dir }()
+    va, vb := new(int), new(int)
+    vt := rt.UnpackType(reflect.TypeOf(0))
+    for i, vp := range []*int { va, vb } {
+        syms := new(Symbols)
+        syms.Add(unsafe.Pointer(vp))
+        fp, ok := Link("test", vt, 0x1234, syms, func() *pgen.Func {
+            p := hir.CreateBuilder()
+            p.IP(unsafe.Pointer(vp), hir.P0)
+            p.RET().R0(hir.P0)
+            return pgen.CreateCodeGen((func() unsafe.Pointer)(nil)).Relocatable().Generate(p.Build(), 0)
+        })
+        require.Equal(t, i != 0, ok)
+        fn := *(*func() unsafe.Pointer)(unsafe.Pointer(&fp))
+        require.Equal(t, unsafe.Pointer(vp), fn())
+    }
+}
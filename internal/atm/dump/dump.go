@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dump
+
+import (
+    `fmt`
+    `io/ioutil`
+    `os`
+    `path/filepath`
+    `strings`
+
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+const (
+    _MaxName = 96
+)
+
+type Dump struct {
+    dir string
+}
+
+// Enabled checks if the compilation pipeline of type vt should be dumped.
+func Enabled(vt *rt.GoType) bool {
+    return opts.DumpDir != "" && (opts.DumpFilter == nil || opts.DumpFilter.MatchString(vt.String()))
+}
+
+// Open creates the dump directory for a program of type vt, which is named after its kind,
+// type and fingerprint. It returns nil if the directory cannot be created, all methods of
+// a nil Dump do nothing.
+func Open(kind string, vt *rt.GoType, fp uint64) *Dump {
+    dir := filepath.Join(opts.DumpDir, fmt.Sprintf("%s_%s_%016x", kind, mangle(vt.String()), fp))
+    err := os.MkdirAll(dir, 0755)
+
+    /* dumping is best-effort, just give up on errors */
+    if err != nil {
+        return nil
+    } else {
+        return &Dump { dir }
+    }
+}
+
+func (self *Dump) Write(name string, text string) {
+    if self != nil {
+        _ = ioutil.WriteFile(filepath.Join(self.dir, name), []byte(text), 0644)
+    }
+}
+
+func (self *Dump) mkdir(name string) error {
+    return os.MkdirAll(filepath.Join(self.dir, name), 0755)
+}
+
+func mangle(name string) string {
+    var ok bool
+    var sb strings.Builder
+
+    /* replace every run of unsafe characters with a single underscore */
+    for _, c := range name {
+        if c == '.' || c == '-' || c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+            ok = true
+            sb.WriteRune(c)
+        } else if ok {
+            ok = false
+            sb.WriteByte('_')
+        }
+    }
+
+    /* anonymous types might have extremely long names */
+    if ret := strings.TrimSuffix(sb.String(), "_"); len(ret) <= _MaxName {
+        return ret
+    } else {
+        return ret[:_MaxName]
+    }
+}
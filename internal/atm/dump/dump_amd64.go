@@ -0,0 +1,159 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dump
+
+import (
+    `fmt`
+    `path/filepath`
+    `runtime`
+    `strings`
+    `time`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/atm/rtx`
+    `github.com/cloudwego/frugal/internal/atm/ssa`
+    `github.com/cloudwego/frugal/internal/loader`
+    `github.com/cloudwego/frugal/internal/opts`
+    `golang.org/x/arch/x86/x86asm`
+)
+
+const (
+    _MaxByte = 10
+)
+
+// SSA compiles the program with the SSA backend, and dumps the CFG after each pass, the
+// SSA backend is still experimental, so a panic is recorded along with the last completed
+// pass instead of crashing. It returns the time spent in the SSA backend, not counting the
+// time spent writing the dump files.
+func (self *Dump) SSA(p hir.Program, proto interface{}) (ret time.Duration) {
+    var i int
+    var pass = "(none)"
+
+    /* nothing to do if not enabled */
+    if self == nil {
+        return
+    }
+
+    /* record the failing pass */
+    defer func() {
+        if v := recover(); v != nil {
+            self.Write(filepath.Join("ssa", "error.txt"), fmt.Sprintf("panic after %s: %v\n", pass, v))
+        }
+    }()
+
+    /* create the directory */
+    if self.mkdir("ssa") != nil {
+        return
+    }
+
+    /* the dumping time is excluded, even if some pass panicked */
+    ts := time.Now()
+    defer func() { ret += time.Since(ts) }()
+
+    /* compile the program, dumping the CFG after every pass */
+    ssa.CompileWithHook(p, proto, func(name string, cfg *ssa.CFG) {
+        defer func(t time.Time) { ret -= time.Since(t) }(time.Now())
+        fn := filepath.Join("ssa", fmt.Sprintf("%02d_%s", i, strings.ToLower(mangle(name))))
+        self.Write(fn + ".txt", cfg.String())
+
+        /* also write the DOT file if needed */
+        if opts.DumpDot {
+            self.Write(fn + ".dot", cfg.Dot())
+        }
+
+        /* the pass has completed */
+        i++
+        pass = name
+    })
+
+    /* all done */
+    return
+}
+
+// Code dumps the disassembly of a function loaded by the loader.
+func (self *Dump) Code(fn unsafe.Pointer) {
+    if self != nil {
+        self.Write("asm.txt", disasm(loader.CodeOf(loader.Function(fn))))
+        runtime.KeepAlive(fn)
+    }
+}
+
+func symlookup(addr uint64) (string, uint64) {
+    if fp := runtime.FuncForPC(uintptr(addr)); fp == nil {
+        if addr == uint64(uintptr(rtx.V_pWriteBarrier)) {
+            return "runtime.writeBarrier", addr
+        } else {
+            return "", 0
+        }
+    } else {
+        return fp.Name(), uint64(fp.Entry())
+    }
+}
+
+func disasm(code []byte) string {
+    var pc int
+    var sb strings.Builder
+
+    /* nothing to disassemble */
+    if len(code) == 0 {
+        return ""
+    }
+
+    /* decode every instruction */
+    orig := uintptr(unsafe.Pointer(&code[0]))
+    for pc < len(code) {
+        ins, err := x86asm.Decode(code[pc:], 64)
+        dis := "(bad)"
+
+        /* data embedded in the code might not be decodable */
+        if err != nil {
+            ins.Len = 1
+        } else {
+            dis = x86asm.GNUSyntax(ins, uint64(pc) + uint64(orig), symlookup)
+        }
+
+        /* instruction address */
+        fmt.Fprintf(&sb, "0x%08x : ", pc + int(orig))
+
+        /* instruction bytes, wrapped every _MaxByte bytes */
+        for x := 0; x < ins.Len; x++ {
+            if x != 0 && x % _MaxByte == 0 {
+                sb.WriteString("\n           : ")
+            }
+
+            /* add the disassembly after the first line */
+            fmt.Fprintf(&sb, " %02x", code[pc + x])
+            if x == _MaxByte - 1 {
+                sb.WriteString("    " + dis)
+            }
+        }
+
+        /* pad the short instructions */
+        if ins.Len < _MaxByte {
+            sb.WriteString(strings.Repeat(" ", (_MaxByte - ins.Len) * 3) + "    " + dis)
+        }
+
+        /* move to the next instruction */
+        pc += ins.Len
+        sb.WriteByte('\n')
+    }
+
+    /* all done */
+    return sb.String()
+}
@@ -0,0 +1,33 @@
+// +build !amd64
+
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dump
+
+import (
+    `time`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+// SSA does nothing, the SSA backend is only available on amd64.
+func (self *Dump) SSA(_ hir.Program, _ interface{}) time.Duration { return 0 }
+
+// Code does nothing, the native code is only available on amd64.
+func (self *Dump) Code(_ unsafe.Pointer) {}
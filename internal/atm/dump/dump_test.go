@@ -0,0 +1,32 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package dump
+
+import (
+    `strings`
+    `testing`
+
+    `github.com/stretchr/testify/assert`
+)
+
+func TestDump_Mangle(t *testing.T) {
+    assert.Equal(t, "main.MyStruct", mangle("main.MyStruct"))
+    assert.Equal(t, "map_string_main.MyStruct", mangle("*map[string]*main.MyStruct"))
+    assert.Equal(t, "struct_A_int32_frugal_1_default_i32", mangle(`struct { A int32 "frugal:\"1,default,i32\"" }`))
+    assert.Equal(t, _MaxName, len(mangle(strings.Repeat("x", _MaxName * 2))))
+}
@@ -173,6 +173,7 @@ func (self *Emulator) Run() {
 
 
 func (self *Emulator) Free() {
+    *self = Emulator{}
     emulatorPool.Put(self)
 }
 
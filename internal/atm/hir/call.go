@@ -19,6 +19,7 @@ package hir
 import (
     `fmt`
     `runtime`
+    `sync`
     `unsafe`
 
     `github.com/cloudwego/frugal/internal/atm/abi`
@@ -163,6 +164,7 @@ func (self CallContext) verifySeq(s string, n uint8, v [8]uint8) bool {
 
 var (
     funcTab []*CallHandle
+    funcMap sync.Map
 )
 
 func LookupCall(id int64) *CallHandle {
@@ -173,6 +175,14 @@ func LookupCall(id int64) *CallHandle {
     }
 }
 
+func LookupCallByName(name string) *CallHandle {
+    if fp, ok := funcMap.Load(name); !ok {
+        return nil
+    } else {
+        return fp.(*CallHandle)
+    }
+}
+
 func RegisterICall(mt rt.Method, proxy func(CallContext)) (h *CallHandle) {
     h       = new(CallHandle)
     h.Id    = len(funcTab)
@@ -190,6 +200,7 @@ func RegisterGCall(fn interface{}, proxy func(CallContext)) (h *CallHandle) {
     h.Func  = abi.ABI.RegisterFunction(h.Id, fn)
     h.proxy = proxy
     funcTab = append(funcTab, h)
+    funcMap.Store(h.Name(), h)
     return
 }
 
@@ -200,5 +211,6 @@ func RegisterCCall(fn unsafe.Pointer, proxy func(CallContext)) (h *CallHandle) {
     h.Func  = fn
     h.proxy = proxy
     funcTab = append(funcTab, h)
+    funcMap.Store(h.Name(), h)
     return
 }
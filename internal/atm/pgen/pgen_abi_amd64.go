@@ -59,7 +59,7 @@ func (self *CodeGen) abiEpilogue(p *x86_64.Program) {
 
 func (self *CodeGen) abiStackGrow(p *x86_64.Program) {
     self.internalSpillArgs(p)
-    p.MOVQ(uintptr(rtx.F_morestack_noctxt), R12)
+    self.abs(p, uintptr(rtx.F_morestack_noctxt), nil, R12)
     p.CALLQ(R12)
     self.internalUnspillArgs(p)
 }
@@ -295,7 +295,7 @@ func checkptr(ri uint8, arg abi.Parameter) bool {
 
 func (self *CodeGen) abiCallGo(p *x86_64.Program, v *hir.Ir) {
     self.internalCallFunction(p, v, nil, func(fp *hir.CallHandle) {
-        p.MOVQ(checkfp(fp.Func), R12)
+        self.abs(p, checkfp(fp.Func), fp, R12)
         p.CALLQ(R12)
     })
 }
@@ -337,7 +337,7 @@ func (self *CodeGen) abiCallNative(p *x86_64.Program, v *hir.Ir) {
     }
 
     /* call the function */
-    p.MOVQ(checkfp(fp.Func), RAX)
+    self.abs(p, checkfp(fp.Func), fp, RAX)
     p.CALLQ(RAX)
 
     /* store the result */
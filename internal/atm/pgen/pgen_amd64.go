@@ -62,7 +62,7 @@ func (self *_SwitchTable) mark(i int, to *x86_64.Label) {
 
 func (self *_SwitchTable) refs(p *x86_64.Program, to *x86_64.Label) {
     for _, v := range self.tab {
-        p.Long(expr.Ref(v).Sub(expr.Ref(to)))
+        p.Long(expr.Ref(v.Retain()).Sub(expr.Ref(to.Retain())))
     }
 }
 
@@ -266,12 +266,15 @@ var _OperandMask = [256]Operands {
 }
 
 type Func struct {
-    Code  []byte
-    Frame rt.Frame
+    Code   []byte
+    Frame  rt.Frame
+    Relocs []Reloc
 }
 
 type CodeGen struct {
     regi int
+    relo bool
+    rels []_RelocSite
     ctxt _FrameInfo
     arch *x86_64.Arch
     head *x86_64.Label
@@ -411,8 +414,9 @@ func (self *CodeGen) Generate(s hir.Program, sp uintptr) *Func {
 
     /* assemble the function */
     ret := &Func {
-        Code  : code,
-        Frame : rt.Frame {
+        Code   : code,
+        Relocs : self.relocs(),
+        Frame  : rt.Frame {
             SpTab     : tab,
             ArgSize   : args,
             ArgPtrs   : self.ctxt.ArgPtrs(),
@@ -652,7 +656,9 @@ var translators = [256]func(*CodeGen, *x86_64.Program, *hir.Ir) {
 
 func (self *CodeGen) translate_OP_ip(p *x86_64.Program, v *hir.Ir) {
     if v.Pd != hir.Pn {
-        if addr := uintptr(v.Pr); addr > math.MaxUint32 {
+        if addr := uintptr(v.Pr); self.relo && addr != 0 {
+            self.abs(p, addr, nil, self.r(v.Pd))
+        } else if addr > math.MaxUint32 {
             p.MOVQ(addr, self.r(v.Pd))
         } else {
             p.MOVL(addr, x86_64.Register32(self.r(v.Pd)))
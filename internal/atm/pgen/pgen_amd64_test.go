@@ -231,3 +231,41 @@ func TestPGen_FunctionCall(t *testing.T) {
     require.Equal(t, 746, y)
     require.Equal(t, 20211206, z)
 }
+
+func TestPGen_Relocatable(t *testing.T) {
+    var s ifacetest
+    var i ifacetesttype = 123456
+    s = i
+    m := hir.RegisterICall(rt.GetMethod((*ifacetest)(nil), "Foo"), nil)
+    h := hir.RegisterGCall(gcalltestfn, nil)
+    p := hir.CreateBuilder()
+    e := *(*rt.GoIface)(unsafe.Pointer(&s))
+    p.IP(e.Itab, hir.P0)
+    p.IP(e.Value, hir.P1)
+    p.LDAQ(0, hir.R0)
+    p.GCALL(h).A0(hir.R0).R0(hir.R1).R1(hir.R2).R2(hir.R3)
+    p.ADD(hir.R1, hir.R2, hir.R1)
+    p.ICALL(hir.P0, hir.P1, m).A0(hir.R3).R0(hir.R4)
+    p.RET().R0(hir.R1).R1(hir.R4)
+    g := CreateCodeGen((func(int) (int, int))(nil)).Relocatable()
+    r := g.Generate(p.Build(), 0)
+    require.NotEmpty(t, r.Relocs)
+    refs := map[uintptr]bool{}
+    for _, v := range r.Relocs {
+        refs[v.Ref] = true
+        require.Equal(t, uint64(v.Ref), *(*uint64)(unsafe.Pointer(&r.Code[v.Off])))
+        if v.Call != nil {
+            require.Equal(t, h, v.Call)
+            require.Equal(t, uintptr(h.Func), v.Ref)
+        }
+    }
+    require.True(t, refs[uintptr(unsafe.Pointer(e.Itab))])
+    require.True(t, refs[uintptr(e.Value)])
+    require.True(t, refs[uintptr(h.Func)])
+    require.True(t, refs[uintptr(rtx.F_morestack_noctxt)])
+    v := loader.Loader(r.Code).Load("_test_reloc", r.Frame)
+    f := *(*func(int) (int, int))(unsafe.Pointer(&v))
+    x, y := f(123)
+    require.Equal(t, 546, x)
+    require.Equal(t, 123879, y)
+}
@@ -27,9 +27,9 @@ func (self *CodeGen) wbStorePointer(p *x86_64.Program, s hir.PointerRegister, d
     rt := x86_64.CreateLabel("_wb_return")
 
     /* check for write barrier */
-    p.MOVQ (uintptr(rtx.V_pWriteBarrier), RAX)
-    p.CMPB (0, Ptr(RAX, 0))
-    p.JNE  (wb)
+    self.abs (p, uintptr(rtx.V_pWriteBarrier), nil, RAX)
+    p.CMPB   (0, Ptr(RAX, 0))
+    p.JNE    (wb)
 
     /* check for storing nil */
     if s == hir.Pn {
@@ -62,7 +62,7 @@ func (self *CodeGen) wbStorePointer(p *x86_64.Program, s hir.PointerRegister, d
         wbSetSlot               ()
         self.abiSpillReserved   (p)
         self.abiLoadReserved    (p)
-        p.MOVQ                  (uintptr(rtx.F_gcWriteBarrier), RSI)
+        self.abs                (p, uintptr(rtx.F_gcWriteBarrier), nil, RSI)
         p.CALLQ                 (RSI)
         self.abiSaveReserved    (p)
         self.abiRestoreReserved (p)
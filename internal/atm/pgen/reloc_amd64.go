@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package pgen
+
+import (
+    `encoding/binary`
+
+    `github.com/chenzhuoyu/iasm/x86_64`
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+/** Relocations
+ *
+ *  Relocatable functions load every absolute address with a full 64-bit immediate (the `movabs`
+ *  form), and record where each immediate is, so that the machine code can be patched and reused
+ *  by another process, where the addresses might be different.
+ */
+
+type Reloc struct {
+    Off  uintptr
+    Ref  uintptr
+    Call *hir.CallHandle
+}
+
+type _RelocSite struct {
+    ref uintptr
+    pos *x86_64.Label
+    fun *hir.CallHandle
+}
+
+func (self *CodeGen) Relocatable() *CodeGen {
+    self.relo = true
+    return self
+}
+
+func (self *CodeGen) abs(p *x86_64.Program, ref uintptr, fn *hir.CallHandle, rd x86_64.Register64) {
+    if !self.relo {
+        p.MOVQ(ref, rd)
+    } else {
+        self.rels = append(self.rels, _RelocSite { ref: ref, fun: fn, pos: x86_64.CreateLabel("_reloc") })
+        p.Data(movabs(rd, ref))
+        p.Link(self.rels[len(self.rels) - 1].pos)
+    }
+}
+
+func (self *CodeGen) relocs() (ret []Reloc) {
+    for _, v := range self.rels {
+        ret = append(ret, Reloc {
+            Off  : toAddress(v.pos) - 8,
+            Ref  : v.ref,
+            Call : v.fun,
+        })
+    }
+    return
+}
+
+func movabs(rd x86_64.Register64, v uintptr) []byte {
+    buf := make([]byte, 10)
+    buf[0] = 0x48 | byte(rd >> 3)
+    buf[1] = 0xb8 | byte(rd & 7)
+    binary.LittleEndian.PutUint64(buf[2:], uint64(v))
+    return buf
+}
@@ -74,6 +74,16 @@ func (self *BasicBlock) addPred(p *BasicBlock) {
     self.Pred = append(self.Pred, p)
 }
 
+func (self *BasicBlock) replacePred(p *BasicBlock, bb *BasicBlock) {
+    for i, v := range self.Pred {
+        if v == p {
+            self.Pred = append(self.Pred[:i], self.Pred[i + 1:]...)
+            break
+        }
+    }
+    self.addPred(bb)
+}
+
 func (self *BasicBlock) addInstr(p *hir.Ir) {
     switch p.Op {
         default: {
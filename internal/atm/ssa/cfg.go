@@ -22,9 +22,16 @@ import (
     `github.com/cloudwego/frugal/internal/atm/abi`
 )
 
+const (
+    _F_ssa   = 1 << iota    // the CFG is in SSA form
+    _F_split                // the CFG has no critical edges
+    _F_alloc                // all the registers are physical registers
+)
+
 type _CFGPrivate struct {
     reg   uint64
     block uint64
+    flags uint8
 }
 
 func (self *_CFGPrivate) allocreg() int {
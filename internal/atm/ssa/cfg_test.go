@@ -17,8 +17,6 @@
 package ssa
 
 import (
-    `fmt`
-    `html`
     `io/ioutil`
     `strings`
     `testing`
@@ -26,95 +24,6 @@ import (
     `github.com/cloudwego/frugal/internal/atm/hir`
 )
 
-func dumpbb(bb *BasicBlock) string {
-    var w int
-    var phi []string
-    var ins []string
-    var term []string
-    for _, v := range bb.Phi {
-        for _, ss := range strings.Split(v.String(), "\n") {
-            vv := html.EscapeString(ss)
-            vv = strings.ReplaceAll(vv, "$", "$$")
-            phi = append(phi, fmt.Sprintf("<tr><td align=\"left\">%s</td></tr>\n", vv))
-            if len(ss) > w {
-                w = len(ss)
-            }
-        }
-    }
-    for _, v := range bb.Ins {
-        for _, ss := range strings.Split(v.String(), "\n") {
-            vv := html.EscapeString(ss)
-            vv = strings.ReplaceAll(vv, "$", "$$")
-            ins = append(ins, fmt.Sprintf("<tr><td align=\"left\">%s</td></tr>\n", vv))
-            if len(ss) > w {
-                w = len(ss)
-            }
-        }
-    }
-    for _, ss := range strings.Split(bb.Term.String(), "\n") {
-        vv := html.EscapeString(ss)
-        vv = strings.ReplaceAll(vv, "$", "$$")
-        term = append(term, fmt.Sprintf("<tr><td align=\"left\">%s</td></tr>\n", vv))
-        if len(ss) > w {
-            w = len(ss)
-        }
-    }
-    buf := []string {
-        "<table border=\"1\" cellborder=\"0\" cellspacing=\"0\">\n",
-        fmt.Sprintf("<tr><td width=\"%d\">bb_%d</td></tr>\n", w * 10 + 5, bb.Id),
-    }
-    if len(bb.Phi) != 0 {
-        buf = append(buf, "<hr/>\n")
-        buf = append(buf, phi...)
-    }
-    if len(bb.Ins) != 0 {
-        buf = append(buf, "<hr/>\n")
-        buf = append(buf, ins...)
-    }
-    buf = append(buf, "<hr/>\n")
-    buf = append(buf, term...)
-    buf = append(buf, "</table>")
-    return strings.Join(buf, "")
-}
-
-func cfgdot(cfg *CFG, fn string) {
-    e := make(map[[2]int]bool)
-    buf := []string {
-        "digraph CFG {",
-        `    xdotversion = "15"`,
-        `    graph [ fontname = "Fira Code" ]`,
-        `    node [ fontname = "Fira Code" fontsize = "16" shape = "plaintext" ]`,
-        `    edge [ fontname = "Fira Code" ]`,
-        `    START [ shape = "circle" ]`,
-        fmt.Sprintf(`    START -> bb_%d`, cfg.Root.Id),
-    }
-    for _, p := range cfg.PostOrder().Reversed() {
-        f := true
-        it := p.Term.Successors()
-        buf = append(buf, fmt.Sprintf(`    bb_%d [ label = < %s > ]`, p.Id, dumpbb(p)))
-        for it.Next() {
-            ln := it.Block()
-            edge := [2]int{p.Id, ln.Id}
-            if !e[edge] {
-                e[edge] = true
-                if v, ok := it.Value(); ok {
-                    f = false
-                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "%d" ]`, p.Id, ln.Id, v))
-                } else if f {
-                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "goto" ]`, p.Id, ln.Id))
-                } else {
-                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "otherwise" ]`, p.Id, ln.Id))
-                }
-            }
-        }
-    }
-    buf = append(buf, "}")
-    err := ioutil.WriteFile(fn, []byte(strings.Join(buf, "\n")), 0644)
-    if err != nil {
-        panic(err)
-    }
-}
-
 var (
     ftest = hir.RegisterGCall(func (i int) int { return i + 1 }, nil)
 )
@@ -135,5 +44,32 @@ func TestCFG_Build(t *testing.T) {
     c := p.Build()
     g := Compile(c, (func(*int, *int) (int, int))(nil))
     t.Logf("Generating DOT file ...")
-    cfgdot(g, "/tmp/cfg.gv")
+    err := ioutil.WriteFile("/tmp/cfg.gv", []byte(g.Dot()), 0644)
+    if err != nil {
+        panic(err)
+    }
+}
+
+func TestCFG_Hook(t *testing.T) {
+    var names []string
+    p := hir.CreateBuilder()
+    p.LDAP  (0, hir.P0)
+    p.LQ    (hir.P0, 0, hir.R0)
+    p.ADDI  (hir.R0, 1, hir.R0)
+    p.RET   ().R0(hir.R0)
+    g := CompileWithHook(p.Build(), (func(*int) int)(nil), func(name string, cfg *CFG) {
+        names = append(names, name)
+        if cfg.String() == "" {
+            t.Errorf("empty CFG after %s", name)
+        }
+    })
+    if len(names) != len(Passes) + 1 {
+        t.Fatalf("expected %d hook calls, got %d", len(Passes) + 1, len(names))
+    }
+    if names[0] != "SSA Construction" || names[len(names) - 1] != Passes[len(Passes) - 1].Name {
+        t.Fatalf("unexpected pass names: %v", names)
+    }
+    if !strings.Contains(g.Dot(), "START -> bb_") {
+        t.Fatal("missing entry edge in the DOT graph")
+    }
 }
@@ -17,16 +17,20 @@
 package ssa
 
 import (
+    `fmt`
     `reflect`
 
     `github.com/cloudwego/frugal/internal/atm/abi`
     `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/opts`
 )
 
 type Pass interface {
     Apply(*CFG)
 }
 
+type PassHook func(name string, cfg *CFG)
+
 type PassDescriptor struct {
     Pass Pass
     Name string
@@ -65,17 +69,46 @@ func toFuncType(fn interface{}) reflect.Type {
     }
 }
 
-func executeSSAPasses(cfg *CFG) {
+func finishPass(name string, cfg *CFG, hook PassHook) {
+    if hook != nil {
+        hook(name, cfg)
+    }
+
+    /* check the invariants if needed */
+    if opts.VerifySSA {
+        if err := Verify(cfg); err != nil {
+            panic(fmt.Sprintf("ssa: invalid CFG after %s:\n%s", name, err))
+        }
+    }
+}
+
+func executeSSAPasses(cfg *CFG, hook PassHook) {
     for _, p := range Passes {
         p.Pass.Apply(cfg)
+        finishPass(p.Name, cfg, hook)
     }
 }
 
-func Compile(p hir.Program, fn interface{}) (cfg *CFG) {
+func buildSSA(p hir.Program, fn interface{}) (cfg *CFG) {
     cfg = newGraphBuilder().build(p)
     cfg.Layout = abi.ABI.LayoutFunc(-1, toFuncType(fn))
     insertPhiNodes(cfg)
     renameRegisters(cfg)
-    executeSSAPasses(cfg)
+    return
+}
+
+func Compile(p hir.Program, fn interface{}) (cfg *CFG) {
+    return CompileWithHook(p, fn, nil)
+}
+
+// CompileWithHook compiles the program like Compile does, and calls hook with the name of
+// the pass and the CFG right after the SSA construction and every optimization pass, before
+// the CFG is verified if opts.VerifySSA is set.
+func CompileWithHook(p hir.Program, fn interface{}, hook PassHook) (cfg *CFG) {
+    cfg = buildSSA(p, fn)
+    finishPass("SSA Construction", cfg, hook)
+
+    /* run all the passes */
+    executeSSAPasses(cfg, hook)
     return
 }
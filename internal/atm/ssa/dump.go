@@ -0,0 +1,159 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package ssa
+
+import (
+    `fmt`
+    `html`
+    `strings`
+)
+
+func (self *CFG) String() string {
+    var buf []string
+    var pred []string
+
+    /* dump every block in reverse post-order */
+    for _, bb := range self.PostOrder().Reversed() {
+        pred = pred[:0]
+        buf = append(buf, bb.String() + ":")
+
+        /* add the predecessors if any */
+        for _, p := range bb.Pred {
+            pred = append(pred, p.String())
+        }
+
+        /* the predecessors go after the block name */
+        if len(pred) != 0 {
+            buf[len(buf) - 1] += " ; preds: " + strings.Join(pred, ", ")
+        }
+
+        /* phi nodes, instructions and the terminator */
+        for _, v := range bb.Phi { buf = append(buf, indent(v.String())) }
+        for _, v := range bb.Ins { buf = append(buf, indent(v.String())) }
+
+        /* blocks are separated by an empty line */
+        buf = append(buf, indent(bb.Term.String()))
+        buf = append(buf, "")
+    }
+
+    /* join them together */
+    return strings.Join(buf, "\n")
+}
+
+func (self *CFG) Dot() string {
+    e := make(map[[2]int]bool)
+    buf := []string {
+        "digraph CFG {",
+        `    xdotversion = "15"`,
+        `    graph [ fontname = "Fira Code" ]`,
+        `    node [ fontname = "Fira Code" fontsize = "16" shape = "plaintext" ]`,
+        `    edge [ fontname = "Fira Code" ]`,
+        `    START [ shape = "circle" ]`,
+        fmt.Sprintf(`    START -> bb_%d`, self.Root.Id),
+    }
+
+    /* add every block and its outgoing edges */
+    for _, p := range self.PostOrder().Reversed() {
+        f := true
+        it := p.Term.Successors()
+        buf = append(buf, fmt.Sprintf(`    bb_%d [ label = < %s > ]`, p.Id, dotbb(p)))
+
+        /* label the edges with the switch values */
+        for it.Next() {
+            ln := it.Block()
+            edge := [2]int{p.Id, ln.Id}
+
+            /* only add each edge once */
+            if !e[edge] {
+                e[edge] = true
+                if v, ok := it.Value(); ok {
+                    f = false
+                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "%d" ]`, p.Id, ln.Id, v))
+                } else if f {
+                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "goto" ]`, p.Id, ln.Id))
+                } else {
+                    buf = append(buf, fmt.Sprintf(`    bb_%d -> bb_%d [ label = "otherwise" ]`, p.Id, ln.Id))
+                }
+            }
+        }
+    }
+
+    /* close the graph */
+    buf = append(buf, "}")
+    return strings.Join(buf, "\n")
+}
+
+func dotbb(bb *BasicBlock) string {
+    var w int
+    var phi []string
+    var ins []string
+    var term []string
+
+    /* phi nodes */
+    for _, v := range bb.Phi {
+        phi = dotrows(phi, v.String(), &w)
+    }
+
+    /* instructions */
+    for _, v := range bb.Ins {
+        ins = dotrows(ins, v.String(), &w)
+    }
+
+    /* block header */
+    term = dotrows(term, bb.Term.String(), &w)
+    buf := []string {
+        "<table border=\"1\" cellborder=\"0\" cellspacing=\"0\">\n",
+        fmt.Sprintf("<tr><td width=\"%d\">bb_%d</td></tr>\n", w * 10 + 5, bb.Id),
+    }
+
+    /* add phi nodes if any */
+    if len(bb.Phi) != 0 {
+        buf = append(buf, "<hr/>\n")
+        buf = append(buf, phi...)
+    }
+
+    /* add instructions if any */
+    if len(bb.Ins) != 0 {
+        buf = append(buf, "<hr/>\n")
+        buf = append(buf, ins...)
+    }
+
+    /* the terminator */
+    buf = append(buf, "<hr/>\n")
+    buf = append(buf, term...)
+    buf = append(buf, "</table>")
+    return strings.Join(buf, "")
+}
+
+func dotrows(buf []string, s string, w *int) []string {
+    for _, ss := range strings.Split(s, "\n") {
+        vv := html.EscapeString(ss)
+        vv = strings.ReplaceAll(vv, "$", "$$")
+        buf = append(buf, fmt.Sprintf("<tr><td align=\"left\">%s</td></tr>\n", vv))
+
+        /* track the widest row */
+        if len(ss) > *w {
+            *w = len(ss)
+        }
+    }
+    return buf
+}
+
+func indent(s string) string {
+    return "    " + strings.ReplaceAll(s, "\n", "\n    ")
+}
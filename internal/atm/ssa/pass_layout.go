@@ -103,6 +103,7 @@ func (self Layout) Apply(cfg *CFG) {
                     if nb = st.Block(); !st.Next() {
                         rt = true
                         it.UpdateBlock(nb)
+                        nb.replacePred(nx, bb)
                     }
                 }
             }
@@ -116,6 +117,9 @@ func (self Layout) Apply(cfg *CFG) {
         }
     }
 
+    /* collapsing might introduce critical edges again, which is fine since all the Phi nodes are gone */
+    cfg.flags &^= _F_split
+
     /* flatten the CFG */
     root := cfg.Root
     self.flatten(cfg.Func.Layout, root)
@@ -207,4 +207,7 @@ func (self PhiProp) Apply(cfg *CFG) {
             }
         }
     })
+
+    /* the CFG is no longer in SSA form */
+    cfg.flags &^= _F_ssa
 }
@@ -867,4 +867,7 @@ func (self RegAlloc) Apply(cfg *CFG) {
             }
         }
     })
+
+    /* all the registers are physical registers from now on */
+    cfg.flags |= _F_alloc
 }
\ No newline at end of file
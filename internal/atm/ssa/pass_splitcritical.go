@@ -94,4 +94,7 @@ func (SplitCritical) Apply(cfg *CFG) {
     if len(edges) != 0 {
         cfg.Rebuild()
     }
+
+    /* no more critical edges from now on */
+    cfg.flags |= _F_split
 }
@@ -64,22 +64,24 @@ func (ZeroReg) replace(cfg *CFG) {
 
         /* scan all the Phi nodes */
         for _, p := range bb.Pred {
-            var z Reg
+            var z [2]Reg
             var v *IrPhi
 
-            /* insert an zeroing instruction to it's predecessor if needed */
+            /* insert an zeroing instruction to it's predecessor if needed, pointer
+             * and integer Phi nodes must not share the same register */
             for _, v = range bb.Phi {
-                if v.V[p].Kind() == K_zero {
-                    z = cfg.CreateRegister(false)
-                    p.Ins = append(p.Ins, IrArchZero(z))
-                    break
+                if rr = v.V[p]; rr.Kind() == K_zero {
+                    if i := bool2int(rr.Ptr()); z[i] == 0 {
+                        z[i] = cfg.CreateRegister(rr.Ptr())
+                        p.Ins = append(p.Ins, IrArchZero(z[i]))
+                    }
                 }
             }
 
             /* substitute all the zero register usages */
             for _, v = range bb.Phi {
                 if rr = v.V[p]; rr.Kind() == K_zero {
-                    *rr = z
+                    *rr = z[bool2int(rr.Ptr())]
                 }
             }
         }
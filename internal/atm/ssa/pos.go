@@ -22,6 +22,7 @@ import (
 )
 
 const (
+    _P_phi  = -1
     _P_term = math.MaxUint32
 )
 
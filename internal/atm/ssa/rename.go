@@ -116,6 +116,7 @@ func (self _Renamer) renameblock(cfg *CFG, bb *BasicBlock) {
 func renameRegisters(cfg *CFG) {
     newRenamer().renameblock(cfg, cfg.Root)
     normalizeRegisters(cfg)
+    cfg.flags |= _F_ssa
 }
 
 func assignRegisters(rr []*Reg, rm map[Reg]Reg, cfg *CFG) {
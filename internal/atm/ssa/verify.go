@@ -0,0 +1,378 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package ssa
+
+import (
+    `errors`
+    `fmt`
+    `sort`
+    `strings`
+)
+
+type _Verifier struct {
+    cfg  *CFG
+    dom  map[int]*BasicBlock
+    bbs  []*BasicBlock
+    err  []string
+    defs map[Reg]Pos
+    live map[*BasicBlock]bool
+}
+
+// Verify checks the invariants of the CFG, which are expected to hold between any two passes:
+//
+//   - the predecessors and successors of every block agree with each other, and the dominator tree is up to date
+//   - while in SSA form, each register is defined exactly once, and the definition dominates all of its usages
+//   - while in SSA form, each Phi node has exactly one value for every predecessor of its block
+//   - once split, there are no critical edges in the CFG
+//   - once allocated, all the registers are physical registers
+//   - pointer and integer registers are used where each instruction expects them
+//
+// It returns an error describing all the violations if any.
+func Verify(cfg *CFG) error {
+    v := &_Verifier {
+        cfg  : cfg,
+        dom  : make(map[int]*BasicBlock),
+        defs : make(map[Reg]Pos),
+        live : make(map[*BasicBlock]bool),
+    }
+
+    /* find all the reachable blocks, and compute a fresh dominator tree */
+    v.reachable()
+    v.dominators()
+
+    /* check the blocks */
+    for _, bb := range v.bbs {
+        v.block(bb)
+    }
+
+    /* check the usages after all the definitions are known */
+    if cfg.flags & _F_ssa != 0 {
+        for _, bb := range v.bbs {
+            v.usages(bb)
+        }
+    }
+
+    /* combine all the errors */
+    if len(v.err) == 0 {
+        return nil
+    } else {
+        return errors.New(strings.Join(v.err, "\n"))
+    }
+}
+
+func (self *_Verifier) errorf(at string, msg string, args ...interface{}) {
+    self.err = append(self.err, at + ": " + fmt.Sprintf(msg, args...))
+}
+
+func (self *_Verifier) reachable() {
+    ids := make(map[int]*BasicBlock)
+    stk := []*BasicBlock { self.cfg.Root }
+
+    /* depth-first search from the root block */
+    for len(stk) != 0 {
+        bb := stk[len(stk) - 1]
+        stk = stk[:len(stk) - 1]
+
+        /* skip the visited blocks */
+        if self.live[bb] {
+            continue
+        }
+
+        /* block IDs must be unique */
+        if p, ok := ids[bb.Id]; ok && p != bb {
+            self.errorf(bb.String(), "duplicated block ID")
+        }
+
+        /* mark as visited */
+        ids[bb.Id] = bb
+        self.live[bb] = true
+        self.bbs = append(self.bbs, bb)
+
+        /* the terminator is checked later */
+        if bb.Term == nil {
+            continue
+        }
+
+        /* add all the successors */
+        for it := bb.Term.Successors(); it.Next(); {
+            stk = append(stk, it.Block())
+        }
+    }
+
+    /* sort the blocks by ID to get stable results */
+    sort.Slice(self.bbs, func(i int, j int) bool {
+        return self.bbs[i].Id < self.bbs[j].Id
+    })
+}
+
+func (self *_Verifier) dominators() {
+    cfg := &CFG {
+        Root        : self.cfg.Root,
+        DominatedBy : self.dom,
+        DominatorOf : make(map[int][]*BasicBlock),
+    }
+
+    /* calculate a fresh dominator tree for comparison */
+    updateDominatorTree(cfg)
+
+    /* the passes must rebuild the dominator tree after changing the CFG */
+    for _, bb := range self.bbs {
+        if p, q := self.dom[bb.Id], self.cfg.DominatedBy[bb.Id]; p != q {
+            self.errorf(bb.String(), "stale dominator tree: immediate dominator is %s, not %s", p, q)
+        }
+    }
+}
+
+func (self *_Verifier) dominates(p *BasicBlock, q *BasicBlock) bool {
+    for q != nil && q != p {
+        q = self.dom[q.Id]
+    }
+    return q == p
+}
+
+func (self *_Verifier) block(bb *BasicBlock) {
+    var ns int
+    var ps map[*BasicBlock]bool
+
+    /* every block must have a terminator */
+    if bb.Term == nil {
+        self.errorf(bb.String(), "missing terminator")
+        return
+    }
+
+    /* the predecessor list must not contain duplicates */
+    ps = make(map[*BasicBlock]bool, len(bb.Pred))
+    if bb == self.cfg.Root && len(bb.Pred) != 0 {
+        self.errorf(bb.String(), "the root block has predecessors")
+    }
+
+    /* check the predecessors */
+    for _, p := range bb.Pred {
+        if ps[p] {
+            self.errorf(bb.String(), "duplicated predecessor %s", p)
+        } else if ps[p] = true; !self.live[p] {
+            self.errorf(bb.String(), "unreachable predecessor %s", p)
+        } else if p.Term != nil && !successorOf(p, bb) {
+            self.errorf(bb.String(), "predecessor %s does not branch to it", p)
+        }
+    }
+
+    /* check the successors */
+    for it := bb.Term.Successors(); it.Next(); ns++ {
+        if !predecessorOf(bb, it.Block()) {
+            self.errorf(bb.String(), "successor %s does not have it as a predecessor", it.Block())
+        }
+    }
+
+    /* there should be no critical edges once split */
+    if ns > 1 && self.cfg.flags & _F_split != 0 {
+        for it := bb.Term.Successors(); it.Next(); {
+            if len(it.Block().Pred) > 1 {
+                self.errorf(bb.String(), "critical edge to %s", it.Block())
+            }
+        }
+    }
+
+    /* Phi nodes only exist in SSA form */
+    if len(bb.Phi) != 0 && self.cfg.flags & _F_ssa == 0 {
+        self.errorf(bb.String(), "Phi nodes outside of SSA form")
+    }
+
+    /* check the Phi nodes */
+    for i, v := range bb.Phi {
+        self.phi(fmt.Sprintf("%s.phi[%d]", bb, i), bb, v)
+    }
+
+    /* check the instructions */
+    for i, v := range bb.Ins {
+        self.instr(pos(bb, i), v)
+    }
+
+    /* check the terminator */
+    self.instr(pos(bb, _P_term), bb.Term)
+}
+
+func (self *_Verifier) phi(at string, bb *BasicBlock, p *IrPhi) {
+    self.define(at, pos(bb, _P_phi), p.R)
+
+    /* must have exactly one value for every predecessor */
+    if len(p.V) != len(bb.Pred) {
+        self.errorf(at, "%d values for %d predecessors", len(p.V), len(bb.Pred))
+    }
+
+    /* check every value */
+    for b, r := range p.V {
+        if !predecessorOf(b, bb) {
+            self.errorf(at, "value from %s which is not a predecessor", b)
+        } else if typed(*r) && typed(p.R) && r.Ptr() != p.R.Ptr() {
+            self.errorf(at, "mixing pointer and integer registers")
+        }
+    }
+}
+
+func (self *_Verifier) instr(p Pos, v IrNode) {
+    at := p.String()
+
+    /* check all the registers */
+    if u, ok := v.(IrUsages)      ; ok { for _, r := range u.Usages()      { self.register(at, *r) } }
+    if d, ok := v.(IrDefinitions) ; ok { for _, r := range d.Definitions() { self.define(at, p, *r) } }
+
+    /* check the register types */
+    switch n := v.(type) {
+        case *IrLoad         : self.memory(at, n.R, n.Mem, n.Size)
+        case *IrStore        : self.memory(at, n.R, n.Mem, n.Size)
+        case *IrLEA          : self.types(at, "lea", []Reg { n.R, n.Mem }, []Reg { n.Off })
+        case *IrConstInt     : self.types(at, "const.i64", nil, []Reg { n.R })
+        case *IrConstPtr     : self.types(at, "const.ptr", []Reg { n.R }, nil)
+        case *IrUnaryExpr    : self.types(at, n.Op.String(), nil, []Reg { n.R, n.V })
+        case *IrBinaryExpr   : self.binary(at, n)
+        case *IrBitTestSet   : self.types(at, "bts", nil, []Reg { n.T, n.S, n.X, n.Y })
+        case *IrSwitch       : if len(n.Br) != 0 { self.types(at, "switch", nil, []Reg { n.V }) }
+        case *IrWriteBarrier : self.types(at, "write_barrier", []Reg { n.M, n.Var }, nil)
+        case *IrAlias        : if mixed(n.R, n.V) { self.errorf(at, "alias between pointer and integer registers") }
+        case *IrLoadArg      : self.loadarg(at, n)
+    }
+}
+
+func (self *_Verifier) binary(at string, p *IrBinaryExpr) {
+    switch p.Op {
+        case IrCmpEq, IrCmpNe : if mixed(p.X, p.Y) { self.errorf(at, "comparing pointer with integer") }
+        default               : self.types(at, p.Op.String(), nil, []Reg { p.X, p.Y })
+    }
+
+    /* the result is always an integer */
+    if typed(p.R) && p.R.Ptr() {
+        self.errorf(at, "%s: result must be an integer register", p.Op)
+    }
+}
+
+func (self *_Verifier) memory(at string, r Reg, mem Reg, size uint8) {
+    if typed(mem) && !mem.Ptr() {
+        self.errorf(at, "memory operand must be a pointer register")
+    } else if typed(r) && r.Ptr() && size != 8 {
+        self.errorf(at, "pointers must be %d bytes, not %d", 8, size)
+    }
+}
+
+func (self *_Verifier) loadarg(at string, p *IrLoadArg) {
+    if n := len(self.cfg.Layout.Args); p.I < 0 || p.I >= n {
+        self.errorf(at, "argument index %d out of range [0, %d)", p.I, n)
+    }
+}
+
+func (self *_Verifier) types(at string, op string, ptrs []Reg, ints []Reg) {
+    for _, r := range ptrs { if typed(r) && !r.Ptr() { self.errorf(at, "%s: %s must be a pointer register", op, r) } }
+    for _, r := range ints { if typed(r) &&  r.Ptr() { self.errorf(at, "%s: %s must be an integer register", op, r) } }
+}
+
+func (self *_Verifier) register(at string, r Reg) {
+    switch r.Kind() {
+        case K_zero : break
+        case K_arch : if r.Name() >= len(ArchRegs) { self.errorf(at, "invalid physical register %#x", uint64(r)) }
+        case K_norm : if self.cfg.flags & _F_alloc != 0 { self.errorf(at, "unallocated register %s", r) }
+        default     : self.errorf(at, "invalid register %s", r)
+    }
+}
+
+func (self *_Verifier) define(at string, p Pos, r Reg) {
+    self.register(at, r)
+
+    /* only SSA registers are checked for unique definitions */
+    if r.Kind() != K_norm || self.cfg.flags & _F_ssa == 0 {
+        return
+    }
+
+    /* each SSA register must be defined only once */
+    if q, ok := self.defs[r]; ok {
+        self.errorf(at, "register %s redefined, previously defined at %s", r, where(q))
+    } else {
+        self.defs[r] = p
+    }
+}
+
+func (self *_Verifier) usages(bb *BasicBlock) {
+    for _, v := range bb.Phi {
+        for b, r := range v.V {
+            self.use(fmt.Sprintf("%s.phi", bb), pos(b, _P_term), *r)
+        }
+    }
+
+    /* the instructions */
+    for i, v := range bb.Ins {
+        if u, ok := v.(IrUsages); ok {
+            for _, r := range u.Usages() {
+                self.use(pos(bb, i).String(), pos(bb, i), *r)
+            }
+        }
+    }
+
+    /* the terminator */
+    if u, ok := bb.Term.(IrUsages); ok {
+        for _, r := range u.Usages() {
+            self.use(pos(bb, _P_term).String(), pos(bb, _P_term), *r)
+        }
+    }
+}
+
+func (self *_Verifier) use(at string, p Pos, r Reg) {
+    if r.Kind() != K_norm {
+        return
+    }
+
+    /* the register must be defined somewhere */
+    q, ok := self.defs[r]
+    if !ok {
+        self.errorf(at, "use of undefined register %s", r)
+        return
+    }
+
+    /* the definition must dominate the usage */
+    if q.B == p.B {
+        if q.I >= p.I {
+            self.errorf(at, "register %s is used before its definition at %s", r, where(q))
+        }
+    } else if !self.dominates(q.B, p.B) {
+        self.errorf(at, "definition of register %s at %s does not dominate the usage", r, where(q))
+    }
+}
+
+func typed(r Reg) bool {
+    return r.Kind() == K_norm || r.Kind() == K_zero
+}
+
+func mixed(a Reg, b Reg) bool {
+    return typed(a) && typed(b) && a.Ptr() != b.Ptr()
+}
+
+func where(p Pos) string {
+    if p.I == _P_phi {
+        return p.B.String() + ".phi"
+    } else {
+        return p.String()
+    }
+}
+
+func successorOf(p *BasicBlock, bb *BasicBlock) bool {
+    for it := p.Term.Successors(); it.Next(); { if it.Block() == bb { return true } }
+    return false
+}
+
+func predecessorOf(p *BasicBlock, bb *BasicBlock) bool {
+    for _, v := range bb.Pred { if v == p { return true } }
+    return false
+}
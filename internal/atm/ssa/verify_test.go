@@ -0,0 +1,170 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package ssa
+
+import (
+    `testing`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/stretchr/testify/require`
+)
+
+func init() {
+    opts.VerifySSA = true
+}
+
+func buildVerifyTestCFG() *CFG {
+    p := hir.CreateBuilder()
+    p.LDAP  (0, hir.P0)
+    p.LDAQ  (1, hir.R0)
+    p.MOVP  (hir.Pn, hir.P1)
+    p.MOV   (hir.Rz, hir.R1)
+    p.BEQ   (hir.R0, hir.Rz, "done")
+    p.MOVP  (hir.P0, hir.P1)
+    p.MOV   (hir.R0, hir.R1)
+    p.Label ("done")
+    p.RET   ().R0(hir.P1).R1(hir.R1)
+    return buildSSA(p.Build(), (func(*int, int) (*int, int))(nil))
+}
+
+func findVerifyTestPhi(cfg *CFG, ptr bool) (*BasicBlock, *IrPhi) {
+    for _, bb := range cfg.PostOrder().Reversed() {
+        for _, v := range bb.Phi {
+            if v.R.Ptr() == ptr {
+                return bb, v
+            }
+        }
+    }
+    panic("no Phi nodes found")
+}
+
+func TestVerify_Valid(t *testing.T) {
+    require.NoError(t, Verify(buildVerifyTestCFG()))
+}
+
+func TestVerify_ZeroRegPhi(t *testing.T) {
+    p := hir.CreateBuilder()
+    p.LDAP  (0, hir.P0)
+    p.LDAQ  (1, hir.R0)
+    p.MOVP  (hir.Pn, hir.P1)
+    p.MOV   (hir.Rz, hir.R1)
+    p.Label ("loop")
+    p.BEQ   (hir.R0, hir.Rz, "done")
+    p.LP    (hir.P0, 0, hir.P1)
+    p.LQ    (hir.P0, 8, hir.R1)
+    p.SUBI  (hir.R0, 1, hir.R0)
+    p.JMP   ("loop")
+    p.Label ("done")
+    p.RET   ().R0(hir.P1).R1(hir.R1)
+    Compile(p.Build(), (func(*int, int) (*int, int))(nil))
+}
+
+func TestVerify_Errors(t *testing.T) {
+    tests := []struct {
+        name   string
+        err    string
+        action func(cfg *CFG)
+    }{{
+        name   : "Redefinition",
+        err    : "redefined, previously defined at bb_",
+        action : func(cfg *CFG) {
+            for _, v := range cfg.Root.Ins {
+                if _, ok := v.(*IrLoadArg); ok {
+                    cfg.Root.Ins = append(cfg.Root.Ins, v.Clone())
+                    return
+                }
+            }
+        },
+    }, {
+        name   : "UndefinedRegister",
+        err    : "use of undefined register",
+        action : func(cfg *CFG) {
+            _, p := findVerifyTestPhi(cfg, false)
+            for _, r := range p.V { *r = cfg.CreateRegister(false); break }
+        },
+    }, {
+        name   : "Dominance",
+        err    : "does not dominate the usage",
+        action : func(cfg *CFG) {
+            _, p := findVerifyTestPhi(cfg, false)
+            cfg.Root.Ins = append(cfg.Root.Ins, &IrBinaryExpr { R: cfg.CreateRegister(false), X: p.R, Y: Rz, Op: IrOpAdd })
+        },
+    }, {
+        name   : "PhiArity",
+        err    : "1 values for 2 predecessors",
+        action : func(cfg *CFG) {
+            _, p := findVerifyTestPhi(cfg, false)
+            for b := range p.V { delete(p.V, b); break }
+        },
+    }, {
+        name   : "PhiTypes",
+        err    : "mixing pointer and integer registers",
+        action : func(cfg *CFG) {
+            _, p := findVerifyTestPhi(cfg, true)
+            _, q := findVerifyTestPhi(cfg, false)
+            for _, r := range p.V { *r = q.R; break }
+        },
+    }, {
+        name   : "PhiOutsideSSA",
+        err    : "Phi nodes outside of SSA form",
+        action : func(cfg *CFG) { cfg.flags &^= _F_ssa },
+    }, {
+        name   : "Predecessors",
+        err    : "does not have it as a predecessor",
+        action : func(cfg *CFG) {
+            bb, _ := findVerifyTestPhi(cfg, false)
+            bb.Pred = bb.Pred[:1]
+        },
+    }, {
+        name   : "StaleDominatorTree",
+        err    : "stale dominator tree",
+        action : func(cfg *CFG) {
+            bb, _ := findVerifyTestPhi(cfg, false)
+            for _, p := range bb.Pred {
+                if p != cfg.DominatedBy[bb.Id] {
+                    cfg.DominatedBy[bb.Id] = p
+                    return
+                }
+            }
+        },
+    }, {
+        name   : "CriticalEdge",
+        err    : "critical edge to bb_",
+        action : func(cfg *CFG) { cfg.flags |= _F_split },
+    }, {
+        name   : "Unallocated",
+        err    : "unallocated register",
+        action : func(cfg *CFG) { cfg.flags |= _F_alloc },
+    }, {
+        name   : "Types",
+        err    : "const.ptr: r",
+        action : func(cfg *CFG) {
+            cfg.Root.Ins = append(cfg.Root.Ins, &IrConstPtr { R: cfg.CreateRegister(false) })
+        },
+    }}
+    for _, tc := range tests {
+        t.Run(tc.name, func(t *testing.T) {
+            cfg := buildVerifyTestCFG()
+            tc.action(cfg)
+            err := Verify(cfg)
+            require.Error(t, err)
+            require.Contains(t, err.Error(), tc.err)
+        })
+    }
+}
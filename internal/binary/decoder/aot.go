@@ -0,0 +1,520 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `fmt`
+    `hash/fnv`
+    `math/bits`
+    `sync`
+    `sync/atomic`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/** Generated Decoders
+ *
+ *  Decoders generated ahead-of-time are registered with the fingerprint of the program they were
+ *  generated from, and only replace the JIT when the program compiled at runtime still matches.
+ */
+
+var (
+    GeneratedCount uint64 = 0
+)
+
+var (
+    generatedLoader func()
+    generatedTable  sync.Map
+)
+
+type generated struct {
+    fp uint64
+    fn Decoder
+}
+
+func SetGeneratedLoader(fn func()) {
+    generatedLoader = fn
+}
+
+func RegisterGenerated(vt *rt.GoType, fp uint64, fn Decoder) {
+    generatedTable.Store(vt, generated { fp, fn })
+}
+
+func link(vt *rt.GoType, pp Program) Decoder {
+    if fn := findGenerated(vt, pp); fn != nil {
+        atomic.AddUint64(&GeneratedCount, 1)
+        return fn
+    } else {
+        return LinkProgram(vt, pp)
+    }
+}
+
+func findGenerated(vt *rt.GoType, pp Program) Decoder {
+    if generatedLoader != nil {
+        generatedLoader()
+    }
+
+    /* the generated code must come from the same program */
+    if v, ok := generatedTable.Load(vt); !ok {
+        return nil
+    } else if gv := v.(generated); gv.fp != pp.Fingerprint() {
+        return nil
+    } else {
+        return gv.fn
+    }
+}
+
+func (self Program) Fingerprint() uint64 {
+    h := fnv.New64a()
+    p := make([]byte, 0, 64)
+
+    /* hash every instruction, function pointers are replaced by their names */
+    for _, v := range self {
+        p = p[:0]
+        p = append(p, self.describe(v)...)
+
+        /* type sizes matter to the generated code */
+        if v.Vt != nil {
+            p = append(p, fmt.Sprintf(" #%d", v.Vt.Size)...)
+        }
+
+        /* add to the hash */
+        p = append(p, '\n')
+        _, _ = h.Write(p)
+    }
+
+    /* all done */
+    return h.Sum64()
+}
+
+func (self Program) describe(v Instr) string {
+    if v.Op != OP_initialize {
+        return v.Disassemble()
+    } else {
+        return fmt.Sprintf("%-20s%s", v.Op, rt.FuncName(v.Fn))
+    }
+}
+
+/** Runtime Support
+ *
+ *  The generated decoders have the same prototype as the JIT-compiled ones, each of the following
+ *  methods mirrors an instruction of the translator, returning the same cursor on errors.
+ */
+
+func (self *RuntimeState) Reset(buf unsafe.Pointer, nb int) {
+    self.Bp = buf
+    self.Bn = uint64(nb)
+}
+
+func (self *RuntimeState) State(st int) *StateItem {
+    return (*StateItem)(unsafe.Pointer(uintptr(unsafe.Pointer(self)) + uintptr(st)))
+}
+
+func (self *RuntimeState) U8(i int) uint8 {
+    return *(*uint8)(unsafe.Pointer(uintptr(self.Bp) + uintptr(i)))
+}
+
+func (self *RuntimeState) U16(i int) uint16 {
+    return bits.ReverseBytes16(*(*uint16)(unsafe.Pointer(uintptr(self.Bp) + uintptr(i))))
+}
+
+func (self *RuntimeState) U32(i int) uint32 {
+    return bits.ReverseBytes32(*(*uint32)(unsafe.Pointer(uintptr(self.Bp) + uintptr(i))))
+}
+
+func (self *RuntimeState) U64(i int) uint64 {
+    return bits.ReverseBytes64(*(*uint64)(unsafe.Pointer(uintptr(self.Bp) + uintptr(i))))
+}
+
+func (self *RuntimeState) Check(i int, n int) (int, error) {
+    if uint64(i + n) <= self.Bn {
+        return i, nil
+    } else {
+        return refill(self, i, n)
+    }
+}
+
+func (self *RuntimeState) checkLimit(lim uint64, kind LimitKind, n uint64) error {
+    if n > ^lim {
+        return error_limit(self, kind, n)
+    } else {
+        return nil
+    }
+}
+
+func (self *RuntimeState) charge(n uint64) error {
+    if n > ^self.La {
+        return error_limit(self, LimitAllocSize, n)
+    } else {
+        self.La += n
+        return nil
+    }
+}
+
+func (self *RuntimeState) bytes(i int, alloc bool) (int, unsafe.Pointer, int, error) {
+    var err error
+    var nb uint64
+
+    /* load the length, empty strings and binaries are not checked */
+    if nb, i = uint64(self.U32(i)), i + 4; nb == 0 {
+        return i, nil, 0, nil
+    }
+
+    /* check for the string size limit and the allocation budget */
+    if err = self.checkLimit(self.Ls, LimitStringSize, nb); err != nil {
+        return i, nil, 0, err
+    }
+
+    /* the copying cases are charged */
+    if alloc {
+        if err = self.charge(nb); err != nil {
+            return i, nil, 0, err
+        }
+    }
+
+    /* the bytes must fit in the buffer */
+    if i, err = self.Check(i, int(nb)); err != nil {
+        return i, nil, 0, err
+    } else {
+        return i + int(nb), unsafe.Pointer(uintptr(self.Bp) + uintptr(i)), int(nb), nil
+    }
+}
+
+func (self *RuntimeState) String(i int, p unsafe.Pointer, nocopy bool) (int, error) {
+    var n int
+    var err error
+    var sp unsafe.Pointer
+
+    /* read the bytes */
+    if i, sp, n, err = self.bytes(i, !nocopy); err != nil {
+        (*rt.GoString)(p).Ptr = nil
+        return i, err
+    }
+
+    /* empty strings do not point anywhere */
+    if n == 0 {
+        *(*string)(p) = ""
+    } else if nocopy {
+        *(*string)(p) = rt.StringFrom(sp, n)
+    } else {
+        *(*string)(p) = slicebytetostring(nil, sp, n)
+    }
+
+    /* all done */
+    return i, nil
+}
+
+func (self *RuntimeState) Binary(i int, p unsafe.Pointer, nocopy bool) (int, error) {
+    var n int
+    var err error
+    var sp unsafe.Pointer
+
+    /* read the bytes */
+    if i, sp, n, err = self.bytes(i, !nocopy); err != nil {
+        (*rt.GoSlice)(p).Ptr = unsafe.Pointer(&_V_zerovalue)
+        return i, err
+    }
+
+    /* empty binaries are not nil */
+    if n == 0 {
+        *(*[]byte)(p) = rt.BytesFrom(unsafe.Pointer(&_V_zerovalue), 0, 0)
+    } else if nocopy {
+        *(*[]byte)(p) = rt.BytesFrom(sp, n, n)
+    } else {
+        *(*[]byte)(p) = rt.BytesFrom(mallocgc(uintptr(n), _T_byte, false), n, n)
+        copy(*(*[]byte)(p), rt.BytesFrom(sp, n, n))
+    }
+
+    /* all done */
+    return i, nil
+}
+
+func (self *RuntimeState) Float(i int, p unsafe.Pointer) error {
+    if v, err := float64to32(self.U64(i)); err != nil {
+        return err
+    } else {
+        *(*uint32)(p) = uint32(v)
+        return nil
+    }
+}
+
+func (self *RuntimeState) Fixed(i int, p unsafe.Pointer, vt *rt.GoType) (int, error) {
+    var err error
+    var nb = int64(int32(self.U32(i)))
+
+    /* the length must match the array */
+    if nb != int64(vt.Pack().Len()) {
+        return i, error_length(vt, int(nb))
+    }
+
+    /* the bytes must fit in the buffer */
+    if i, err = self.Check(i + 4, int(vt.Size)); err != nil {
+        return i, err
+    } else {
+        copy(rt.BytesFrom(p, int(vt.Size), int(vt.Size)), rt.BytesFrom(unsafe.Pointer(uintptr(self.Bp) + uintptr(i)), int(vt.Size), int(vt.Size)))
+        return i + int(vt.Size), nil
+    }
+}
+
+func (self *RuntimeState) RangeError(vt *rt.GoType, v uint64, nb int) error {
+    return error_range(vt, v, nb)
+}
+
+func (self *RuntimeState) TypeError(e uint8, t uint8) error {
+    return error_type(e, t)
+}
+
+func (self *RuntimeState) New(vt *rt.GoType) (unsafe.Pointer, error) {
+    if err := self.charge(uint64(vt.Size)); err != nil {
+        return nil, err
+    } else {
+        return mallocgc(vt.Size, vt, true), nil
+    }
+}
+
+func (self *RuntimeState) Deref(p unsafe.Pointer, vt *rt.GoType) (unsafe.Pointer, error) {
+    if *(*unsafe.Pointer)(p) != nil {
+        return *(*unsafe.Pointer)(p), nil
+    } else if vp, err := self.New(vt); err != nil {
+        return p, err
+    } else {
+        *(*unsafe.Pointer)(p) = vp
+        return vp, nil
+    }
+}
+
+func (self *RuntimeState) Container(i int, st int, ns int) (int, error) {
+    nb := uint64(self.U32(i))
+    nr := nb * uint64(ns)
+
+    /* check the limit, and the remaining input before anything is allocated */
+    if nb > ^self.Lc {
+        return i + 4, error_limit(self, LimitContainerSize, nb)
+    } else if nr <= self.Bn - uint64(i + 4) {
+        self.State(st).Nb = nb
+        return i + 4, nil
+    } else if err := reader_count(self, i + 4, int(nr)); err != nil {
+        return i + 4, err
+    } else {
+        self.State(st).Nb = nb
+        return i + 4, nil
+    }
+}
+
+func (self *RuntimeState) MapAlloc(p unsafe.Pointer, st int, vt *rt.GoType) error {
+    mt := rt.MapType(vt)
+    nb := self.State(st).Nb
+
+    /* charge the key-value pairs to the allocation budget */
+    if err := self.charge(nb * uint64(mt.Key.Size + mt.Elem.Size)); err != nil {
+        return &headerError { err }
+    }
+
+    /* allocate the map */
+    mp := makemap(mt, int(nb), nil)
+    *(**rt.GoMap)(p) = mp
+    self.State(st).Mp = mp
+    return nil
+}
+
+func (self *RuntimeState) MapSetInt(i int, st int, vt *rt.GoType, nb int) (int, unsafe.Pointer) {
+    var kv uint64
+    var mt = rt.MapType(vt)
+    var mp = self.State(st).Mp
+
+    /* load the key */
+    switch nb {
+        case 1  : return i + 1, mapassign(mt, mp, unsafe.Pointer(uintptr(self.Bp) + uintptr(i)))
+        case 2  : kv = uint64(self.U16(i))
+        case 4  : kv = uint64(self.U32(i))
+        case 8  : kv = self.U64(i)
+        default : panic("can only load 1, 2, 4 or 8 bytes at a time")
+    }
+
+    /* use the fast path if possible */
+    if nb != 2 && mt.IsFastMap() {
+        if nb == 4 {
+            return i + nb, mapassign_fast32(mt, mp, uint32(kv))
+        } else {
+            return i + nb, mapassign_fast64(mt, mp, kv)
+        }
+    }
+
+    /* the key is spilled into the runtime state */
+    self.Iv = kv
+    return i + nb, mapassign(mt, mp, unsafe.Pointer(&self.Iv))
+}
+
+func (self *RuntimeState) MapSetEnum(i int, st int, vt *rt.GoType) (int, unsafe.Pointer) {
+    mt := rt.MapType(vt)
+    kv := uint64(int64(int32(self.U32(i))))
+
+    /* use the fast path if possible */
+    if mt.IsFastMap() {
+        return i + 4, mapassign_fast64(mt, self.State(st).Mp, kv)
+    }
+
+    /* the key is spilled into the runtime state */
+    self.Iv = kv
+    return i + 4, mapassign(mt, self.State(st).Mp, unsafe.Pointer(&self.Iv))
+}
+
+func (self *RuntimeState) MapSetString(i int, st int, vt *rt.GoType) (int, unsafe.Pointer, error) {
+    var n int
+    var kv string
+    var err error
+    var sp unsafe.Pointer
+
+    /* read the key */
+    if i, sp, n, err = self.bytes(i, true); err != nil {
+        return i, nil, err
+    }
+
+    /* copy the key */
+    if n != 0 {
+        kv = slicebytetostring(nil, sp, n)
+    }
+
+    /* use the fast path if possible */
+    if mt := rt.MapType(vt); mt.IsFastMap() {
+        return i, mapassign_faststr(mt, self.State(st).Mp, kv), nil
+    } else {
+        return i, mapassign(mt, self.State(st).Mp, unsafe.Pointer(&kv)), nil
+    }
+}
+
+func (self *RuntimeState) MapSetPointer(p unsafe.Pointer, st int, vt *rt.GoType) unsafe.Pointer {
+    if mt := rt.MapType(vt); mt.IsFastMap() {
+        return mapassign_fast64ptr(mt, self.State(st).Mp, p)
+    } else {
+        self.Pr = p
+        p = mapassign(mt, self.State(st).Mp, unsafe.Pointer(&self.Pr))
+        self.Pr = nil
+        return p
+    }
+}
+
+func (self *RuntimeState) ListAlloc(p unsafe.Pointer, st int, vt *rt.GoType) (unsafe.Pointer, error) {
+    sl := (*rt.GoSlice)(p)
+    nb := self.State(st).Nb
+
+    /* empty lists reuse the existing slice if any, otherwise are not nil */
+    if nb == 0 {
+        if sl.Len = 0; sl.Cap == 0 {
+            sl.Ptr = unsafe.Pointer(&_V_zerovalue)
+        }
+        return sl.Ptr, nil
+    }
+
+    /* reuse the existing slice if it's large enough */
+    if uint64(sl.Cap) >= nb {
+        sl.Len = int(nb)
+        return sl.Ptr, nil
+    }
+
+    /* charge the elements to the allocation budget */
+    if err := self.charge(nb * uint64(vt.Size)); err != nil {
+        return p, &headerError { err }
+    }
+
+    /* allocate a new slice */
+    sl.Ptr = mallocgc(uintptr(nb) * vt.Size, vt, true)
+    sl.Cap = int(nb)
+    sl.Len = int(nb)
+    return sl.Ptr, nil
+}
+
+func (self *RuntimeState) ArrayCheck(st int, vt *rt.GoType) error {
+    if nb := self.State(st).Nb; nb != uint64(vt.Pack().Len()) {
+        return error_length(vt, int(nb))
+    } else {
+        return nil
+    }
+}
+
+func (self *RuntimeState) Skip(i int, t uint8) (int, error) {
+    if rv := do_skip((*_skipbuf_t)(unsafe.Pointer(&self.Sk)), unsafe.Pointer(uintptr(self.Bp) + uintptr(i)), int(self.Bn) - i, defs.Tag(t)); rv >= 0 {
+        return i + rv, nil
+    } else {
+        return reader_skip(self, i, t, rv)
+    }
+}
+
+func (self *RuntimeState) Unknown(i int, t uint8, id int, p unsafe.Pointer) (int, error) {
+    return unknown_field(self, i, t, id, (*[]byte)(p))
+}
+
+func (self *RuntimeState) UnionMark(st int, id int, vt *rt.GoType) error {
+    if nb := self.State(st).Nb; nb != 0 && nb != uint64(id) + 1 {
+        return error_union(vt, int(nb) - 1, id)
+    } else {
+        self.State(st).Nb = uint64(id) + 1
+        return nil
+    }
+}
+
+func (self *RuntimeState) Bitmap(st int, words ...int) {
+    fm := newFieldBitmap()
+    self.State(st).Fm = fm
+
+    /* clear words of required fields */
+    for _, i := range words {
+        fm[i] = 0
+    }
+}
+
+func (self *RuntimeState) Require(st int, vt *rt.GoType, i int, mask int64) error {
+    if m := (self.State(st).Fm[i] & mask) ^ mask; m != 0 {
+        return error_missing(vt, i, uint64(m))
+    } else {
+        return nil
+    }
+}
+
+func (self *RuntimeState) Release(st int) {
+    fm := self.State(st).Fm
+    self.State(st).Fm = nil
+    fm.Free()
+}
+
+func (self *RuntimeState) Push(st int, p unsafe.Pointer) (int, error) {
+    if int64(st) >= StateMax {
+        return st, _E_overflow
+    } else if uint64(st) >= ^self.Ld {
+        return st, error_limit(self, LimitNestingDepth, uint64(st))
+    } else {
+        self.State(st).Wp = p
+        return st + int(StateSize), nil
+    }
+}
+
+func (self *RuntimeState) Pop(st int) (int, unsafe.Pointer) {
+    st -= int(StateSize)
+    wp := self.State(st).Wp
+    self.State(st).Wp = nil
+    return st, wp
+}
+
+func (self *RuntimeState) Unmarshal(st int, vt *rt.GoType, p unsafe.Pointer) error {
+    return unmarshal(defs.LookupCodec(vt.Pack()), p, self.State(st - int(StateSize)).Wp)
+}
+
+func (self *RuntimeState) Decode(vt *rt.GoType, i int, p unsafe.Pointer, st int) (int, error) {
+    return decode(vt, self.Bp, int(self.Bn), i, p, self, st)
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+const (
+    EVARINT = -4
+)
+
+const (
+    _MaxVarintLen = 10
+)
+
+var (
+    F_compact_skip   = hir.RegisterGCall(compact_skip, emu_gcall_compact_skip)
+    F_compact_varint = hir.RegisterGCall(compact_varint, emu_gcall_compact_varint)
+)
+
+func compact_varint(s unsafe.Pointer, n int, i int) (uint64, int) {
+    return compactVarint(rt.BytesFrom(s, n, n), i)
+}
+
+func compact_skip(s unsafe.Pointer, n int, i int, t uint8) int {
+    return compactSkip(rt.BytesFrom(s, n, n), i, defs.CompactTag(t), false, 0)
+}
+
+func compactVarint(buf []byte, i int) (v uint64, p int) {
+    for n := uint(0); n < _MaxVarintLen * 7; n += 7 {
+        if i >= len(buf) {
+            return 0, EEOF
+        } else if v |= uint64(buf[i] & 0x7f) << n; buf[i] < 0x80 {
+            return v, i + 1
+        } else {
+            i++
+        }
+    }
+    return 0, EVARINT
+}
+
+func compactSkipFixed(buf []byte, i int, nb int) int {
+    if i > len(buf) - nb {
+        return EEOF
+    } else {
+        return i + nb
+    }
+}
+
+func compactSkip(buf []byte, i int, t defs.CompactTag, elem bool, depth int) int {
+    if depth >= defs.StackSize {
+        return ESTACK
+    }
+
+    /* check for value types */
+    switch t {
+        default: {
+            return ETAG
+        }
+
+        /* booleans are encoded within field types, but occupies one byte within containers */
+        case defs.C_true  : fallthrough
+        case defs.C_false : {
+            if !elem {
+                return i
+            } else {
+                return compactSkipFixed(buf, i, 1)
+            }
+        }
+
+        /* fixed-size types */
+        case defs.C_i8     : return compactSkipFixed(buf, i, 1)
+        case defs.C_double : return compactSkipFixed(buf, i, 8)
+
+        /* varints */
+        case defs.C_i16 : fallthrough
+        case defs.C_i32 : fallthrough
+        case defs.C_i64 : {
+            _, i = compactVarint(buf, i)
+            return i
+        }
+
+        /* strings & binaries */
+        case defs.C_binary: {
+            var nb uint64
+            if nb, i = compactVarint(buf, i); i < 0 {
+                return i
+            } else if nb > uint64(len(buf) - i) {
+                return EEOF
+            } else {
+                return i + int(nb)
+            }
+        }
+
+        /* sets & lists */
+        case defs.C_set  : fallthrough
+        case defs.C_list : {
+            var nb uint64
+            var et defs.CompactTag
+
+            /* read the list header */
+            if i >= len(buf) {
+                return EEOF
+            }
+
+            /* decode the element type and count */
+            nb = uint64(buf[i] >> 4)
+            et = defs.CompactTag(buf[i] & 0x0f)
+
+            /* long form of the element count */
+            if i++; nb == 0x0f {
+                if nb, i = compactVarint(buf, i); i < 0 {
+                    return i
+                }
+            }
+
+            /* skip every element */
+            for ; nb > 0 && i >= 0; nb-- {
+                i = compactSkip(buf, i, et, true, depth + 1)
+            }
+
+            /* all done */
+            return i
+        }
+
+        /* maps */
+        case defs.C_map: {
+            var nb uint64
+            var kt defs.CompactTag
+            var vt defs.CompactTag
+
+            /* read the map size */
+            if nb, i = compactVarint(buf, i); i < 0 || nb == 0 {
+                return i
+            }
+
+            /* read the key and value types */
+            if i >= len(buf) {
+                return EEOF
+            }
+
+            /* decode the key and value type */
+            kt = defs.CompactTag(buf[i] >> 4)
+            vt = defs.CompactTag(buf[i] & 0x0f)
+
+            /* skip every key-value pair */
+            for i++; nb > 0 && i >= 0; nb-- {
+                if i = compactSkip(buf, i, kt, true, depth + 1); i >= 0 {
+                    i = compactSkip(buf, i, vt, true, depth + 1)
+                }
+            }
+
+            /* all done */
+            return i
+        }
+
+        /* structs */
+        case defs.C_struct: {
+            for {
+                var ft defs.CompactTag
+                var fd byte
+
+                /* read the field header */
+                if i >= len(buf) {
+                    return EEOF
+                }
+
+                /* check for end of struct */
+                if fd, ft = buf[i] >> 4, defs.CompactTag(buf[i] & 0x0f); ft == defs.C_stop {
+                    return i + 1
+                }
+
+                /* long form of the field ID, don't care about the actual value */
+                if i++; fd == 0 {
+                    if _, i = compactVarint(buf, i); i < 0 {
+                        return i
+                    }
+                }
+
+                /* skip the field value */
+                if i = compactSkip(buf, i, ft, false, depth + 1); i < 0 {
+                    return i
+                }
+            }
+        }
+    }
+}
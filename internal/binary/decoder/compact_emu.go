@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+func emu_gcall_compact_varint(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "ii") {
+        panic("invalid compact_varint call")
+    } else {
+        v, i := compact_varint(ctx.Ap(0), int(ctx.Au(1)), int(ctx.Au(2)))
+        ctx.Ru(0, v)
+        ctx.Ru(1, uint64(i))
+    }
+}
+
+func emu_gcall_compact_skip(ctx hir.CallContext) {
+    if !ctx.Verify("*iii", "i") {
+        panic("invalid compact_skip call")
+    } else {
+        ctx.Ru(0, uint64(compact_skip(ctx.Ap(0), int(ctx.Au(1)), int(ctx.Au(2)), uint8(ctx.Au(3)))))
+    }
+}
@@ -81,32 +81,51 @@ func (self Instr) IntSeq() (p []int) {
 
 func (self Instr) Disassemble() string {
     switch self.Op {
-        case OP_int               : fallthrough
-        case OP_size              : fallthrough
-        case OP_seek              : fallthrough
-        case OP_struct_mark_tag   : return fmt.Sprintf("%-18s%d", self.Op, self.Iv)
-        case OP_type              : return fmt.Sprintf("%-18s%d", self.Op, self.Tx)
-        case OP_deref             : fallthrough
-        case OP_map_alloc         : fallthrough
-        case OP_map_set_i8        : fallthrough
-        case OP_map_set_i16       : fallthrough
-        case OP_map_set_i32       : fallthrough
-        case OP_map_set_i64       : fallthrough
-        case OP_map_set_str       : fallthrough
-        case OP_map_set_enum      : fallthrough
-        case OP_map_set_pointer   : fallthrough
-        case OP_list_alloc        : fallthrough
-        case OP_construct         : fallthrough
-        case OP_defer             : return fmt.Sprintf("%-18s%s", self.Op, self.Vt)
-        case OP_ctr_is_zero       : fallthrough
-        case OP_struct_is_stop    : fallthrough
-        case OP_goto              : return fmt.Sprintf("%-18sL_%d", self.Op, self.To)
-        case OP_struct_bitmap     : fallthrough
-        case OP_struct_require    : return fmt.Sprintf("%-18s%s", self.Op, self.rtab())
-        case OP_struct_switch     : return fmt.Sprintf("%-18s%s", self.Op, self.stab())
-        case OP_struct_check_type : return fmt.Sprintf("%-18s%d, L_%d", self.Op, self.Tx, self.To)
-        case OP_initialize        : return fmt.Sprintf("%-18s*%p [%s]", self.Op, self.Fn, rt.FuncName(self.Fn))
-        default                   : return self.Op.String()
+        case OP_int                 : fallthrough
+        case OP_size                : fallthrough
+        case OP_seek                : fallthrough
+        case OP_ctr_load            : fallthrough
+        case OP_struct_unknown      : fallthrough
+        case OP_struct_unknown_init : fallthrough
+        case OP_struct_mark_tag     : return fmt.Sprintf("%-20s%d", self.Op, self.Iv)
+        case OP_type                : return fmt.Sprintf("%-20s%d", self.Op, self.Tx)
+        case OP_deref               : fallthrough
+        case OP_map_alloc           : fallthrough
+        case OP_map_set_i8          : fallthrough
+        case OP_map_set_i16         : fallthrough
+        case OP_map_set_i32         : fallthrough
+        case OP_map_set_i64         : fallthrough
+        case OP_map_set_str         : fallthrough
+        case OP_map_set_enum        : fallthrough
+        case OP_map_set_pointer     : fallthrough
+        case OP_list_alloc          : fallthrough
+        case OP_construct           : fallthrough
+        case OP_struct_union_reset  : fallthrough
+        case OP_fixed               : fallthrough
+        case OP_array_check         : fallthrough
+        case OP_compact_fixed       : fallthrough
+        case OP_defer               : return fmt.Sprintf("%-20s%s", self.Op, self.Vt)
+        case OP_uint                : fallthrough
+        case OP_compact_uint        : return fmt.Sprintf("%-20s%d, %s", self.Op, self.Iv, self.Vt)
+        case OP_struct_union_mark   : return fmt.Sprintf("%-20s%d, %s", self.Op, self.Id, self.Vt)
+        case OP_ctr_is_zero         : fallthrough
+        case OP_struct_is_stop      : fallthrough
+        case OP_goto                : return fmt.Sprintf("%-20sL_%d", self.Op, self.To)
+        case OP_struct_bitmap       : fallthrough
+        case OP_struct_require      : return fmt.Sprintf("%-20s%s", self.Op, self.rtab())
+        case OP_struct_switch       : return fmt.Sprintf("%-20s%s", self.Op, self.stab())
+        case OP_struct_check_type   : return fmt.Sprintf("%-20s%d, L_%d", self.Op, self.Tx, self.To)
+        case OP_initialize          : return fmt.Sprintf("%-20s*%p [%s]", self.Op, self.Fn, rt.FuncName(self.Fn))
+        case OP_custom              : return fmt.Sprintf("%-20s%s", self.Op, (*defs.Codec)(self.Fn).Type)
+        case OP_compact_vint        : fallthrough
+        case OP_compact_map_begin   : return fmt.Sprintf("%-20s%d", self.Op, self.Iv)
+        case OP_compact_list_begin  : return fmt.Sprintf("%-20s%s", self.Op, defs.CompactTag(self.Tx))
+        case OP_compact_map_set_int : return fmt.Sprintf("%-20s%d, %s", self.Op, self.Tx, self.Vt)
+        case OP_compact_map_set_str : fallthrough
+        case OP_compact_defer       : return fmt.Sprintf("%-20s%s", self.Op, self.Vt)
+        case OP_compact_switch      : return fmt.Sprintf("%-20s%s", self.Op, self.stab())
+        case OP_compact_check_type  : return fmt.Sprintf("%-20s%s, L_%d", self.Op, defs.CompactTag(self.Tx), self.To)
+        default                     : return self.Op.String()
     }
 }
 
@@ -151,11 +170,24 @@ func (self *Program) rtt(op OpCode, vt reflect.Type)           { self.ins(mkins(
 func (self *Program) jsr(op OpCode, fn unsafe.Pointer)         { self.ins(mkins(op, 0, 0, 0, 0, nil, nil, fn)) }
 func (self *Program) jcc(op OpCode, vt defs.Tag, to int)       { self.ins(mkins(op, vt, 0, to, 0, nil, nil, nil)) }
 func (self *Program) req(op OpCode, vt reflect.Type, fv []int) { self.ins(mkins(op, 0, 0, 0, 0, fv, vt, nil)) }
+func (self *Program) key(op OpCode, kt defs.Tag, vt reflect.Type)  { self.ins(mkins(op, kt, 0, 0, 0, nil, vt, nil)) }
+func (self *Program) fid(op OpCode, id uint16, vt reflect.Type)    { self.ins(mkins(op, 0, id, 0, 0, nil, vt, nil)) }
+func (self *Program) cvt(op OpCode, iv int64, vt reflect.Type)     { self.ins(mkins(op, 0, 0, 0, iv, nil, vt, nil)) }
+func (self *Program) cdc(op OpCode, cc *defs.Codec)                { self.ins(mkins(op, 0, 0, 0, 0, nil, nil, unsafe.Pointer(cc))) }
 
 func (self Program) Free() {
     freeProgram(self)
 }
 
+func (self Program) deferred() (ret int) {
+    for _, v := range self {
+        if v.Op == OP_defer || v.Op == OP_compact_defer {
+            ret++
+        }
+    }
+    return
+}
+
 func (self Program) Disassemble() string {
     nb  := len(self)
     tab := make([]bool, nb + 1)
@@ -164,7 +196,7 @@ func (self Program) Disassemble() string {
     /* prescan to get all the labels */
     for _, ins := range self {
         if _OpBranches[ins.Op] {
-            if ins.Op != OP_struct_switch {
+            if !_OpSwitches[ins.Op] {
                 tab[ins.To] = true
             } else {
                 for _, v := range ins.IntSeq() {
@@ -202,6 +234,7 @@ func (self Program) Disassemble() string {
 }
 
 type Compiler struct {
+    n int
     o opts.Options
     t map[reflect.Type]bool
     d map[reflect.Type]struct{}
@@ -221,6 +254,13 @@ func (self *Compiler) rescue(ep *error) {
     }
 }
 
+func (self *Compiler) enter(vt reflect.Type) {
+    if len(self.t) != 0 {
+        self.n++
+    }
+    self.t[vt] = true
+}
+
 func (self *Compiler) compileDef(p *Program, vt *defs.Type) {
     p.rtt(OP_defer, vt.S)
     self.d[vt.S] = struct{}{}
@@ -239,7 +279,7 @@ func (self *Compiler) compileOne(p *Program, sp int, vt *defs.Type) {
 }
 
 func (self *Compiler) compileTag(p *Program, sp int, vt *defs.Type) {
-    self.t[vt.S] = true
+    self.enter(vt.S)
     self.compileRec(p, sp, vt)
     delete(self.t, vt.S)
 }
@@ -255,6 +295,11 @@ func (self *Compiler) compileRec(p *Program, sp int, vt *defs.Type) {
         case defs.T_string : p.i64(OP_size, 4); p.add(OP_str)
         case defs.T_binary : p.i64(OP_size, 4); p.add(OP_bin)
         case defs.T_enum   : p.i64(OP_size, 4); p.add(OP_enum)
+        case defs.T_uint   : self.compileUint    (p, vt)
+        case defs.T_float  : p.i64(OP_size, 8); p.add(OP_float)
+        case defs.T_fixed  : p.i64(OP_size, 4); p.rtt(OP_fixed, vt.S)
+        case defs.T_array  : self.compileArray   (p, sp, vt)
+        case defs.T_custom : self.compileCustom  (p, sp, vt)
         case defs.T_struct : self.compileStruct  (p, sp, vt)
         case defs.T_map    : self.compileMap     (p, sp, vt)
         case defs.T_set    : self.compileSetList (p, sp, vt.V)
@@ -263,6 +308,12 @@ func (self *Compiler) compileRec(p *Program, sp int, vt *defs.Type) {
     }
 }
 
+func (self *Compiler) compileUint(p *Program, vt *defs.Type) {
+    nb := int64(defs.WireSize(vt.W))
+    p.i64(OP_size, nb)
+    p.cvt(OP_uint, nb, vt.S)
+}
+
 func (self *Compiler) compilePtr(p *Program, sp int, vt *defs.Type) {
     p.use(sp)
     p.add(OP_make_state)
@@ -277,7 +328,7 @@ func (self *Compiler) compileMap(p *Program, sp int, vt *defs.Type) {
     p.tag(OP_type, vt.K.Tag())
     p.tag(OP_type, vt.V.Tag())
     p.add(OP_make_state)
-    p.add(OP_ctr_load)
+    p.i64(OP_ctr_load, int64(defs.MinWireSize(vt.K.Tag()) + defs.MinWireSize(vt.V.Tag())))
     p.rtt(OP_map_alloc, vt.S)
     i := p.pc()
     p.add(OP_ctr_is_zero)
@@ -363,6 +414,8 @@ func (self *Compiler) compileKeyPtr(p *Program, sp int, vt *defs.Type) {
 
 func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type) {
     var fid int
+    var uid int
+    var isu bool
     var err error
     var req []int
     var fvs []defs.Field
@@ -373,8 +426,18 @@ func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type) {
         panic(err)
     }
 
+    /* resolve the unknown fields, if any */
+    if uid, err = defs.ResolveUnknownFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
     /* empty struct */
-    if len(fvs) == 0 {
+    if len(fvs) == 0 && uid < 0 {
         p.add(OP_struct_ignore)
         return
     }
@@ -405,6 +468,16 @@ func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type) {
         p.tab(OP_struct_bitmap, req)
     }
 
+    /* clear the unknown fields left by the previous decoding, if any */
+    if uid >= 0 {
+        p.i64(OP_struct_unknown_init, int64(uid))
+    }
+
+    /* clear the union members left by the previous decoding */
+    if isu {
+        self.compileUnionReset(p, fvs)
+    }
+
     /* switch jump buffer */
     i := p.pc()
     s := make([]int, fid + 1)
@@ -422,19 +495,33 @@ func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type) {
     p.i64(OP_size, 2)
     p.tab(OP_struct_switch, s)
     k := p.pc()
+
+    /* preserve the unknown fields if requested */
+    if uid >= 0 {
+        p.i64(OP_struct_unknown, int64(uid))
+        p.jmp(OP_goto, i)
+        k = p.pc()
+    }
+
+    /* skip the unknown fields, or fields with mismatched types */
     p.add(OP_struct_skip)
     p.jmp(OP_goto, i)
 
     /* assemble every field */
     for _, fv := range fvs {
         s[fv.ID] = p.pc()
-        p.jcc(OP_struct_check_type, fv.Type.Tag(), k)
+        self.compileCheckType(p, fv, k)
 
         /* mark the field as seen, if needed */
         if fv.Spec == defs.Required {
             p.i64(OP_struct_mark_tag, int64(fv.ID))
         }
 
+        /* at most one field of a union can be set */
+        if isu {
+            p.fid(OP_struct_union_mark, fv.ID, vt.S)
+        }
+
         /* seek to the field */
         off := int64(fv.F)
         p.i64(OP_seek, off)
@@ -464,12 +551,39 @@ func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type) {
     p.add(OP_drop_state)
 }
 
+func (self *Compiler) compileCheckType(p *Program, fv defs.Field, to int) {
+    if fv.Opts & defs.Inferred == 0 || fv.Type.Tag() != defs.T_list {
+        p.jcc(OP_struct_check_type, fv.Type.Tag(), to)
+        return
+    }
+
+    /* slices inferred from "thrift" tags might be sets as well, both have the same layout */
+    i := p.pc()
+    p.jcc(OP_struct_check_type, defs.T_set, -1)
+    j := p.pc()
+    p.jmp(OP_goto, -1)
+    p.pin(i)
+    p.jcc(OP_struct_check_type, defs.T_list, to)
+    p.pin(j)
+}
+
+func (self *Compiler) compileUnionReset(p *Program, fvs []defs.Field) {
+    p.add(OP_struct_union_init)
+
+    /* every member of a union is nullable */
+    for _, fv := range fvs {
+        p.i64(OP_seek, int64(fv.F))
+        p.rtt(OP_struct_union_reset, fv.Type.S)
+        p.i64(OP_seek, -int64(fv.F))
+    }
+}
+
 func (self *Compiler) compileSetList(p *Program, sp int, et *defs.Type) {
     p.use(sp)
     p.i64(OP_size, 5)
     p.tag(OP_type, et.Tag())
     p.add(OP_make_state)
-    p.add(OP_ctr_load)
+    p.i64(OP_ctr_load, int64(defs.MinWireSize(et.Tag())))
     p.rtt(OP_list_alloc, et.S)
     i := p.pc()
     p.add(OP_ctr_is_zero)
@@ -485,6 +599,37 @@ func (self *Compiler) compileSetList(p *Program, sp int, et *defs.Type) {
     p.add(OP_drop_state)
 }
 
+func (self *Compiler) compileArray(p *Program, sp int, vt *defs.Type) {
+    et := vt.V
+    p.use(sp)
+    p.i64(OP_size, 5)
+    p.tag(OP_type, et.Tag())
+    p.add(OP_make_state)
+    p.i64(OP_ctr_load, int64(defs.MinWireSize(et.Tag())))
+    p.rtt(OP_array_check, vt.S)
+    i := p.pc()
+    p.add(OP_ctr_is_zero)
+    j := p.pc()
+    self.compileOne(p, sp + 1, et)
+    p.add(OP_ctr_decr)
+    k := p.pc()
+    p.add(OP_ctr_is_zero)
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.jmp(OP_goto, j)
+    p.pin(i)
+    p.pin(k)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCustom(p *Program, sp int, vt *defs.Type) {
+    p.use(sp)
+    p.add(OP_make_state)
+    p.rtt(OP_construct, vt.V.S)
+    self.compileOne(p, sp + 1, vt.V)
+    p.cdc(OP_custom, vt.C)
+    p.add(OP_drop_state)
+}
+
 func (self *Compiler) Free() {
     freeCompiler(self)
 }
@@ -504,6 +649,7 @@ func (self *Compiler) Compile(vt reflect.Type) (_ Program, err error) {
     }
 
     /* catch the exceptions, and free the type */
+    self.n = 0
     defer self.rescue(&err)
     defer vtp.Free()
 
@@ -518,3 +664,29 @@ func (self *Compiler) CompileAndFree(vt reflect.Type) (ret Program, err error) {
     self.Free()
     return
 }
+
+func (self *Compiler) CompileCompact(vt reflect.Type) (_ Program, err error) {
+    ret := newProgram()
+    vtp := (*defs.Type)(nil)
+
+    /* parse the type */
+    if vtp, err = defs.ParseType(vt, ""); err != nil {
+        return nil, err
+    }
+
+    /* catch the exceptions, and free the type */
+    self.n = 0
+    defer self.rescue(&err)
+    defer vtp.Free()
+
+    /* compile the actual type */
+    self.compileCompactOne(&ret, 0, vtp)
+    ret.add(OP_halt)
+    return Optimize(ret), nil
+}
+
+func (self *Compiler) CompileCompactAndFree(vt reflect.Type) (ret Program, err error) {
+    ret, err = self.CompileCompact(vt)
+    self.Free()
+    return
+}
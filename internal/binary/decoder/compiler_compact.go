@@ -0,0 +1,373 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `sort`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/utils`
+)
+
+func (self *Compiler) compileCompactOne(p *Program, sp int, vt *defs.Type) {
+    if vt.T == defs.T_pointer {
+        self.compileCompactPtr(p, sp, vt)
+    } else if vt.T != defs.T_struct {
+        self.compileCompactRec(p, sp, vt)
+    } else if _, ok := self.t[vt.S]; !ok && self.o.CanInline(sp, p.pc()) {
+        self.compileCompactTag(p, sp, vt)
+    } else {
+        p.rtt(OP_compact_defer, vt.S)
+    }
+}
+
+func (self *Compiler) compileCompactTag(p *Program, sp int, vt *defs.Type) {
+    self.enter(vt.S)
+    self.compileCompactRec(p, sp, vt)
+    delete(self.t, vt.S)
+}
+
+func (self *Compiler) compileCompactRec(p *Program, sp int, vt *defs.Type) {
+    switch vt.T {
+        case defs.T_bool   : p.i64(OP_size, 1); p.add(OP_compact_bool)
+        case defs.T_i8     : p.i64(OP_size, 1); p.i64(OP_int, 1)
+        case defs.T_i16    : p.i64(OP_compact_vint, 2)
+        case defs.T_i32    : p.i64(OP_compact_vint, 4)
+        case defs.T_i64    : p.i64(OP_compact_vint, 8)
+        case defs.T_double : p.i64(OP_size, 8); p.add(OP_compact_double)
+        case defs.T_string : p.add(OP_compact_str)
+        case defs.T_binary : p.add(OP_compact_bin)
+        case defs.T_enum   : p.i64(OP_compact_vint, 8)
+        case defs.T_uint   : self.compileCompactUint    (p, vt)
+        case defs.T_float  : p.i64(OP_size, 8); p.add(OP_compact_float)
+        case defs.T_fixed  : p.rtt(OP_compact_fixed, vt.S)
+        case defs.T_array  : self.compileCompactArray   (p, sp, vt)
+        case defs.T_custom : self.compileCompactCustom  (p, sp, vt)
+        case defs.T_struct : self.compileCompactStruct  (p, sp, vt)
+        case defs.T_map    : self.compileCompactMap     (p, sp, vt)
+        case defs.T_set    : self.compileCompactSetList (p, sp, vt.V)
+        case defs.T_list   : self.compileCompactSetList (p, sp, vt.V)
+        default            : panic("unreachable")
+    }
+}
+
+func (self *Compiler) compileCompactUint(p *Program, vt *defs.Type) {
+    if nb := int64(defs.WireSize(vt.W)); nb != 1 {
+        p.cvt(OP_compact_uint, nb, vt.S)
+    } else {
+        p.i64(OP_size, 1)
+        p.cvt(OP_uint, 1, vt.S)
+    }
+}
+
+func (self *Compiler) compileCompactCustom(p *Program, sp int, vt *defs.Type) {
+    p.use(sp)
+    p.add(OP_make_state)
+    p.rtt(OP_construct, vt.V.S)
+    self.compileCompactOne(p, sp + 1, vt.V)
+    p.cdc(OP_custom, vt.C)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactPtr(p *Program, sp int, vt *defs.Type) {
+    p.use(sp)
+    p.add(OP_make_state)
+    p.rtt(OP_deref, vt.V.S)
+    self.compileCompactOne(p, sp + 1, vt.V)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactMap(p *Program, sp int, vt *defs.Type) {
+    p.use(sp)
+    p.add(OP_make_state)
+    p.i64(OP_compact_map_begin, int64(vt.K.Compact() << 4 | vt.V.Compact()))
+    p.rtt(OP_map_alloc, vt.S)
+    i := p.pc()
+    p.add(OP_ctr_is_zero)
+    self.compileCompactKey(p, sp + 1, vt)
+    self.compileCompactOne(p, sp + 1, vt.V)
+    p.add(OP_ctr_decr)
+    p.jmp(OP_goto, i)
+    p.pin(i)
+    p.add(OP_map_close)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactKey(p *Program, sp int, vt *defs.Type) {
+    switch vt.K.T {
+        case defs.T_bool    : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_i8      : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_double  : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_i16     : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_i32     : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_i64     : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_binary  : p.rtt(OP_compact_map_set_str, vt.S)
+        case defs.T_string  : p.rtt(OP_compact_map_set_str, vt.S)
+        case defs.T_enum    : p.key(OP_compact_map_set_int, vt.K.T, vt.S)
+        case defs.T_pointer : self.compileCompactKeyPtr(p, sp, vt)
+        default             : panic("unreachable")
+    }
+}
+
+func (self *Compiler) compileCompactKeyPtr(p *Program, sp int, vt *defs.Type) {
+    pt := vt.K
+    st := pt.V
+
+    /* must be a struct */
+    if st.T != defs.T_struct {
+        panic("map key cannot be non-struct pointers")
+    }
+
+    /* construct a new object */
+    p.rtt(OP_construct, st.S)
+    self.compileCompactOne(p, sp, st)
+    p.rtt(OP_map_set_pointer, vt.S)
+}
+
+func (self *Compiler) compileCompactNoCopy(p *Program, sp int, vt *defs.Type) {
+    switch {
+        default: {
+            panic("invalid nocopy type: " + vt.String())
+        }
+
+        /* simple strings */
+        case vt.T == defs.T_string: {
+            p.add(OP_compact_str_nocopy)
+        }
+
+        /* simple binaries */
+        case vt.T == defs.T_binary: {
+            p.add(OP_compact_bin_nocopy)
+        }
+
+        /* string pointers */
+        case vt.T == defs.T_pointer && vt.V.T == defs.T_string: {
+            p.use(sp)
+            p.add(OP_make_state)
+            p.rtt(OP_deref, vt.V.S)
+            p.add(OP_compact_str_nocopy)
+            p.add(OP_drop_state)
+        }
+
+        /* binary pointers */
+        case vt.T == defs.T_pointer && vt.V.T == defs.T_binary: {
+            p.use(sp)
+            p.add(OP_make_state)
+            p.rtt(OP_deref, vt.V.S)
+            p.add(OP_compact_bin_nocopy)
+            p.add(OP_drop_state)
+        }
+    }
+}
+
+func (self *Compiler) compileCompactStruct(p *Program, sp int, vt *defs.Type) {
+    var fid int
+    var isu bool
+    var err error
+    var req []int
+    var fvs []defs.Field
+    var ifn unsafe.Pointer
+
+    /* resolve the fields */
+    if fvs, err = defs.ResolveFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* empty struct */
+    if len(fvs) == 0 {
+        p.add(OP_compact_ignore)
+        return
+    }
+
+    /* find the default initializer */
+    if ifn, err = defs.GetDefaultInitializer(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* call the initializer if any */
+    if ifn != nil {
+        p.jsr(OP_initialize, ifn)
+    }
+
+    /* find the maximum field IDs */
+    for _, fv := range fvs {
+        if fid = utils.MaxInt(fid, int(fv.ID)); fv.Spec == defs.Required {
+            req = append(req, int(fv.ID))
+        }
+    }
+
+    /* save the current state */
+    p.use(sp)
+    p.add(OP_make_state)
+
+    /* allocate bitmap for required fields, if needed */
+    if sort.Ints(req); len(req) != 0 {
+        p.tab(OP_struct_bitmap, req)
+    }
+
+    /* field IDs are delta-encoded, reset the last field ID */
+    p.add(OP_compact_begin)
+
+    /* clear the union members left by the previous decoding */
+    if isu {
+        self.compileUnionReset(p, fvs)
+    }
+
+    /* switch jump buffer */
+    i := p.pc()
+    s := make([]int, fid + 1)
+
+    /* set the default branch */
+    for v := range s {
+        s[v] = -1
+    }
+
+    /* dispatch the next field */
+    p.add(OP_compact_field)
+    j := p.pc()
+    p.add(OP_struct_is_stop)
+    p.tab(OP_compact_switch, s)
+    k := p.pc()
+    p.add(OP_compact_skip)
+    p.jmp(OP_goto, i)
+
+    /* assemble every field */
+    for _, fv := range fvs {
+        s[fv.ID] = p.pc()
+        self.compileCompactCheckType(p, fv, k)
+
+        /* mark the field as seen, if needed */
+        if fv.Spec == defs.Required {
+            p.i64(OP_struct_mark_tag, int64(fv.ID))
+        }
+
+        /* at most one field of a union can be set */
+        if isu {
+            p.fid(OP_struct_union_mark, fv.ID, vt.S)
+        }
+
+        /* seek to the field */
+        off := int64(fv.F)
+        p.i64(OP_seek, off)
+
+        /* check for no-copy strings */
+        if fv.Opts & defs.NoCopy == 0 {
+            self.compileCompactField(p, sp + 1, fv.Type)
+        } else if fv.Type.Tag() == defs.T_string {
+            self.compileCompactNoCopy(p, sp + 1, fv.Type)
+        } else {
+            panic(`"nocopy" is only applicable to "string" or "binary" types`)
+        }
+
+        /* seek back to the beginning */
+        p.i64(OP_seek, -off)
+        p.jmp(OP_goto, i)
+    }
+
+    /* no required fields */
+    if p.pin(j); len(req) == 0 {
+        p.add(OP_drop_state)
+        return
+    }
+
+    /* check all the required fields */
+    p.req(OP_struct_require, vt.S, req)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactCheckType(p *Program, fv defs.Field, to int) {
+    if fv.Opts & defs.Inferred == 0 || fv.Type.Tag() != defs.T_list {
+        p.jcc(OP_compact_check_type, defs.Tag(fv.Type.Compact()), to)
+        return
+    }
+
+    /* slices inferred from "thrift" tags might be sets as well, both have the same layout */
+    i := p.pc()
+    p.jcc(OP_compact_check_type, defs.Tag(defs.C_set), -1)
+    j := p.pc()
+    p.jmp(OP_goto, -1)
+    p.pin(i)
+    p.jcc(OP_compact_check_type, defs.Tag(defs.C_list), to)
+    p.pin(j)
+}
+
+func (self *Compiler) compileCompactField(p *Program, sp int, vt *defs.Type) {
+    switch {
+        default: {
+            self.compileCompactOne(p, sp, vt)
+        }
+
+        /* boolean values are encoded within the field type */
+        case vt.T == defs.T_bool: {
+            p.add(OP_compact_field_bool)
+        }
+
+        /* boolean pointers */
+        case vt.T == defs.T_pointer && vt.V.T == defs.T_bool: {
+            p.use(sp)
+            p.add(OP_make_state)
+            p.rtt(OP_deref, vt.V.S)
+            p.add(OP_compact_field_bool)
+            p.add(OP_drop_state)
+        }
+    }
+}
+
+func (self *Compiler) compileCompactSetList(p *Program, sp int, et *defs.Type) {
+    p.use(sp)
+    p.add(OP_make_state)
+    p.tag(OP_compact_list_begin, defs.Tag(et.Compact()))
+    p.rtt(OP_list_alloc, et.S)
+    i := p.pc()
+    p.add(OP_ctr_is_zero)
+    j := p.pc()
+    self.compileCompactOne(p, sp + 1, et)
+    p.add(OP_ctr_decr)
+    k := p.pc()
+    p.add(OP_ctr_is_zero)
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.jmp(OP_goto, j)
+    p.pin(i)
+    p.pin(k)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactArray(p *Program, sp int, vt *defs.Type) {
+    et := vt.V
+    p.use(sp)
+    p.add(OP_make_state)
+    p.tag(OP_compact_list_begin, defs.Tag(et.Compact()))
+    p.rtt(OP_array_check, vt.S)
+    i := p.pc()
+    p.add(OP_ctr_is_zero)
+    j := p.pc()
+    self.compileCompactOne(p, sp + 1, et)
+    p.add(OP_ctr_decr)
+    k := p.pc()
+    p.add(OP_ctr_is_zero)
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.jmp(OP_goto, j)
+    p.pin(i)
+    p.pin(k)
+    p.add(OP_drop_state)
+}
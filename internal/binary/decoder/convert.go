@@ -0,0 +1,53 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `fmt`
+    `math`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+func uintMax(vt *rt.GoType, nb int64) int64 {
+    nv := uint64(1) << (nb * 8 - 1) - 1
+    ng := ^uint64(0) >> (64 - vt.Size * 8)
+
+    /* the value must fit both the wire type and the Go type */
+    if ng < nv {
+        return int64(ng)
+    } else {
+        return int64(nv)
+    }
+}
+
+func float64to32(v uint64) (uint64, error) {
+    fv := math.Float64frombits(v)
+    rv := float32(fv)
+
+    /* rounding is allowed, but the value must not overflow */
+    if math.IsInf(float64(rv), 0) && !math.IsInf(fv, 0) {
+        return 0, fmt.Errorf("frugal: value %g out of range for float32", fv)
+    } else {
+        return uint64(math.Float32bits(rv)), nil
+    }
+}
+
+var (
+    F_float64to32 = hir.RegisterGCall(float64to32, emu_gcall_float64to32)
+)
@@ -18,12 +18,15 @@ package decoder
 
 import (
     `reflect`
+    `runtime`
     `sync/atomic`
+    `time`
     `unsafe`
 
     `github.com/cloudwego/frugal/internal/opts`
     `github.com/cloudwego/frugal/internal/rt`
     `github.com/cloudwego/frugal/internal/utils`
+    `github.com/cloudwego/frugal/iov`
 )
 
 type Decoder func (
@@ -36,36 +39,66 @@ type Decoder func (
 ) (int, error)
 
 var (
-    HitCount  uint64 = 0
-    MissCount uint64 = 0
-    TypeCount uint64 = 0
+    HitCount    uint64 = 0
+    MissCount   uint64 = 0
+    TypeCount   uint64 = 0
+    CachedCount uint64 = 0
+)
+
+var (
+    TypeStats utils.StatsTable
 )
 
 var (
     programCache = utils.CreateProgramCache()
+    compactCache = utils.CreateProgramCache()
 )
 
 func decode(vt *rt.GoType, buf unsafe.Pointer, nb int, i int, p unsafe.Pointer, rs *RuntimeState, st int) (int, error) {
     if dec, err := resolve(vt); err != nil {
         return 0, err
     } else {
-        return dec(buf, nb, i, p, rs, st)
+        rv, ex := dec(buf, nb, i, p, rs, st)
+
+        /* the machine code is unloaded once the decoder becomes unreachable, keep it until it returns */
+        runtime.KeepAlive(dec)
+        return rv, ex
+    }
+}
+
+func decode_compact(vt *rt.GoType, buf unsafe.Pointer, nb int, i int, p unsafe.Pointer, rs *RuntimeState, st int) (int, error) {
+    if dec, err := resolveCompact(vt); err != nil {
+        return 0, err
+    } else {
+        rv, ex := dec(buf, nb, i, p, rs, st)
+
+        /* the machine code is unloaded once the decoder becomes unreachable, keep it until it returns */
+        runtime.KeepAlive(dec)
+        return rv, ex
     }
 }
 
 func resolve(vt *rt.GoType) (Decoder, error) {
+    return resolveWith(programCache, vt, compile)
+}
+
+func resolveCompact(vt *rt.GoType) (Decoder, error) {
+    return resolveWith(compactCache, vt, compileCompact)
+}
+
+func resolveWith(pc *utils.ProgramCache, vt *rt.GoType, fn func(*rt.GoType) (interface{}, error)) (Decoder, error) {
     var err error
     var val interface{}
 
     /* fast-path: type is cached */
-    if val = programCache.Get(vt); val != nil {
+    if val = pc.Get(vt); val != nil {
         atomic.AddUint64(&HitCount, 1)
         return val.(Decoder), nil
     }
 
     /* record the cache miss, and compile the type */
     atomic.AddUint64(&MissCount, 1)
-    val, err = programCache.Compute(vt, compile)
+    val, err = pc.Compute(vt, fn)
 
     /* check for errors */
     if err != nil {
@@ -77,38 +110,75 @@ func resolve(vt *rt.GoType) (Decoder, error) {
     return val.(Decoder), nil
 }
 
+func Unload(vt *rt.GoType) (ret bool) {
+    for _, pc := range []*utils.ProgramCache { programCache, compactCache } {
+        if pc.Remove(vt) {
+            ret = true
+            atomic.AddUint64(&TypeCount, ^uint64(0))
+        }
+    }
+
+    /* remove the statistics as well */
+    TypeStats.Remove(vt)
+    return
+}
+
 func compile(vt *rt.GoType) (interface{}, error) {
-    if pp, err := CreateCompiler().CompileAndFree(vt.Pack()); err != nil {
+    if pp, err := frontend(vt, opts.GetDefaultOptions(), nil, (*Compiler).Compile); err != nil {
+        return nil, err
+    } else {
+        return linkAsync(programCache, vt, pp, link), nil
+    }
+}
+
+func compileCompact(vt *rt.GoType) (interface{}, error) {
+    if pp, err := frontend(vt, opts.GetDefaultOptions(), nil, (*Compiler).CompileCompact); err != nil {
         return nil, err
     } else {
-        return Link(Translate(pp)), nil
+        return linkAsync(compactCache, vt, pp, LinkProgram), nil
     }
 }
 
 func mkcompile(ty map[reflect.Type]struct{}, opts opts.Options) func(*rt.GoType) (interface{}, error) {
     return func(vt *rt.GoType) (interface{}, error) {
-        cc := CreateCompiler()
-        pp, err := cc.Apply(opts).Compile(vt.Pack())
+        if pp, err := frontend(vt, opts, ty, (*Compiler).Compile); err != nil {
+            return nil, err
+        } else {
+            return link(vt, pp), nil
+        }
+    }
+}
+
+func frontend(vt *rt.GoType, o opts.Options, ty map[reflect.Type]struct{}, fn func(*Compiler, reflect.Type) (Program, error)) (Program, error) {
+    ts := time.Now()
+    cc := CreateCompiler().Apply(o)
+    pp, err := fn(cc, vt.Pack())
 
-        /* add all the deferred types */
+    /* add all the deferred types if needed */
+    if ty != nil {
         for t := range cc.d {
             ty[t] = struct{}{}
         }
+    }
 
-        /* translate and link the program */
-        if err != nil {
-            return nil, err
-        } else {
-            return Link(Translate(pp)), nil
-        }
+    /* record the compilation statistics */
+    if err == nil {
+        st := TypeStats.Of(vt)
+        st.Since(&st.Frontend, ts)
+        st.Store(&st.Inlined, cc.n)
+        st.Store(&st.Deferred, pp.deferred())
     }
+
+    /* release the compiler */
+    cc.Free()
+    return pp, err
 }
 
-type DecodeError struct {
+type InvalidUnmarshalError struct {
     vt *rt.GoType
 }
 
-func (self DecodeError) Error() string {
+func (self InvalidUnmarshalError) Error() string {
     if self.vt == nil {
         return "frugal: unmarshal to nil interface"
     } else if self.vt.Kind() == reflect.Ptr {
@@ -142,12 +212,28 @@ func Pretouch(vt *rt.GoType, opts opts.Options) (map[reflect.Type]struct{}, erro
 }
 
 func DecodeObject(buf []byte, val interface{}) (ret int, err error) {
+    return decodeWith(decode, buf, val, opts.GetDefaultOptions(), false)
+}
+
+func DecodeObjectWithOptions(buf []byte, val interface{}, o opts.Options) (ret int, err error) {
+    return decodeWith(decode, buf, val, o, false)
+}
+
+func DecodeCompact(buf []byte, val interface{}) (ret int, err error) {
+    return decodeWith(decode_compact, buf, val, opts.GetDefaultOptions(), true)
+}
+
+func DecodeCompactWithOptions(buf []byte, val interface{}, o opts.Options) (ret int, err error) {
+    return decodeWith(decode_compact, buf, val, o, true)
+}
+
+func decodeWith(fn func(*rt.GoType, unsafe.Pointer, int, int, unsafe.Pointer, *RuntimeState, int) (int, error), buf []byte, val interface{}, o opts.Options, compact bool) (ret int, err error) {
     vv := rt.UnpackEface(val)
     vt := vv.Type
 
     /* check for nil interface */
     if vt == nil || vv.Value == nil || vt.Kind() != reflect.Ptr {
-        return 0, DecodeError { vt }
+        return 0, InvalidUnmarshalError { vt }
     }
 
     /* create a new runtime state */
@@ -155,8 +241,73 @@ func DecodeObject(buf []byte, val interface{}) (ret int, err error) {
     st := newRuntimeState()
     sl := (*rt.GoSlice)(unsafe.Pointer(&buf))
 
-    /* call the encoder, and return the runtime state into pool */
-    ret, err = decode(et, sl.Ptr, sl.Len, 0, vv.Value, st, 0)
+    /* apply the decoding limits */
+    st.limit(o)
+
+    /* call the decoder, and return the runtime state into pool */
+    ret, err = fn(et, sl.Ptr, sl.Len, 0, vv.Value, st, 0)
+    st.Bp = nil
     freeRuntimeState(st)
+
+    /* report the error position, or sample the call if succeeded */
+    if err != nil {
+        err = newDecodeError(err, et, buf, ret, compact)
+    } else {
+        TypeStats.Sample(et, ret)
+    }
+
+    /* all done */
     return
 }
+
+func DecodeReader(r iov.BufferReader, val interface{}) (ret int, err error) {
+    return decodeReaderWith(decode, r, val, opts.GetDefaultOptions(), false)
+}
+
+func DecodeReaderWithOptions(r iov.BufferReader, val interface{}, o opts.Options) (ret int, err error) {
+    return decodeReaderWith(decode, r, val, o, false)
+}
+
+func DecodeCompactReader(r iov.BufferReader, val interface{}) (ret int, err error) {
+    return decodeReaderWith(decode_compact, r, val, opts.GetDefaultOptions(), true)
+}
+
+func DecodeCompactReaderWithOptions(r iov.BufferReader, val interface{}, o opts.Options) (ret int, err error) {
+    return decodeReaderWith(decode_compact, r, val, o, true)
+}
+
+func decodeReaderWith(fn func(*rt.GoType, unsafe.Pointer, int, int, unsafe.Pointer, *RuntimeState, int) (int, error), r iov.BufferReader, val interface{}, o opts.Options, compact bool) (ret int, err error) {
+    vv := rt.UnpackEface(val)
+    vt := vv.Type
+
+    /* check for nil interface */
+    if vt == nil || vv.Value == nil || vt.Kind() != reflect.Ptr {
+        return 0, InvalidUnmarshalError { vt }
+    }
+
+    /* create a new runtime state, and start with the first segment */
+    et := rt.PtrElem(vt)
+    st := newRuntimeState()
+    rd := &bufferReader { r: r }
+    buf := r.Segment()
+    sl := (*rt.GoSlice)(unsafe.Pointer(&buf))
+
+    /* call the decoder, and consume the decoded bytes */
+    st.Rd = rd
+    st.limit(o)
+    ret, err = fn(et, sl.Ptr, sl.Len, 0, vv.Value, st, 0)
+
+    /* skip the remaining bytes if succeeded, otherwise report the error position */
+    if err == nil {
+        err = r.Skip(ret)
+        TypeStats.Sample(et, rd.i + ret)
+    } else {
+        err = newDecodeError(err, et, nil, rd.i + ret, compact)
+    }
+
+    /* return the runtime state into pool */
+    st.Rd = nil
+    st.Bp = nil
+    freeRuntimeState(st)
+    return rd.i + ret, err
+}
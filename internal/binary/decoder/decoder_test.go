@@ -168,3 +168,37 @@ func TestDecoder_NoCopyString(t *testing.T) {
     println("v.F: nocopy =", &(*v.F)[0])
     spew.Dump(v)
 }
+
+type CompactTestStruct struct {
+    A bool             `frugal:"1,default,bool"`
+    B int32            `frugal:"2,default,i32"`
+    C []int64          `frugal:"3,default,list<i64>"`
+    D map[string]int16 `frugal:"100,default,map<string:i16>"`
+    E *bool            `frugal:"101,optional,bool"`
+}
+
+func TestDecoder_Compact(t *testing.T) {
+    var v CompactTestStruct
+    buf := []byte {
+        0x11,                                                   // field 1: bool, true
+        0x15, 0x03,                                             // field 2: i32, -2
+        0x19, 0x26, 0x02, 0x01,                                 // field 3: list<i64>, len = 2, [1, -1]
+        0x0b, 0xc8, 0x01, 0x01, 0x84,                           // field 100: map<string, i16>, len = 1
+        0x01, 'a', 0x06,                                        //     "a" = 3
+        0x19, 0x1c, 0x00,                                       // field 101: list<struct>, unknown, skipped
+        0x12,                                                   // field 102: bool, false, unknown, skipped
+        0x11,                                                   // field 103: bool, true, unknown, skipped
+        0x00,                                                   // end
+    }
+    pos, err := DecodeCompact(buf, &v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), pos)
+    require.Equal(t, CompactTestStruct {
+        A: true,
+        B: -2,
+        C: []int64{1, -1},
+        D: map[string]int16{"a": 3},
+    }, v)
+    _, err = DecodeCompact(buf[:10], &v)
+    require.Error(t, err)
+}
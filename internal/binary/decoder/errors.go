@@ -21,6 +21,7 @@ import (
     `math/bits`
 
     `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
     `github.com/cloudwego/frugal/internal/rt`
 )
 
@@ -32,26 +33,235 @@ func error_eof(n int) error {
 //go:nosplit
 func error_skip(e int) error {
     switch e {
-        case ETAG   : return fmt.Errorf("frugal: error when skipping fields: -1 (invalid tag)")
-        case EEOF   : return fmt.Errorf("frugal: error when skipping fields: -2 (unexpected EOF)")
-        case ESTACK : return fmt.Errorf("frugal: error when skipping fields: -3 (value nesting too deep)")
-        default     : return fmt.Errorf("frugal: error when skipping fields: %d (unknown error)", e)
+        case ETAG    : return fmt.Errorf("frugal: error when skipping fields: -1 (invalid tag)")
+        case EEOF    : return fmt.Errorf("frugal: error when skipping fields: -2 (unexpected EOF)")
+        case ESTACK  : return fmt.Errorf("frugal: error when skipping fields: -3 (value nesting too deep)")
+        case EVARINT : return fmt.Errorf("frugal: error when skipping fields: -4 (varint too long)")
+        default      : return fmt.Errorf("frugal: error when skipping fields: %d (unknown error)", e)
+    }
+}
+
+//go:nosplit
+func error_varint(e int) error {
+    switch e {
+        case EEOF    : return fmt.Errorf("frugal: error when decoding varint: -2 (unexpected EOF)")
+        case EVARINT : return fmt.Errorf("frugal: error when decoding varint: -4 (varint too long)")
+        default      : return fmt.Errorf("frugal: error when decoding varint: %d (unknown error)", e)
     }
 }
 
 //go:nosplit
 func error_type(e uint8, t uint8) error {
-    return fmt.Errorf("frugal: type mismatch: %d expected, got %d", e, t)
+    return &typeError { e, t }
 }
 
 //go:nosplit
 func error_missing(t *rt.GoType, i int, m uint64) error {
-    return fmt.Errorf("frugal: missing required field %d for type %s", i * 64 + bits.TrailingZeros64(m), t)
+    return &missingError { t, i * 64 + bits.TrailingZeros64(m) }
+}
+
+//go:nosplit
+func error_union(t *rt.GoType, a int, b int) error {
+    return fmt.Errorf("frugal: multiple fields set for union %s: %d and %d", t, a, b)
+}
+
+//go:nosplit
+func error_range(t *rt.GoType, v uint64, nb int) error {
+    return fmt.Errorf("frugal: value %d out of range for %s", int64(v << (64 - nb * 8)) >> (64 - nb * 8), t)
+}
+
+//go:nosplit
+func error_length(t *rt.GoType, n int) error {
+    return fmt.Errorf("frugal: length mismatch for %s: expected %d, got %d", t, t.Pack().Len(), n)
+}
+
+//go:nosplit
+func error_limit(rs *RuntimeState, k LimitKind, v uint64) error {
+    if k & _LimitHeader == 0 {
+        return limitError(rs, k, v)
+    } else {
+        return &headerError { limitError(rs, k &^ _LimitHeader, v) }
+    }
+}
+
+func limitError(rs *RuntimeState, k LimitKind, v uint64) *LimitError {
+    switch k {
+        case LimitAllocSize    : return &LimitError { k, int(rs.Lm), int(rs.La - ^rs.Lm + v) }
+        case LimitContainerSize: return &LimitError { k, int(^rs.Lc), int(v) }
+        case LimitStringSize   : return &LimitError { k, int(^rs.Ls), int(v) }
+        case LimitNestingDepth : return &LimitError { k, int(^rs.Ld / uint64(StateSize)), int(v / uint64(StateSize)) + 1 }
+        default                : panic("unreachable")
+    }
 }
 
 var (
-    F_error_eof     = hir.RegisterGCall(error_eof, emu_gcall_error_eof)
-    F_error_skip    = hir.RegisterGCall(error_skip, emu_gcall_error_skip)
     F_error_type    = hir.RegisterGCall(error_type, emu_gcall_error_type)
+    F_error_limit   = hir.RegisterGCall(error_limit, emu_gcall_error_limit)
+    F_error_union   = hir.RegisterGCall(error_union, emu_gcall_error_union)
+    F_error_range   = hir.RegisterGCall(error_range, emu_gcall_error_range)
+    F_error_length  = hir.RegisterGCall(error_length, emu_gcall_error_length)
     F_error_missing = hir.RegisterGCall(error_missing, emu_gcall_error_missing)
 )
+
+type LimitKind uint8
+
+const (
+    LimitAllocSize LimitKind = iota
+    LimitContainerSize
+    LimitStringSize
+    LimitNestingDepth
+)
+
+const (
+    _LimitHeader LimitKind = 0x40   // The limit is exceeded right after a container header.
+)
+
+func (self LimitKind) String() string {
+    switch self {
+        case LimitAllocSize     : return "allocation size"
+        case LimitContainerSize : return "container size"
+        case LimitStringSize    : return "string size"
+        case LimitNestingDepth  : return "nesting depth"
+        default                 : return fmt.Sprintf("LimitKind(%d)", self)
+    }
+}
+
+type LimitError struct {
+    Kind  LimitKind
+    Limit int
+    Value int
+}
+
+func (self *LimitError) Error() string {
+    return fmt.Sprintf("frugal: %s limit exceeded: %d > %d", self.Kind, self.Value, self.Limit)
+}
+
+/* headerError wraps the errors raised right after a container header, before any of the
+ * elements is decoded, the error is located at the container rather than its first element */
+type headerError struct {
+    error
+}
+
+func (self *headerError) Unwrap() error {
+    return self.error
+}
+
+type typeError struct {
+    e uint8
+    t uint8
+}
+
+func (self *typeError) Error() string {
+    return fmt.Sprintf("frugal: type mismatch: %d expected, got %d", self.e, self.t)
+}
+
+type missingError struct {
+    t  *rt.GoType
+    id int
+}
+
+func (self *missingError) Error() string {
+    return fmt.Sprintf("frugal: missing required field %d for type %s", self.id, self.t)
+}
+
+type WireType uint8
+
+var wireTypeNames = [256]string {
+    defs.T_bool   : "bool",
+    defs.T_i8     : "i8",
+    defs.T_double : "double",
+    defs.T_i16    : "i16",
+    defs.T_i32    : "i32",
+    defs.T_i64    : "i64",
+    defs.T_string : "string",
+    defs.T_struct : "struct",
+    defs.T_map    : "map",
+    defs.T_set    : "set",
+    defs.T_list   : "list",
+}
+
+func (self WireType) String() string {
+    if name := wireTypeNames[self]; name != "" {
+        return name
+    } else {
+        return fmt.Sprintf("WireType(%d)", uint8(self))
+    }
+}
+
+type DecodeError struct {
+    Offset    int
+    FieldPath string
+    Expected  WireType
+    Actual    WireType
+    Cause     error
+}
+
+func (self *DecodeError) Error() string {
+    if self.FieldPath == "" {
+        return fmt.Sprintf("%s (at offset %d)", self.Cause, self.Offset)
+    } else {
+        return fmt.Sprintf("%s (at offset %d, field %s)", self.Cause, self.Offset, self.FieldPath)
+    }
+}
+
+func (self *DecodeError) Unwrap() error {
+    return self.Cause
+}
+
+func isHeaderError(err error) bool {
+    switch e := err.(type) {
+        case *missingError : return true
+        case *LimitError   : return e.Kind == LimitContainerSize
+        default            : return false
+    }
+}
+
+func newDecodeError(err error, vt *rt.GoType, buf []byte, off int, compact bool) error {
+    var ok bool
+    var pos int
+    var tv *defs.Type
+    var te *typeError
+    var he *headerError
+
+    /* errors raised at container headers are located at the header */
+    if he, ok = err.(*headerError); ok {
+        err = he.error
+    }
+
+    /* only type mismatches carry the wire types */
+    ret := &DecodeError {
+        Cause  : err,
+        Offset : off,
+    }
+
+    /* extract the wire types */
+    if te, ok = err.(*typeError); ok {
+        if !compact {
+            ret.Expected, ret.Actual = WireType(te.e), WireType(te.t)
+        } else {
+            ret.Expected, ret.Actual = WireType(defs.CompactTag(te.e).Tag()), WireType(defs.CompactTag(te.t).Tag())
+        }
+    }
+
+    /* the field path can only be found with the entire input */
+    if buf == nil {
+        return ret
+    }
+
+    /* missing fields are detected after the STOP field of the struct, and
+     * container sizes are checked after the container header */
+    if he != nil || isHeaderError(err) {
+        pos = off - 1
+    } else {
+        pos = off
+    }
+
+    /* find the field path, the type must have been parsed successfully */
+    if tv, err = defs.ParseType(vt.Pack(), ""); err == nil {
+        ret.FieldPath = locate(tv, buf, pos, compact)
+        tv.Free()
+    }
+
+    /* all done */
+    return ret
+}
@@ -29,27 +29,43 @@ func emu_seterr(ctx hir.CallContext, i int, err error) {
     ctx.Rp(i + 1, vv.Value)
 }
 
-func emu_gcall_error_eof(ctx hir.CallContext) {
-    if !ctx.Verify("i", "**") {
-        panic("invalid error_eof call")
+func emu_gcall_error_type(ctx hir.CallContext) {
+    if !ctx.Verify("ii", "**") {
+        panic("invalid error_type call")
     } else {
-        emu_seterr(ctx, 0, error_eof(int(ctx.Au(0))))
+        emu_seterr(ctx, 0, error_type(uint8(ctx.Au(0)), uint8(ctx.Au(1))))
     }
 }
 
-func emu_gcall_error_skip(ctx hir.CallContext) {
-    if !ctx.Verify("i", "**") {
-        panic("invalid error_skip call")
+func emu_gcall_error_union(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid error_union call")
     } else {
-        emu_seterr(ctx, 0, error_skip(int(ctx.Au(0))))
+        emu_seterr(ctx, 0, error_union((*rt.GoType)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2))))
     }
 }
 
-func emu_gcall_error_type(ctx hir.CallContext) {
-    if !ctx.Verify("ii", "**") {
-        panic("invalid error_type call")
+func emu_gcall_error_range(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid error_range call")
     } else {
-        emu_seterr(ctx, 0, error_type(uint8(ctx.Au(0)), uint8(ctx.Au(1))))
+        emu_seterr(ctx, 0, error_range((*rt.GoType)(ctx.Ap(0)), ctx.Au(1), int(ctx.Au(2))))
+    }
+}
+
+func emu_gcall_error_length(ctx hir.CallContext) {
+    if !ctx.Verify("*i", "**") {
+        panic("invalid error_length call")
+    } else {
+        emu_seterr(ctx, 0, error_length((*rt.GoType)(ctx.Ap(0)), int(ctx.Au(1))))
+    }
+}
+
+func emu_gcall_error_limit(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid error_limit call")
+    } else {
+        emu_seterr(ctx, 0, error_limit((*RuntimeState)(ctx.Ap(0)), LimitKind(ctx.Au(1)), ctx.Au(2)))
     }
 }
 
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `encoding/binary`
+    `math`
+
+    `github.com/cloudwego/frugal/generic`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* Generic decoding runs the skipping state machine over the input first, so malformed
+ * input is rejected with the very same errors and nesting limit as the compiled decoders,
+ * then builds the value tree from the already validated bytes. */
+
+type _GenericDecoder struct {
+    buf []byte
+}
+
+// DecodeGeneric decodes a struct from buf into a generic value tree.
+func DecodeGeneric(buf []byte) (generic.Struct, int, error) {
+    var rv generic.Struct
+
+    /* validate the input */
+    n, err := Skip(buf, defs.T_struct)
+    if err != nil {
+        return rv, 0, err
+    }
+
+    /* build the value tree */
+    dec := _GenericDecoder { buf: buf[:n] }
+    rv, _ = dec.structValue(0)
+    return rv, n, nil
+}
+
+func (self _GenericDecoder) u16(i int) int {
+    return int(binary.BigEndian.Uint16(self.buf[i:]))
+}
+
+func (self _GenericDecoder) u32(i int) int {
+    return int(binary.BigEndian.Uint32(self.buf[i:]))
+}
+
+func (self _GenericDecoder) u64(i int) uint64 {
+    return binary.BigEndian.Uint64(self.buf[i:])
+}
+
+func (self _GenericDecoder) value(t generic.Type, i int) (generic.Value, int) {
+    switch t {
+        case generic.TypeBool   : return generic.Bool(self.buf[i] != 0), i + 1
+        case generic.TypeI8     : return generic.I8(self.buf[i]), i + 1
+        case generic.TypeI16    : return generic.I16(self.u16(i)), i + 2
+        case generic.TypeI32    : return generic.I32(self.u32(i)), i + 4
+        case generic.TypeI64    : return generic.I64(self.u64(i)), i + 8
+        case generic.TypeDouble : return generic.Double(math.Float64frombits(self.u64(i))), i + 8
+        case generic.TypeBinary : return self.binaryValue(i)
+        case generic.TypeStruct : return self.structValue(i)
+        case generic.TypeMap    : return self.mapValue(i)
+        case generic.TypeSet    : return self.setValue(i)
+        case generic.TypeList   : return self.listValue(i)
+        default                 : panic("unreachable")
+    }
+}
+
+func (self _GenericDecoder) binaryValue(i int) (generic.Value, int) {
+    nb := self.u32(i)
+    rv := make(generic.Binary, nb)
+    return rv, i + 4 + copy(rv, self.buf[i + 4:])
+}
+
+func (self _GenericDecoder) structValue(i int) (generic.Struct, int) {
+    var vv generic.Value
+    var rv generic.Struct
+
+    /* read every field until STOP */
+    for rv.Fields = make(map[int16]generic.Value); self.buf[i] != 0; {
+        id := int16(self.u16(i + 1))
+        vv, i = self.value(generic.Type(self.buf[i]), i + 3)
+        rv.Fields[id] = vv
+    }
+
+    /* skip the STOP field */
+    return rv, i + 1
+}
+
+func (self _GenericDecoder) values(t generic.Type, nb int, i int) ([]generic.Value, int) {
+    vv := generic.Value(nil)
+    rv := make([]generic.Value, 0, nb)
+
+    /* read every element */
+    for n := 0; n < nb; n++ {
+        vv, i = self.value(t, i)
+        rv = append(rv, vv)
+    }
+
+    /* all done */
+    return rv, i
+}
+
+func (self _GenericDecoder) listValue(i int) (generic.Value, int) {
+    et := generic.Type(self.buf[i])
+    vv, i := self.values(et, self.u32(i + 1), i + 5)
+    return generic.List { Elem: et, Values: vv }, i
+}
+
+func (self _GenericDecoder) setValue(i int) (generic.Value, int) {
+    et := generic.Type(self.buf[i])
+    vv, i := self.values(et, self.u32(i + 1), i + 5)
+    return generic.Set { Elem: et, Values: vv }, i
+}
+
+func (self _GenericDecoder) mapValue(i int) (generic.Value, int) {
+    nb := self.u32(i + 2)
+    rv := generic.Map { Key: generic.Type(self.buf[i]), Elem: generic.Type(self.buf[i + 1]) }
+
+    /* read every key-value pair */
+    for i, rv.Pairs = i + 6, make([]generic.Pair, nb); nb > 0; nb-- {
+        p := &rv.Pairs[len(rv.Pairs) - nb]
+        p.Key, i = self.value(rv.Key, i)
+        p.Value, i = self.value(rv.Elem, i)
+    }
+
+    /* all done */
+    return rv, i
+}
@@ -17,28 +17,75 @@
 package decoder
 
 import (
+    `time`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/cache`
+    `github.com/cloudwego/frugal/internal/atm/dump`
     `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
     `github.com/cloudwego/frugal/internal/utils`
 )
 
 type Linker interface {
-    Link(p hir.Program) Decoder
+    Link(vt *rt.GoType, p hir.Program) Decoder
+    LinkCached(vt *rt.GoType, pp Program) Decoder
 }
 
 var (
-    linker   Linker
-    F_decode *hir.CallHandle
+    linker           Linker
+    F_decode         *hir.CallHandle
+    F_decode_compact *hir.CallHandle
 )
 
 func init() {
     F_decode = hir.RegisterGCall(decode, emu_gcall_decode)
+    F_decode_compact = hir.RegisterGCall(decode_compact, emu_gcall_decode_compact)
 }
 
-func Link(p hir.Program) Decoder {
+func Link(vt *rt.GoType, p hir.Program) Decoder {
     if linker == nil || utils.ForceEmulator {
         return link_emu(p)
     } else {
-        return linker.Link(p)
+        return linker.Link(vt, p)
+    }
+}
+
+func LinkProgram(vt *rt.GoType, pp Program) (fn Decoder) {
+    if linker == nil || utils.ForceEmulator || !cache.Enabled() {
+        st := TypeStats.Of(vt)
+        ts := time.Now()
+        p := Translate(pp)
+
+        /* the code generation is timed by the linker */
+        st.Since(&st.Translate, ts)
+        fn = Link(vt, p)
+    } else {
+        fn = linker.LinkCached(vt, pp)
+    }
+
+    /* dump the compilation pipeline if needed */
+    if dump.Enabled(vt) {
+        dumpProgram(vt, pp, fn)
+    }
+
+    /* all done */
+    return
+}
+
+func dumpProgram(vt *rt.GoType, pp Program, fn Decoder) {
+    p := Translate(pp)
+    d := dump.Open("decoder", vt, pp.Fingerprint())
+
+    /* the opcode program, the HIR and the SSA form */
+    d.Write("program.txt", pp.Disassemble())
+    d.Write("hir.txt", p.Disassemble())
+    st := TypeStats.Of(vt)
+    st.Add(&st.SSA, d.SSA(p, (Decoder)(nil)))
+
+    /* the machine code is only available with the native backend */
+    if linker != nil && !utils.ForceEmulator {
+        d.Code(*(*unsafe.Pointer)(unsafe.Pointer(&fn)))
     }
 }
 
@@ -17,11 +17,15 @@
 package decoder
 
 import (
+    `sync/atomic`
+    `time`
     `unsafe`
 
+    `github.com/cloudwego/frugal/internal/atm/cache`
     `github.com/cloudwego/frugal/internal/atm/hir`
     `github.com/cloudwego/frugal/internal/atm/pgen`
     `github.com/cloudwego/frugal/internal/loader`
+    `github.com/cloudwego/frugal/internal/rt`
 )
 
 type (
@@ -36,8 +40,56 @@ func init() {
     SetLinker(new(LinkerAMD64))
 }
 
-func (LinkerAMD64) Link(p hir.Program) Decoder {
+func (LinkerAMD64) Link(vt *rt.GoType, p hir.Program) Decoder {
+    ts := time.Now()
     fn := pgen.CreateCodeGen((Decoder)(nil)).Generate(p, _NativeStackSize)
-    fp := loader.Loader(fn.Code).Load("decoder", fn.Frame)
+    fp := loader.Loader(fn.Code).LoadCollectable("decoder_" + vt.String(), fn.Frame)
+    st := TypeStats.Of(vt)
+
+    /* record the statistics */
+    st.Since(&st.Codegen, ts)
+    st.Store(&st.CodeSize, len(loader.CodeOf(fp)))
+    return *(*Decoder)(unsafe.Pointer(&fp))
+}
+
+func (LinkerAMD64) LinkCached(vt *rt.GoType, pp Program) Decoder {
+    td := time.Duration(0)
+    ts := time.Now()
+
+    /* the program is only translated if the cache missed */
+    fp, ok := cache.Link("decoder", vt, pp.Fingerprint(), pp.symbols(), func() *pgen.Func {
+        p := Translate(pp)
+        td = time.Since(ts)
+        return pgen.CreateCodeGen((Decoder)(nil)).Relocatable().Generate(p, _NativeStackSize)
+    })
+
+    /* record the statistics */
+    st := TypeStats.Of(vt)
+    atomic.AddInt64(&st.Translate, int64(td))
+    atomic.AddInt64(&st.Codegen, int64(time.Since(ts) - td))
+    st.Store(&st.CodeSize, len(loader.CodeOf(fp)))
+
+    /* loaded from the cache */
+    if ok {
+        atomic.AddUint64(&CachedCount, 1)
+    }
+
+    /* convert to the function */
     return *(*Decoder)(unsafe.Pointer(&fp))
 }
+
+func (self Program) symbols() *cache.Symbols {
+    ret := new(cache.Symbols)
+    ret.Add(unsafe.Pointer(&_E_overflow))
+    ret.Add(unsafe.Pointer(&_V_zerovalue))
+    ret.Add(unsafe.Pointer(_T_byte))
+
+    /* types and functions referenced by the instructions */
+    for _, v := range self {
+        ret.Add(unsafe.Pointer(v.Vt))
+        ret.Add(v.Fn)
+    }
+
+    /* all done */
+    return ret
+}
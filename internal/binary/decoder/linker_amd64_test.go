@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package decoder
+
+import (
+    `io/ioutil`
+    `reflect`
+    `sync/atomic`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/internal/utils`
+    `github.com/stretchr/testify/require`
+)
+
+func TestLinker_Cached(t *testing.T) {
+    if utils.ForceEmulator {
+        t.Skip("the cache is not used by the emulator")
+    }
+    dir, nw := opts.CacheDir, opts.AsyncCompileWorkers
+    opts.CacheDir, opts.AsyncCompileWorkers = t.TempDir(), 0
+    defer func() { opts.CacheDir, opts.AsyncCompileWorkers = dir, nw }()
+    vt := rt.UnpackType(reflect.TypeOf(TestWithDefaultValue{}))
+    nc := atomic.LoadUint64(&CachedCount)
+    buf := []byte { 0x0a, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x00 }
+    for i := 0; i < 2; i++ {
+        var v TestWithDefaultValue
+        fn, err := compile(vt)
+        require.NoError(t, err)
+        require.Equal(t, nc + uint64(i), atomic.LoadUint64(&CachedCount))
+        sl := (*rt.GoSlice)(unsafe.Pointer(&buf))
+        pos, err := fn.(Decoder)(sl.Ptr, sl.Len, 0, unsafe.Pointer(&v), new(RuntimeState), 0)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), pos)
+        require.Equal(t, TestWithDefaultValue { B: 100, C: 42 }, v)
+    }
+    fs, err := ioutil.ReadDir(opts.CacheDir)
+    require.NoError(t, err)
+    require.Len(t, fs, 1)
+    require.NoError(t, ioutil.WriteFile(opts.CacheDir + "/" + fs[0].Name(), []byte("corrupted"), 0644))
+    _, err = compile(vt)
+    require.NoError(t, err)
+    require.Equal(t, nc + 1, atomic.LoadUint64(&CachedCount))
+    _, err = compile(vt)
+    require.NoError(t, err)
+    require.Equal(t, nc + 2, atomic.LoadUint64(&CachedCount))
+}
@@ -0,0 +1,466 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package decoder
+
+import (
+    `encoding/binary`
+    `strconv`
+    `strings`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* Locator walks the input along with the type descriptor to find the field path of the
+ * innermost value that contains the failing offset, it's only used when reporting errors,
+ * so it favors simplicity over performance. */
+
+type _Locator struct {
+    ok   bool
+    off  int
+    buf  []byte
+    best string
+    path []string
+}
+
+func locate(vt *defs.Type, buf []byte, off int, compact bool) string {
+    lc := _Locator {
+        off  : off,
+        buf  : buf,
+        path : []string { vt.String() },
+    }
+
+    /* the root type is always dereferenced */
+    if vt.T == defs.T_pointer {
+        lc.path[0] = vt.V.String()
+    }
+
+    /* walk through the buffer */
+    if compact {
+        lc.compactValue(vt, vt.Compact(), 0, true, 0)
+    } else {
+        lc.binaryValue(vt, vt.Tag(), 0, 0)
+    }
+
+    /* use the root path if nothing matches */
+    lc.mark()
+    return lc.best
+}
+
+func (self *_Locator) mark() {
+    if !self.ok {
+        self.ok = true
+        self.best = strings.Join(self.path, "")
+    }
+}
+
+func (self *_Locator) push(s string) {
+    self.path = append(self.path, s)
+}
+
+func (self *_Locator) pop() {
+    self.path = self.path[:len(self.path) - 1]
+}
+
+func (self *_Locator) leave(i int, n int) int {
+    if i <= self.off && (n < 0 || self.off < n) {
+        self.mark()
+    }
+    return n
+}
+
+func (self *_Locator) fixed(i int, nb int) int {
+    if nb < 0 || nb > len(self.buf) - i {
+        return EEOF
+    } else {
+        return i + nb
+    }
+}
+
+func (self *_Locator) field(vt *defs.Type, id int) *defs.Field {
+    if vt == nil || vt.T != defs.T_struct {
+        return nil
+    }
+
+    /* find the field by ID */
+    fvs, err := defs.ResolveFields(vt.S)
+    if err != nil {
+        return nil
+    }
+
+    /* the fields are sorted by ID */
+    for i := range fvs {
+        if int(fvs[i].ID) == id {
+            return &fvs[i]
+        }
+    }
+    return nil
+}
+
+func (self *_Locator) fieldName(fv *defs.Field, id int) string {
+    if fv == nil {
+        return ".#" + strconv.Itoa(id)
+    } else {
+        return "." + fv.Name
+    }
+}
+
+func elemOf(vt *defs.Type) (*defs.Type, *defs.Type) {
+    if vt == nil {
+        return nil, nil
+    } else {
+        return vt.K, vt.V
+    }
+}
+
+func (self *_Locator) binaryValue(vt *defs.Type, tag defs.Tag, i int, depth int) int {
+    if vt != nil && vt.T == defs.T_pointer {
+        vt = vt.V
+    }
+
+    /* custom types are located by their wire types */
+    if vt != nil && vt.T == defs.T_custom {
+        vt = vt.V
+    }
+
+    /* check for the nesting depth */
+    if depth >= defs.StackSize {
+        return self.leave(i, ESTACK)
+    }
+
+    /* check for the value type */
+    switch tag {
+        default              : return self.leave(i, ETAG)
+        case defs.T_bool     : return self.leave(i, self.fixed(i, 1))
+        case defs.T_i8       : return self.leave(i, self.fixed(i, 1))
+        case defs.T_i16      : return self.leave(i, self.fixed(i, 2))
+        case defs.T_i32      : return self.leave(i, self.fixed(i, 4))
+        case defs.T_i64      : return self.leave(i, self.fixed(i, 8))
+        case defs.T_double   : return self.leave(i, self.fixed(i, 8))
+        case defs.T_string   : return self.leave(i, self.binaryString(i))
+        case defs.T_struct   : return self.leave(i, self.binaryStruct(vt, i, depth))
+        case defs.T_map      : return self.leave(i, self.binaryMap(vt, i, depth))
+        case defs.T_set      : return self.leave(i, self.binaryList(vt, i, depth))
+        case defs.T_list     : return self.leave(i, self.binaryList(vt, i, depth))
+    }
+}
+
+func (self *_Locator) binaryString(i int) int {
+    if self.fixed(i, 4) < 0 {
+        return EEOF
+    } else {
+        return self.fixed(i + 4, int(int32(binary.BigEndian.Uint32(self.buf[i:]))))
+    }
+}
+
+func (self *_Locator) binaryStruct(vt *defs.Type, i int, depth int) int {
+    for {
+        if self.fixed(i, 1) < 0 {
+            return EEOF
+        }
+
+        /* check for the STOP field */
+        tag := defs.Tag(self.buf[i])
+        if tag == 0 {
+            return i + 1
+        }
+
+        /* read the field ID */
+        if self.fixed(i, 3) < 0 {
+            return EEOF
+        }
+
+        /* find the field, fields with mismatched types are skipped */
+        id := int(binary.BigEndian.Uint16(self.buf[i + 1:]))
+        fv := self.field(vt, id)
+        ft := (*defs.Type)(nil)
+
+        /* check for field types */
+        if fv != nil && fv.Type.Tag() == tag {
+            ft = fv.Type
+        }
+
+        /* walk through the field value */
+        self.push(self.fieldName(fv, id))
+        i = self.binaryValue(ft, tag, i + 3, depth + 1)
+        self.pop()
+
+        /* check for errors */
+        if i < 0 {
+            return i
+        }
+    }
+}
+
+func (self *_Locator) binaryList(vt *defs.Type, i int, depth int) int {
+    if self.fixed(i, 5) < 0 {
+        return EEOF
+    }
+
+    /* read the element type and count */
+    _, et := elemOf(vt)
+    tag := defs.Tag(self.buf[i])
+    nb := int(int32(binary.BigEndian.Uint32(self.buf[i + 1:])))
+
+    /* the element type must match */
+    if et != nil && et.Tag() != tag {
+        return ETAG
+    }
+
+    /* walk through every element */
+    for n, i := 0, i + 5; ; n++ {
+        if n >= nb || i < 0 {
+            return i
+        }
+
+        /* walk through the element */
+        self.push("[" + strconv.Itoa(n) + "]")
+        i = self.binaryValue(et, tag, i, depth + 1)
+        self.pop()
+    }
+}
+
+func (self *_Locator) binaryMap(vt *defs.Type, i int, depth int) int {
+    if self.fixed(i, 6) < 0 {
+        return EEOF
+    }
+
+    /* read the key and value types, and the count */
+    kt, et := elemOf(vt)
+    tk := defs.Tag(self.buf[i])
+    tv := defs.Tag(self.buf[i + 1])
+    nb := int(int32(binary.BigEndian.Uint32(self.buf[i + 2:])))
+
+    /* the key and value types must match */
+    if kt != nil && (kt.Tag() != tk || et.Tag() != tv) {
+        return ETAG
+    }
+
+    /* walk through every key-value pair */
+    for n, i := 0, i + 6; ; n++ {
+        if n >= nb || i < 0 {
+            return i
+        }
+
+        /* walk through the key */
+        s := "[" + strconv.Itoa(n) + "]"
+        self.push(s + ".key")
+        i = self.binaryValue(kt, tk, i, depth + 1)
+        self.pop()
+
+        /* check for errors */
+        if i < 0 {
+            return i
+        }
+
+        /* walk through the value */
+        self.push(s + ".value")
+        i = self.binaryValue(et, tv, i, depth + 1)
+        self.pop()
+    }
+}
+
+func compactMatch(vt *defs.Type, tag defs.CompactTag) bool {
+    if vt.Tag() != defs.T_bool {
+        return vt.Compact() == tag
+    } else {
+        return tag == defs.C_true || tag == defs.C_false
+    }
+}
+
+func (self *_Locator) compactValue(vt *defs.Type, tag defs.CompactTag, i int, elem bool, depth int) int {
+    if vt != nil && vt.T == defs.T_pointer {
+        vt = vt.V
+    }
+
+    /* custom types are located by their wire types */
+    if vt != nil && vt.T == defs.T_custom {
+        vt = vt.V
+    }
+
+    /* check for the nesting depth */
+    if depth >= defs.StackSize {
+        return self.leave(i, ESTACK)
+    }
+
+    /* check for the value type */
+    switch tag {
+        default              : return self.leave(i, ETAG)
+        case defs.C_true     : return self.leave(i, self.compactBool(i, elem))
+        case defs.C_false    : return self.leave(i, self.compactBool(i, elem))
+        case defs.C_i8       : return self.leave(i, self.fixed(i, 1))
+        case defs.C_i16      : return self.leave(i, self.compactVarint(i))
+        case defs.C_i32      : return self.leave(i, self.compactVarint(i))
+        case defs.C_i64      : return self.leave(i, self.compactVarint(i))
+        case defs.C_double   : return self.leave(i, self.fixed(i, 8))
+        case defs.C_binary   : return self.leave(i, self.compactString(i))
+        case defs.C_struct   : return self.leave(i, self.compactStruct(vt, i, depth))
+        case defs.C_map      : return self.leave(i, self.compactMap(vt, i, depth))
+        case defs.C_set      : return self.leave(i, self.compactList(vt, i, depth))
+        case defs.C_list     : return self.leave(i, self.compactList(vt, i, depth))
+    }
+}
+
+func (self *_Locator) compactBool(i int, elem bool) int {
+    if elem {
+        return self.fixed(i, 1)
+    } else {
+        return i
+    }
+}
+
+func (self *_Locator) compactVarint(i int) int {
+    _, i = compactVarint(self.buf, i)
+    return i
+}
+
+func (self *_Locator) compactString(i int) int {
+    if nb, p := compactVarint(self.buf, i); p < 0 {
+        return p
+    } else if nb > uint64(len(self.buf) - p) {
+        return EEOF
+    } else {
+        return p + int(nb)
+    }
+}
+
+func (self *_Locator) compactStruct(vt *defs.Type, i int, depth int) int {
+    for id := 0; ; {
+        if self.fixed(i, 1) < 0 {
+            return EEOF
+        }
+
+        /* check for the STOP field */
+        dx := int(self.buf[i] >> 4)
+        tag := defs.CompactTag(self.buf[i] & 0x0f)
+        if tag == defs.C_stop {
+            return i + 1
+        }
+
+        /* short form: the delta of field ID, long form: zigzag varint */
+        if i++; dx != 0 {
+            id += dx
+        } else if v, p := compactVarint(self.buf, i); p < 0 {
+            return p
+        } else {
+            i, id = p, int(int16(v >> 1) ^ -int16(v & 1))
+        }
+
+        /* find the field, fields with mismatched types are skipped */
+        fv := self.field(vt, id)
+        ft := (*defs.Type)(nil)
+
+        /* check for field types */
+        if fv != nil && compactMatch(fv.Type, tag) {
+            ft = fv.Type
+        }
+
+        /* walk through the field value */
+        self.push(self.fieldName(fv, id))
+        i = self.compactValue(ft, tag, i, false, depth + 1)
+        self.pop()
+
+        /* check for errors */
+        if i < 0 {
+            return i
+        }
+    }
+}
+
+func (self *_Locator) compactList(vt *defs.Type, i int, depth int) int {
+    if self.fixed(i, 1) < 0 {
+        return EEOF
+    }
+
+    /* short form: (size << 4) | type, long form: 0xf0 | type, followed by the size */
+    _, et := elemOf(vt)
+    nb := uint64(self.buf[i] >> 4)
+    tag := defs.CompactTag(self.buf[i] & 0x0f)
+
+    /* read the long form size */
+    if i++; nb == 0x0f {
+        if nb, i = compactVarint(self.buf, i); i < 0 {
+            return i
+        }
+    }
+
+    /* the element type must match */
+    if et != nil && !compactMatch(et, tag) {
+        return ETAG
+    }
+
+    /* walk through every element */
+    for n := uint64(0); ; n++ {
+        if n >= nb || i < 0 {
+            return i
+        }
+
+        /* walk through the element */
+        self.push("[" + strconv.FormatUint(n, 10) + "]")
+        i = self.compactValue(et, tag, i, true, depth + 1)
+        self.pop()
+    }
+}
+
+func (self *_Locator) compactMap(vt *defs.Type, i int, depth int) int {
+    var nb uint64
+    var kt *defs.Type
+    var et *defs.Type
+
+    /* read the map size, empty maps are encoded as a single zero byte */
+    if nb, i = compactVarint(self.buf, i); i < 0 || nb == 0 {
+        return i
+    }
+
+    /* read the key and value types */
+    if self.fixed(i, 1) < 0 {
+        return EEOF
+    }
+
+    /* the key and value types must match */
+    kt, et = elemOf(vt)
+    tk := defs.CompactTag(self.buf[i] >> 4)
+    tv := defs.CompactTag(self.buf[i] & 0x0f)
+
+    /* check the key and value types */
+    if kt != nil && (!compactMatch(kt, tk) || !compactMatch(et, tv)) {
+        return ETAG
+    }
+
+    /* walk through every key-value pair */
+    for n, i := uint64(0), i + 1; ; n++ {
+        if n >= nb || i < 0 {
+            return i
+        }
+
+        /* walk through the key */
+        s := "[" + strconv.FormatUint(n, 10) + "]"
+        self.push(s + ".key")
+        i = self.compactValue(kt, tk, i, true, depth + 1)
+        self.pop()
+
+        /* check for errors */
+        if i < 0 {
+            return i
+        }
+
+        /* walk through the value */
+        self.push(s + ".value")
+        i = self.compactValue(et, tv, i, true, depth + 1)
+        self.pop()
+    }
+}
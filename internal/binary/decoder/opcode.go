@@ -29,6 +29,9 @@ const (
     OP_bin
     OP_bin_nocopy
     OP_enum
+    OP_uint
+    OP_float
+    OP_fixed
     OP_size
     OP_type
     OP_seek
@@ -46,8 +49,14 @@ const (
     OP_map_set_enum
     OP_map_set_pointer
     OP_list_alloc
+    OP_array_check
     OP_struct_skip
     OP_struct_ignore
+    OP_struct_unknown
+    OP_struct_unknown_init
+    OP_struct_union_init
+    OP_struct_union_reset
+    OP_struct_union_mark
     OP_struct_bitmap
     OP_struct_switch
     OP_struct_require
@@ -58,60 +67,122 @@ const (
     OP_make_state
     OP_drop_state
     OP_construct
+    OP_custom
     OP_initialize
     OP_defer
     OP_goto
+    OP_compact_bool
+    OP_compact_double
+    OP_compact_vint
+    OP_compact_uint
+    OP_compact_float
+    OP_compact_fixed
+    OP_compact_str
+    OP_compact_str_nocopy
+    OP_compact_bin
+    OP_compact_bin_nocopy
+    OP_compact_list_begin
+    OP_compact_map_begin
+    OP_compact_map_set_int
+    OP_compact_map_set_str
+    OP_compact_begin
+    OP_compact_field
+    OP_compact_field_bool
+    OP_compact_switch
+    OP_compact_check_type
+    OP_compact_skip
+    OP_compact_ignore
+    OP_compact_defer
     OP_halt
 )
 
 var _OpNames = [256]string {
-    OP_int               : "int",
-    OP_str               : "str",
-    OP_str_nocopy        : "str_nocopy",
-    OP_bin               : "bin",
-    OP_bin_nocopy        : "bin_nocopy",
-    OP_enum              : "enum",
-    OP_size              : "size",
-    OP_type              : "type",
-    OP_seek              : "seek",
-    OP_deref             : "deref",
-    OP_ctr_load          : "ctr_load",
-    OP_ctr_decr          : "ctr_decr",
-    OP_ctr_is_zero       : "ctr_is_zero",
-    OP_map_alloc         : "map_alloc",
-    OP_map_close         : "map_close",
-    OP_map_set_i8        : "map_set_i8",
-    OP_map_set_i16       : "map_set_i16",
-    OP_map_set_i32       : "map_set_i32",
-    OP_map_set_i64       : "map_set_i64",
-    OP_map_set_str       : "map_set_str",
-    OP_map_set_enum      : "map_set_enum",
-    OP_map_set_pointer   : "map_set_pointer",
-    OP_list_alloc        : "list_alloc",
-    OP_struct_skip       : "struct_skip",
-    OP_struct_ignore     : "struct_ignore",
-    OP_struct_bitmap     : "struct_bitmap",
-    OP_struct_switch     : "struct_switch",
-    OP_struct_require    : "struct_require",
-    OP_struct_is_stop    : "struct_is_stop",
-    OP_struct_mark_tag   : "struct_mark_tag",
-    OP_struct_read_type  : "struct_read_type",
-    OP_struct_check_type : "struct_check_type",
-    OP_make_state        : "make_state",
-    OP_drop_state        : "drop_state",
-    OP_construct         : "construct",
-    OP_initialize        : "initialize",
-    OP_defer             : "defer",
-    OP_goto              : "goto",
-    OP_halt              : "halt",
+    OP_int                 : "int",
+    OP_str                 : "str",
+    OP_str_nocopy          : "str_nocopy",
+    OP_bin                 : "bin",
+    OP_bin_nocopy          : "bin_nocopy",
+    OP_enum                : "enum",
+    OP_uint                : "uint",
+    OP_float               : "float",
+    OP_fixed               : "fixed",
+    OP_size                : "size",
+    OP_type                : "type",
+    OP_seek                : "seek",
+    OP_deref               : "deref",
+    OP_ctr_load            : "ctr_load",
+    OP_ctr_decr            : "ctr_decr",
+    OP_ctr_is_zero         : "ctr_is_zero",
+    OP_map_alloc           : "map_alloc",
+    OP_map_close           : "map_close",
+    OP_map_set_i8          : "map_set_i8",
+    OP_map_set_i16         : "map_set_i16",
+    OP_map_set_i32         : "map_set_i32",
+    OP_map_set_i64         : "map_set_i64",
+    OP_map_set_str         : "map_set_str",
+    OP_map_set_enum        : "map_set_enum",
+    OP_map_set_pointer     : "map_set_pointer",
+    OP_list_alloc          : "list_alloc",
+    OP_array_check         : "array_check",
+    OP_struct_skip         : "struct_skip",
+    OP_struct_ignore       : "struct_ignore",
+    OP_struct_unknown      : "struct_unknown",
+    OP_struct_unknown_init : "struct_unknown_init",
+    OP_struct_union_init   : "struct_union_init",
+    OP_struct_union_reset  : "struct_union_reset",
+    OP_struct_union_mark   : "struct_union_mark",
+    OP_struct_bitmap       : "struct_bitmap",
+    OP_struct_switch       : "struct_switch",
+    OP_struct_require      : "struct_require",
+    OP_struct_is_stop      : "struct_is_stop",
+    OP_struct_mark_tag     : "struct_mark_tag",
+    OP_struct_read_type    : "struct_read_type",
+    OP_struct_check_type   : "struct_check_type",
+    OP_make_state          : "make_state",
+    OP_drop_state          : "drop_state",
+    OP_construct           : "construct",
+    OP_custom              : "custom",
+    OP_initialize          : "initialize",
+    OP_defer               : "defer",
+    OP_goto                : "goto",
+    OP_compact_bool        : "compact_bool",
+    OP_compact_double      : "compact_double",
+    OP_compact_vint        : "compact_vint",
+    OP_compact_uint        : "compact_uint",
+    OP_compact_float       : "compact_float",
+    OP_compact_fixed       : "compact_fixed",
+    OP_compact_str         : "compact_str",
+    OP_compact_str_nocopy  : "compact_str_nocopy",
+    OP_compact_bin         : "compact_bin",
+    OP_compact_bin_nocopy  : "compact_bin_nocopy",
+    OP_compact_list_begin  : "compact_list_begin",
+    OP_compact_map_begin   : "compact_map_begin",
+    OP_compact_map_set_int : "compact_map_set_int",
+    OP_compact_map_set_str : "compact_map_set_str",
+    OP_compact_begin       : "compact_begin",
+    OP_compact_field       : "compact_field",
+    OP_compact_field_bool  : "compact_field_bool",
+    OP_compact_switch      : "compact_switch",
+    OP_compact_check_type  : "compact_check_type",
+    OP_compact_skip        : "compact_skip",
+    OP_compact_ignore      : "compact_ignore",
+    OP_compact_defer       : "compact_defer",
+    OP_halt                : "halt",
 }
 
 var _OpBranches = [256]bool {
-    OP_ctr_is_zero       : true,
-    OP_struct_switch     : true,
-    OP_struct_is_stop    : true,
-    OP_struct_check_type : true,
-    OP_goto              : true,
+    OP_ctr_is_zero         : true,
+    OP_struct_switch       : true,
+    OP_struct_is_stop      : true,
+    OP_struct_check_type   : true,
+    OP_goto                : true,
+    OP_compact_switch      : true,
+    OP_compact_check_type  : true,
+}
+
+var _OpSwitches = [256]bool {
+    OP_struct_switch  : true,
+    OP_compact_switch : true,
 }
 
 func (self OpCode) String() string {
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/iov`
+)
+
+const (
+    _MinSkipWindow = 64
+)
+
+var (
+    F_refill              = hir.RegisterGCall(refill, emu_gcall_refill)
+    F_reader_check        = hir.RegisterGCall(reader_check, emu_gcall_reader_check)
+    F_reader_count        = hir.RegisterGCall(reader_count, emu_gcall_reader_count)
+    F_reader_skip         = hir.RegisterGCall(reader_skip, emu_gcall_reader_skip)
+    F_reader_compact_skip = hir.RegisterGCall(reader_compact_skip, emu_gcall_reader_compact_skip)
+    F_reader_varint       = hir.RegisterGCall(reader_varint, emu_gcall_reader_varint)
+)
+
+type bufferReader struct {
+    r iov.BufferReader
+    i int                   // Number of bytes consumed before the current input buffer.
+}
+
+func (self *bufferReader) refill(rs *RuntimeState, i int, n int) (int, error) {
+    var err error
+    var buf []byte
+
+    /* consume all the decoded bytes */
+    if err = self.r.Skip(i); err != nil {
+        return 0, err
+    }
+
+    /* use the current segment if it's large enough, otherwise the bytes straddle segment boundaries */
+    if self.i += i; len(self.r.Segment()) >= n {
+        buf = self.r.Segment()
+    } else if nb, err := self.buffered(n); err != nil {
+        return 0, err
+    } else if nb < n {
+        return 0, error_eof(n - nb)
+    } else if buf, err = self.r.Peek(n); err != nil {
+        return 0, err
+    }
+
+    /* move to the new buffer */
+    rs.Bn = uint64(len(buf))
+    rs.Bp = (*rt.GoSlice)(unsafe.Pointer(&buf)).Ptr
+    return 0, nil
+}
+
+func (self *bufferReader) skip(rs *RuntimeState, i int, fn func(unsafe.Pointer, int) int) (int, error) {
+    nw := int(rs.Bn) - i
+
+    /* grow the buffer until the value fits in */
+    for {
+        if nb := self.grow(i, nw); nb <= nw {
+            return 0, error_skip(EEOF)
+        } else if _, err := self.refill(rs, i, nb); err != nil {
+            return 0, err
+        }
+
+        /* skip within the buffer */
+        i, nw = 0, int(rs.Bn)
+        rv := fn(rs.Bp, nw)
+
+        /* check if the value fits in */
+        if rv != EEOF {
+            return reader_result(rv)
+        }
+    }
+}
+
+func (self *bufferReader) grow(i int, nw int) int {
+    if _, ok := self.r.(bufferFiller); ok {
+        nb, _ := self.buffered(i + nw + 1)
+        return nb - i
+    }
+
+    /* double the window, but cannot exceed the remaining bytes */
+    if nw *= 2; nw < _MinSkipWindow {
+        nw = _MinSkipWindow
+    }
+
+    /* check for the remaining bytes */
+    if nb := self.r.Len() - i; nw > nb {
+        return nb
+    } else {
+        return nw
+    }
+}
+
+func (self *bufferReader) buffered(n int) (int, error) {
+    if fr, ok := self.r.(bufferFiller); ok {
+        return fr.fill(n)
+    } else {
+        return self.r.Len(), nil
+    }
+}
+
+func (self *RuntimeState) available(i int, n int) (int, error) {
+    if self.Rd == nil {
+        return int(self.Bn) - i, nil
+    } else if nb, err := self.Rd.buffered(i + n); err != nil {
+        return 0, err
+    } else {
+        return nb - i, nil
+    }
+}
+
+func refill(rs *RuntimeState, i int, n int) (int, error) {
+    if rs.Rd == nil {
+        return i, error_eof(i + n - int(rs.Bn))
+    } else {
+        return rs.Rd.refill(rs, i, n)
+    }
+}
+
+func reader_check(rs *RuntimeState, i int, n int) error {
+    if nb, err := rs.available(i, n); err != nil {
+        return err
+    } else if nb < n {
+        return error_eof(n - nb)
+    } else {
+        return nil
+    }
+}
+
+func reader_count(rs *RuntimeState, i int, n int) error {
+    if err := reader_check(rs, i, n); err != nil {
+        return &headerError { err }
+    } else {
+        return nil
+    }
+}
+
+func reader_skip(rs *RuntimeState, i int, t uint8, e int) (int, error) {
+    if e != EEOF || rs.Rd == nil {
+        return i, error_skip(e)
+    } else {
+        return rs.Rd.skip(rs, i, func(s unsafe.Pointer, n int) int { return do_skip((*_skipbuf_t)(unsafe.Pointer(&rs.Sk)), s, n, defs.Tag(t)) })
+    }
+}
+
+func reader_compact_skip(rs *RuntimeState, i int, t uint8, e int) (int, error) {
+    if e != EEOF || rs.Rd == nil {
+        return i, error_skip(e)
+    } else {
+        return rs.Rd.skip(rs, i, func(s unsafe.Pointer, n int) int { return compact_skip(s, n, 0, t) })
+    }
+}
+
+func reader_varint(rs *RuntimeState, i int, e int) (uint64, int, error) {
+    var v uint64
+    var n int
+
+    /* the varint may straddle segment boundaries */
+    if e != EEOF || rs.Rd == nil {
+        return 0, i, error_varint(e)
+    }
+
+    /* grow the buffer byte by byte, a stream may not have the following bytes yet,
+     * this always terminates since a varint takes at most 10 bytes */
+    for n = int(rs.Bn) - i; ; n = int(rs.Bn) {
+        if _, err := rs.Rd.refill(rs, i, n + 1); err != nil {
+            return 0, 0, err
+        }
+
+        /* decode the varint from the new buffer */
+        i = 0
+        v, n = compact_varint(rs.Bp, int(rs.Bn), 0)
+
+        /* check if the varint is complete */
+        if n != EEOF {
+            break
+        }
+    }
+
+    /* check for errors */
+    if n < 0 {
+        return 0, 0, error_varint(n)
+    } else {
+        return v, n, nil
+    }
+}
+
+func reader_result(rv int) (int, error) {
+    if rv < 0 {
+        return 0, error_skip(rv)
+    } else {
+        return rv, nil
+    }
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+func emu_gcall_refill(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "i**") {
+        panic("invalid refill call")
+    } else {
+        ret, err := refill((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_reader_check(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid reader_check call")
+    } else {
+        emu_seterr(ctx, 0, reader_check((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2))))
+    }
+}
+
+func emu_gcall_reader_count(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid reader_count call")
+    } else {
+        emu_seterr(ctx, 0, reader_count((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2))))
+    }
+}
+
+func emu_gcall_reader_skip(ctx hir.CallContext) {
+    if !ctx.Verify("*iii", "i**") {
+        panic("invalid reader_skip call")
+    } else {
+        ret, err := reader_skip((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), uint8(ctx.Au(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_reader_compact_skip(ctx hir.CallContext) {
+    if !ctx.Verify("*iii", "i**") {
+        panic("invalid reader_compact_skip call")
+    } else {
+        ret, err := reader_compact_skip((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), uint8(ctx.Au(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_reader_varint(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "ii**") {
+        panic("invalid reader_varint call")
+    } else {
+        val, ret, err := reader_varint((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)))
+        ctx.Ru(0, val)
+        ctx.Ru(1, uint64(ret))
+        emu_seterr(ctx, 2, err)
+    }
+}
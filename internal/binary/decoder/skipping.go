@@ -17,7 +17,10 @@
 package decoder
 
 import (
+    `unsafe`
+
     `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
 )
 
 const (
@@ -29,3 +32,22 @@ const (
 var (
     C_skip = hir.RegisterCCall(archSkippingFn(), emu_ccall_skip)
 )
+
+// Skip measures the size of the value of type t at the beginning of buf
+// with the skipping state machine, without decoding it.
+func Skip(buf []byte, t defs.Tag) (int, error) {
+    var rv int
+    var sb _skipbuf_t
+
+    /* check for empty buffer, since taking the address of it is not allowed */
+    if len(buf) == 0 {
+        return 0, error_skip(EEOF)
+    }
+
+    /* skip the value */
+    if rv = do_skip(&sb, unsafe.Pointer(&buf[0]), len(buf), t); rv < 0 {
+        return 0, error_skip(rv)
+    } else {
+        return rv, nil
+    }
+}
@@ -17,9 +17,11 @@
 package decoder
 
 import (
+    `math`
     `unsafe`
 
     `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/opts`
     `github.com/cloudwego/frugal/internal/rt`
 )
 
@@ -28,12 +30,19 @@ const (
     MpOffset = int64(unsafe.Offsetof(StateItem{}.Mp))
     WpOffset = int64(unsafe.Offsetof(StateItem{}.Wp))
     FmOffset = int64(unsafe.Offsetof(StateItem{}.Fm))
+    FiOffset = int64(unsafe.Offsetof(StateItem{}.Fi))
 )
 
 const (
     SkOffset = int64(unsafe.Offsetof(RuntimeState{}.Sk))
     PrOffset = int64(unsafe.Offsetof(RuntimeState{}.Pr))
     IvOffset = int64(unsafe.Offsetof(RuntimeState{}.Iv))
+    BpOffset = int64(unsafe.Offsetof(RuntimeState{}.Bp))
+    BnOffset = int64(unsafe.Offsetof(RuntimeState{}.Bn))
+    LaOffset = int64(unsafe.Offsetof(RuntimeState{}.La))
+    LcOffset = int64(unsafe.Offsetof(RuntimeState{}.Lc))
+    LsOffset = int64(unsafe.Offsetof(RuntimeState{}.Ls))
+    LdOffset = int64(unsafe.Offsetof(RuntimeState{}.Ld))
 )
 
 const (
@@ -53,6 +62,7 @@ type StateItem struct {
     Mp *rt.GoMap
     Wp unsafe.Pointer
     Fm *FieldBitmap
+    Fi int64        // Last field ID, used by the Compact Protocol.
 }
 
 type RuntimeState struct {
@@ -60,4 +70,30 @@ type RuntimeState struct {
     Sk [defs.StackSize]SkipItem     // Skip buffer, used for non-recursive skipping
     Pr unsafe.Pointer               // Pointer spill space, used for non-fast string or pointer map access.
     Iv uint64                       // Integer spill space, used for non-fast string map access.
+    Bp unsafe.Pointer               // Current input buffer, moves along the segments when decoding from a BufferReader.
+    Bn uint64                       // Size of the current input buffer.
+    Rd *bufferReader                // Segmented input, nil if decoding from a contiguous buffer.
+    La uint64                       // Remaining allocation budget, in one's complement.
+    Lc uint64                       // Maximum container length, in one's complement.
+    Ls uint64                       // Maximum string or binary length, in one's complement.
+    Ld uint64                       // Maximum nesting depth in bytes of the state stack, in one's complement.
+    Lm uint64                       // Maximum allocation size, used for error reporting.
+}
+
+/* The limits are stored in one's complement, so a zero value means unlimited. */
+
+func (self *RuntimeState) limit(o opts.Options) {
+    self.Lm = limitOf(o.MaxAllocSize, 1)
+    self.Lc = ^limitOf(o.MaxContainerSize, 1)
+    self.Ls = ^limitOf(o.MaxStringSize, 1)
+    self.Ld = ^limitOf(o.MaxNestingDepth, StateSize)
+    self.La = ^self.Lm
+}
+
+func limitOf(v int, scale int64) uint64 {
+    if v <= 0 {
+        return math.MaxUint64
+    } else {
+        return uint64(v) * uint64(scale)
+    }
 }
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package decoder
+
+import (
+    `fmt`
+    `io`
+
+    `github.com/cloudwego/frugal/internal/opts`
+)
+
+const (
+    _StreamChunkSize    = 65536
+    _StreamMaxChunkSize = 1 << 20
+)
+
+type Stream struct {
+    o opts.Options
+    r streamReader
+}
+
+func NewStream(r io.Reader, o opts.Options) *Stream {
+    return &Stream { o: o, r: streamReader { r: r } }
+}
+
+func (self *Stream) Buffered() int {
+    return self.r.nb
+}
+
+func (self *Stream) Decode(val interface{}) (int, error) {
+    return decodeReaderWith(decode, &self.r, val, self.o, false)
+}
+
+type bufferFiller interface {
+    fill(n int) (int, error)
+}
+
+type streamReader struct {
+    r   io.Reader
+    nb  int
+    buf [][]byte
+    err error
+}
+
+func (self *streamReader) Len() int {
+    return self.nb
+}
+
+func (self *streamReader) Segment() []byte {
+    if len(self.buf) == 0 {
+        return nil
+    } else {
+        return self.buf[0]
+    }
+}
+
+func (self *streamReader) Peek(n int) ([]byte, error) {
+    if _, err := self.fill(n); err != nil {
+        return nil, err
+    } else if n < 0 || n > self.nb {
+        return nil, errorShortStream(n, self.nb)
+    } else if len(self.buf) == 0 || n <= len(self.buf[0]) {
+        return self.Segment()[:n], nil
+    }
+
+    /* the bytes straddle chunk boundaries, make a copy */
+    i := 0
+    ret := make([]byte, n)
+
+    /* copy from every chunk */
+    for _, v := range self.buf {
+        if i += copy(ret[i:], v); i == n {
+            break
+        }
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func (self *streamReader) Next(n int) ([]byte, error) {
+    if ret, err := self.Peek(n); err != nil {
+        return nil, err
+    } else {
+        self.advance(n)
+        return ret, nil
+    }
+}
+
+func (self *streamReader) Skip(n int) error {
+    if _, err := self.fill(n); err != nil {
+        return err
+    } else if n < 0 || n > self.nb {
+        return errorShortStream(n, self.nb)
+    } else {
+        self.advance(n)
+        return nil
+    }
+}
+
+func (self *streamReader) fill(n int) (int, error) {
+    for self.nb < n && self.err == nil {
+        self.read(n - self.nb)
+    }
+
+    /* reaching the end of stream is not an error */
+    if self.err == io.EOF {
+        return self.nb, nil
+    } else {
+        return self.nb, self.err
+    }
+}
+
+func (self *streamReader) read(n int) {
+    var nb int
+    var nc int
+    var buf []byte
+
+    /* drop the exhausted chunk */
+    if nc = len(self.buf); nc != 0 && len(self.buf[nc - 1]) == 0 && cap(self.buf[nc - 1]) == 0 {
+        nc--
+        self.buf = self.buf[:nc]
+    }
+
+    /* reuse the spare space of the last chunk, the bytes before it are never modified, large reads
+     * are split into bounded chunks, so the buffer only grows with the bytes actually received */
+    if nc != 0 && len(self.buf[nc - 1]) < cap(self.buf[nc - 1]) {
+        buf = self.buf[nc - 1]
+    } else if n < _StreamChunkSize {
+        buf = make([]byte, 0, _StreamChunkSize)
+        self.buf = append(self.buf, buf)
+    } else if n < _StreamMaxChunkSize {
+        buf = make([]byte, 0, n)
+        self.buf = append(self.buf, buf)
+    } else {
+        buf = make([]byte, 0, _StreamMaxChunkSize)
+        self.buf = append(self.buf, buf)
+    }
+
+    /* read from the underlying reader */
+    nb, self.err = self.r.Read(buf[len(buf):cap(buf)])
+    self.nb += nb
+    self.buf[len(self.buf) - 1] = buf[:len(buf) + nb]
+}
+
+func (self *streamReader) advance(n int) {
+    for self.nb -= n; n != 0; {
+        if n < len(self.buf[0]) || len(self.buf) == 1 {
+            self.buf[0] = self.buf[0][n:]
+            break
+        } else {
+            n -= len(self.buf[0])
+            self.buf[0] = nil
+            self.buf = self.buf[1:]
+        }
+    }
+}
+
+func errorShortStream(n int, nb int) error {
+    return fmt.Errorf("frugal: cannot read %d bytes from the stream: only %d bytes available", n, nb)
+}
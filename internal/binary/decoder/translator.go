@@ -20,6 +20,7 @@ import (
     `fmt`
     `reflect`
 
+    `github.com/cloudwego/frugal/internal/atm/abi`
     `github.com/cloudwego/frugal/internal/atm/hir`
     `github.com/cloudwego/frugal/internal/binary/defs`
     `github.com/cloudwego/frugal/internal/rt`
@@ -73,11 +74,10 @@ const (
 )
 
 const (
-    LB_eof      = "_eof"
     LB_halt     = "_halt"
     LB_type     = "_type"
-    LB_skip     = "_skip"
     LB_error    = "_error"
+    LB_limit    = "_limit"
     LB_missing  = "_missing"
     LB_overflow = "_overflow"
 )
@@ -103,14 +103,6 @@ func Translate(s Program) hir.Program {
 }
 
 func errors(p *hir.Builder) {
-    p.Label (LB_eof)
-    p.LDAQ  (ARG_nb, UR)
-    p.SUB   (TR, UR, TR)
-    p.GCALL (F_error_eof).
-      A0    (TR).
-      R0    (ET).
-      R1    (EP)
-    p.JMP   (LB_error)
     p.Label (LB_type)
     p.GCALL (F_error_type).
       A0    (UR).
@@ -118,9 +110,11 @@ func errors(p *hir.Builder) {
       R0    (ET).
       R1    (EP)
     p.JMP   (LB_error)
-    p.Label (LB_skip)
-    p.GCALL (F_error_skip).
-      A0    (TR).
+    p.Label (LB_limit)
+    p.GCALL (F_error_limit).
+      A0    (RS).
+      A1    (UR).
+      A2    (TR).
       R0    (ET).
       R1    (EP)
     p.JMP   (LB_error)
@@ -152,6 +146,9 @@ func prologue(p *hir.Builder) {
     p.LDAP  (ARG_p, WP)
     p.LDAP  (ARG_rs, RS)
     p.LDAQ  (ARG_st, ST)
+    p.LDAQ  (ARG_nb, TR)
+    p.SP    (IP, RS, BpOffset)
+    p.SQ    (TR, RS, BnOffset)
     p.MOV   (hir.Rz, TR)
     p.MOV   (hir.Rz, UR)
 }
@@ -168,45 +165,77 @@ func epilogue(p *hir.Builder) {
 }
 
 var translators = [256]func(*hir.Builder, Instr) {
-    OP_int               : translate_OP_int,
-    OP_str               : translate_OP_str,
-    OP_str_nocopy        : translate_OP_str_nocopy,
-    OP_bin               : translate_OP_bin,
-    OP_bin_nocopy        : translate_OP_bin_nocopy,
-    OP_enum              : translate_OP_enum,
-    OP_size              : translate_OP_size,
-    OP_type              : translate_OP_type,
-    OP_seek              : translate_OP_seek,
-    OP_deref             : translate_OP_deref,
-    OP_ctr_load          : translate_OP_ctr_load,
-    OP_ctr_decr          : translate_OP_ctr_decr,
-    OP_ctr_is_zero       : translate_OP_ctr_is_zero,
-    OP_map_alloc         : translate_OP_map_alloc,
-    OP_map_close         : translate_OP_map_close,
-    OP_map_set_i8        : translate_OP_map_set_i8,
-    OP_map_set_i16       : translate_OP_map_set_i16,
-    OP_map_set_i32       : translate_OP_map_set_i32,
-    OP_map_set_i64       : translate_OP_map_set_i64,
-    OP_map_set_str       : translate_OP_map_set_str,
-    OP_map_set_enum      : translate_OP_map_set_enum,
-    OP_map_set_pointer   : translate_OP_map_set_pointer,
-    OP_list_alloc        : translate_OP_list_alloc,
-    OP_struct_skip       : translate_OP_struct_skip,
-    OP_struct_ignore     : translate_OP_struct_ignore,
-    OP_struct_bitmap     : translate_OP_struct_bitmap,
-    OP_struct_switch     : translate_OP_struct_switch,
-    OP_struct_require    : translate_OP_struct_require,
-    OP_struct_is_stop    : translate_OP_struct_is_stop,
-    OP_struct_mark_tag   : translate_OP_struct_mark_tag,
-    OP_struct_read_type  : translate_OP_struct_read_type,
-    OP_struct_check_type : translate_OP_struct_check_type,
-    OP_make_state        : translate_OP_make_state,
-    OP_drop_state        : translate_OP_drop_state,
-    OP_construct         : translate_OP_construct,
-    OP_initialize        : translate_OP_initialize,
-    OP_defer             : translate_OP_defer,
-    OP_goto              : translate_OP_goto,
-    OP_halt              : translate_OP_halt,
+    OP_int                 : translate_OP_int,
+    OP_str                 : translate_OP_str,
+    OP_str_nocopy          : translate_OP_str_nocopy,
+    OP_bin                 : translate_OP_bin,
+    OP_bin_nocopy          : translate_OP_bin_nocopy,
+    OP_enum                : translate_OP_enum,
+    OP_uint                : translate_OP_uint,
+    OP_float               : translate_OP_float,
+    OP_fixed               : translate_OP_fixed,
+    OP_size                : translate_OP_size,
+    OP_type                : translate_OP_type,
+    OP_seek                : translate_OP_seek,
+    OP_deref               : translate_OP_deref,
+    OP_ctr_load            : translate_OP_ctr_load,
+    OP_ctr_decr            : translate_OP_ctr_decr,
+    OP_ctr_is_zero         : translate_OP_ctr_is_zero,
+    OP_map_alloc           : translate_OP_map_alloc,
+    OP_map_close           : translate_OP_map_close,
+    OP_map_set_i8          : translate_OP_map_set_i8,
+    OP_map_set_i16         : translate_OP_map_set_i16,
+    OP_map_set_i32         : translate_OP_map_set_i32,
+    OP_map_set_i64         : translate_OP_map_set_i64,
+    OP_map_set_str         : translate_OP_map_set_str,
+    OP_map_set_enum        : translate_OP_map_set_enum,
+    OP_map_set_pointer     : translate_OP_map_set_pointer,
+    OP_list_alloc          : translate_OP_list_alloc,
+    OP_array_check         : translate_OP_array_check,
+    OP_struct_skip         : translate_OP_struct_skip,
+    OP_struct_ignore       : translate_OP_struct_ignore,
+    OP_struct_unknown      : translate_OP_struct_unknown,
+    OP_struct_unknown_init : translate_OP_struct_unknown_init,
+    OP_struct_union_init   : translate_OP_struct_union_init,
+    OP_struct_union_reset  : translate_OP_struct_union_reset,
+    OP_struct_union_mark   : translate_OP_struct_union_mark,
+    OP_struct_bitmap       : translate_OP_struct_bitmap,
+    OP_struct_switch       : translate_OP_struct_switch,
+    OP_struct_require      : translate_OP_struct_require,
+    OP_struct_is_stop      : translate_OP_struct_is_stop,
+    OP_struct_mark_tag     : translate_OP_struct_mark_tag,
+    OP_struct_read_type    : translate_OP_struct_read_type,
+    OP_struct_check_type   : translate_OP_struct_check_type,
+    OP_make_state          : translate_OP_make_state,
+    OP_drop_state          : translate_OP_drop_state,
+    OP_construct           : translate_OP_construct,
+    OP_custom              : translate_OP_custom,
+    OP_initialize          : translate_OP_initialize,
+    OP_defer               : translate_OP_defer,
+    OP_goto                : translate_OP_goto,
+    OP_compact_bool        : translate_OP_compact_bool,
+    OP_compact_double      : translate_OP_compact_double,
+    OP_compact_vint        : translate_OP_compact_vint,
+    OP_compact_uint        : translate_OP_compact_uint,
+    OP_compact_float       : translate_OP_compact_float,
+    OP_compact_fixed       : translate_OP_compact_fixed,
+    OP_compact_str         : translate_OP_compact_str,
+    OP_compact_str_nocopy  : translate_OP_compact_str_nocopy,
+    OP_compact_bin         : translate_OP_compact_bin,
+    OP_compact_bin_nocopy  : translate_OP_compact_bin_nocopy,
+    OP_compact_list_begin  : translate_OP_compact_list_begin,
+    OP_compact_map_begin   : translate_OP_compact_map_begin,
+    OP_compact_map_set_int : translate_OP_compact_map_set_int,
+    OP_compact_map_set_str : translate_OP_compact_map_set_str,
+    OP_compact_begin       : translate_OP_compact_begin,
+    OP_compact_field       : translate_OP_compact_field,
+    OP_compact_field_bool  : translate_OP_compact_field_bool,
+    OP_compact_switch      : translate_OP_compact_switch,
+    OP_compact_check_type  : translate_OP_compact_check_type,
+    OP_compact_skip        : translate_OP_compact_skip,
+    OP_compact_ignore      : translate_OP_compact_ignore,
+    OP_compact_defer       : translate_OP_compact_defer,
+    OP_halt                : translate_OP_halt,
 }
 
 func translate_OP_int(p *hir.Builder, v Instr) {
@@ -225,15 +254,18 @@ func translate_OP_str(p *hir.Builder, _ Instr) {
     p.ADDI  (IC, 4, IC)
     p.LL    (EP, 0, TR)
     p.SWAPL (TR, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
     p.BEQ   (TR, hir.Rz, "_empty_{n}")
-    p.ADDPI (EP, 4, EP)
-    p.ADD   (IC, TR, IC)
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    translate_alloc(p, "s")
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "s")
+    p.ADDP  (IP, IC, EP)
+    p.SUB   (TR, IC, UR)
+    p.MOV   (TR, IC)
     p.GCALL (F_slicebytetostring).
       A0    (hir.Pn).
       A1    (EP).
-      A2    (TR).
+      A2    (UR).
       R0    (TP).
       R1    (TR)
     p.SP    (TP, WP, 0)
@@ -253,19 +285,23 @@ func translate_OP_bin(p *hir.Builder, _ Instr) {
     p.ADDI  (IC, 4, IC)
     p.LL    (EP, 0, TR)
     p.SWAPL (TR, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
     p.BEQ   (TR, hir.Rz, "_empty_{n}")
-    p.ADDPI (EP, 4, EP)
-    p.ADD   (IC, TR, IC)
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    translate_alloc(p, "s")
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "s")
+    p.ADDP  (IP, IC, EP)
+    p.SUB   (TR, IC, UR)
+    p.MOV   (TR, IC)
     p.IP    (_T_byte, TP)
     p.GCALL (F_mallocgc).
-      A0    (TR).
+      A0    (UR).
       A1    (TP).
       A2    (hir.Rz).
       R0    (TP)
-    p.BCOPY (EP, TR, TP)
+    p.BCOPY (EP, UR, TP)
     p.SP    (TP, WP, 0)
+    p.MOV   (UR, TR)
     p.Label ("_empty_{n}")
     p.SQ    (TR, WP, 8)
     p.SQ    (TR, WP, 16)
@@ -283,11 +319,14 @@ func translate_OP_binstr_nocopy(p *hir.Builder) {
     p.ADDI  (IC, 4, IC)
     p.LL    (EP, 0, TR)
     p.SWAPL (TR, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
     p.BEQ   (TR, hir.Rz, "_empty_{n}")
-    p.ADDPI (EP, 4, EP)
-    p.ADD   (IC, TR, IC)
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "s")
+    p.ADDP  (IP, IC, EP)
+    p.SUB   (TR, IC, UR)
+    p.MOV   (TR, IC)
+    p.MOV   (UR, TR)
     p.SP    (EP, WP, 0)
     p.Label ("_empty_{n}")
     p.SQ    (TR, WP, 8)
@@ -302,10 +341,168 @@ func translate_OP_enum(p *hir.Builder, _ Instr) {
     p.ADDI  (IC, 4, IC)
 }
 
+func translate_OP_uint(p *hir.Builder, v Instr) {
+    p.ADDP  (IP, IC, EP)
+
+    /* load the wire value */
+    switch v.Iv {
+        case 1  : p.LB(EP, 0, TR)
+        case 2  : p.LW(EP, 0, TR); p.SWAPW(TR, TR)
+        case 4  : p.LL(EP, 0, TR); p.SWAPL(TR, TR)
+        case 8  : p.LQ(EP, 0, TR); p.SWAPQ(TR, TR)
+        default : panic("can only convert 1, 2, 4 or 8 bytes at a time")
+    }
+
+    /* check for range, and store the value */
+    translate_range(p, v.Vt, v.Iv)
+    translate_store(p, v.Vt)
+    p.ADDI  (IC, v.Iv, IC)
+}
+
+func translate_OP_float(p *hir.Builder, _ Instr) {
+    p.ADDP  (IP, IC, EP)
+    p.LQ    (EP, 0, TR)
+    p.SWAPQ (TR, TR)
+    translate_narrow(p)
+    p.SL    (TR, WP, 0)
+    p.ADDI  (IC, 8, IC)
+}
+
+func translate_OP_fixed(p *hir.Builder, v Instr) {
+    p.ADDP  (IP, IC, EP)
+    p.LL    (EP, 0, TR)
+    p.SWAPL (TR, TR)
+    p.SXLQ  (TR, TR)
+    translate_length(p, v.Vt)
+    p.ADDI  (IC, 4, IC)
+    p.ADDI  (IC, int64(v.Vt.Size), TR)
+    translate_check(p, "s")
+    translate_copy(p, v.Vt)
+}
+
+func translate_range(p *hir.Builder, vt *rt.GoType, nb int64) {
+    p.IQ    (uintMax(vt, nb), UR)
+    p.BGEU  (UR, TR, "_range_ok_{n}")
+    p.IP    (vt, TP)
+    p.IQ    (nb, UR)
+    p.GCALL (F_error_range).
+      A0    (TP).
+      A1    (TR).
+      A2    (UR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label ("_range_ok_{n}")
+}
+
+func translate_store(p *hir.Builder, vt *rt.GoType) {
+    switch vt.Size {
+        case 1  : p.SB(TR, WP, 0)
+        case 2  : p.SW(TR, WP, 0)
+        case 4  : p.SL(TR, WP, 0)
+        case 8  : p.SQ(TR, WP, 0)
+        default : panic("can only store 1, 2, 4 or 8 bytes at a time")
+    }
+}
+
+func translate_narrow(p *hir.Builder) {
+    p.GCALL (F_float64to32).
+      A0    (TR).
+      R0    (TR).
+      R1    (ET).
+      R2    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+}
+
+func translate_length(p *hir.Builder, vt *rt.GoType) {
+    p.IQ    (int64(vt.Pack().Len()), UR)
+    p.BEQ   (TR, UR, "_length_ok_{n}")
+    p.IP    (vt, TP)
+    p.GCALL (F_error_length).
+      A0    (TP).
+      A1    (TR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label ("_length_ok_{n}")
+}
+
+func translate_copy(p *hir.Builder, vt *rt.GoType) {
+    p.ADDP  (IP, IC, EP)
+    p.IQ    (int64(vt.Size), TR)
+    p.BCOPY (EP, TR, WP)
+    p.ADDI  (IC, int64(vt.Size), IC)
+}
+
 func translate_OP_size(p *hir.Builder, v Instr) {
-    p.IQ    (v.Iv, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
+    p.ADDI  (IC, v.Iv, TR)
+    translate_check(p, "n")
+}
+
+func translate_check(p *hir.Builder, lb string) {
+    p.LQ    (RS, BnOffset, UR)
+    p.BGEU  (UR, TR, "_check_" + lb + "_{n}")
+    p.SUB   (TR, IC, TR)
+    p.GCALL (F_refill).
+      A0    (RS).
+      A1    (IC).
+      A2    (TR).
+      R0    (IC).
+      R1    (ET).
+      R2    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.ADD   (IC, TR, TR)
+    p.LP    (RS, BpOffset, IP)
+    p.Label ("_check_" + lb + "_{n}")
+}
+
+func translate_limit(p *hir.Builder, off int64, kind LimitKind, lb string) {
+    p.LQ    (RS, off, UR)
+    p.XORI  (UR, -1, UR)
+    p.BGEU  (UR, TR, "_limit_" + lb + "_{n}")
+    p.IB    (int8(kind), UR)
+    p.JMP   (LB_limit)
+    p.Label ("_limit_" + lb + "_{n}")
+}
+
+/* translate_count checks that the remaining input can hold TR elements of at least nb bytes each,
+ * before anything is allocated for them, TR is preserved if nb is 1 */
+func translate_count(p *hir.Builder, nb int64, fn *hir.CallHandle) {
+    if nb != 1 {
+        p.MULI  (TR, nb, TR)
+    }
+    p.LQ    (RS, BnOffset, UR)
+    p.SUB   (UR, IC, UR)
+    p.BGEU  (UR, TR, "_count_ok_{n}")
+    p.GCALL (fn).
+      A0    (RS).
+      A1    (IC).
+      A2    (TR).
+      R0    (ET).
+      R1    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.Label ("_count_ok_{n}")
+}
+
+func translate_alloc(p *hir.Builder, lb string) {
+    translate_budget(p, LimitAllocSize, lb)
+}
+
+/* containers are allocated right after the header, before any of the elements exists */
+func translate_alloc_header(p *hir.Builder, lb string) {
+    translate_budget(p, LimitAllocSize | _LimitHeader, lb)
+}
+
+func translate_budget(p *hir.Builder, kind LimitKind, lb string) {
+    p.LQ    (RS, LaOffset, UR)
+    p.XORI  (UR, -1, UR)
+    p.BGEU  (UR, TR, "_budget_" + lb + "_{n}")
+    p.IB    (int8(kind), UR)
+    p.JMP   (LB_limit)
+    p.Label ("_budget_" + lb + "_{n}")
+    p.LQ    (RS, LaOffset, UR)
+    p.ADD   (UR, TR, UR)
+    p.SQ    (UR, RS, LaOffset)
 }
 
 func translate_OP_type(p *hir.Builder, v Instr) {
@@ -323,9 +520,10 @@ func translate_OP_seek(p *hir.Builder, v Instr) {
 func translate_OP_deref(p *hir.Builder, v Instr) {
     p.LQ    (WP, 0, TR)
     p.BNE   (TR, hir.Rz, "_skip_{n}")
+    p.IQ    (int64(v.Vt.Size), TR)
+    translate_alloc(p, "d")
     p.IB    (1, UR)
     p.IP    (v.Vt, TP)
-    p.IQ    (int64(v.Vt.Size), TR)
     p.GCALL (F_mallocgc).
       A0    (TR).
       A1    (TP).
@@ -336,13 +534,15 @@ func translate_OP_deref(p *hir.Builder, v Instr) {
     p.LP    (WP, 0, WP)
 }
 
-func translate_OP_ctr_load(p *hir.Builder, _ Instr) {
+func translate_OP_ctr_load(p *hir.Builder, v Instr) {
     p.ADDP  (IP, IC, EP)
     p.ADDI  (IC, 4, IC)
     p.LL    (EP, 0, TR)
     p.SWAPL (TR, TR)
+    translate_limit(p, LcOffset, LimitContainerSize, "c")
     p.ADDP  (RS, ST, TP)
     p.SQ    (TR, TP, NbOffset)
+    translate_count(p, v.Iv, F_reader_count)
 }
 
 func translate_OP_ctr_decr(p *hir.Builder, _ Instr) {
@@ -359,6 +559,16 @@ func translate_OP_ctr_is_zero(p *hir.Builder, v Instr) {
 }
 
 func translate_OP_map_alloc(p *hir.Builder, v Instr) {
+    mt := rt.MapType(v.Vt)
+    nb := int64(mt.Key.Size + mt.Elem.Size)
+
+    /* charge the key-value pairs to the allocation budget */
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, NbOffset, TR)
+    p.MULI  (TR, nb, TR)
+    translate_alloc_header(p, "m")
+
+    /* allocate the map */
     p.ADDP  (RS, ST, TP)
     p.LQ    (TP, NbOffset, TR)
     p.IP    (v.Vt, ET)
@@ -472,7 +682,7 @@ func translate_OP_map_set_i64_fast(p *hir.Builder, v Instr) {
 
 func translate_OP_map_set_i64_safe(p *hir.Builder, v Instr) {
     p.ADDP  (IP, IC, ET)
-    p.ADDI  (IC, 2, IC)
+    p.ADDI  (IC, 8, IC)
     p.ADDP  (RS, ST, TP)
     p.LP    (TP, MpOffset, EP)
     p.LQ    (ET, 0, TR)
@@ -500,16 +710,19 @@ func translate_OP_map_set_str_fast(p *hir.Builder, v Instr) {
     p.ADDI  (IC, 4, IC)
     p.LL    (EP, 0, TR)
     p.SWAPL (TR, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
     p.MOVP  (hir.Pn, EP)
     p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    translate_alloc(p, "s")
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "s")
     p.ADDP  (IP, IC, ET)
-    p.ADD   (IC, TR, IC)
+    p.SUB   (TR, IC, UR)
+    p.MOV   (TR, IC)
     p.GCALL (F_slicebytetostring).
       A0    (hir.Pn).
       A1    (ET).
-      A2    (TR).
+      A2    (UR).
       R0    (EP).
       R1    (TR)
     p.Label ("_empty_{n}")
@@ -529,17 +742,20 @@ func translate_OP_map_set_str_safe(p *hir.Builder, v Instr) {
     p.ADDI  (IC, 4, IC)
     p.LL    (ET, 0, TR)
     p.SWAPL (TR, TR)
-    p.LDAQ  (ARG_nb, UR)
-    p.BLTU  (UR, TR, LB_eof)
     p.SQ    (TR, RS, IvOffset)
     p.SP    (hir.Pn, RS, PrOffset)
     p.BEQ   (TR, hir.Rz, "_empty_{n}")
-    p.ADDPI (ET, 4, ET)
-    p.ADD   (IC, TR, IC)
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    translate_alloc(p, "s")
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "s")
+    p.ADDP  (IP, IC, ET)
+    p.SUB   (TR, IC, UR)
+    p.MOV   (TR, IC)
     p.GCALL (F_slicebytetostring).
       A0    (hir.Pn).
       A1    (ET).
-      A2    (TR).
+      A2    (UR).
       R0    (TP).
       R1    (TR)
     p.SP    (TP, RS, PrOffset)
@@ -634,33 +850,44 @@ func translate_OP_map_set_pointer_safe(p *hir.Builder, v Instr) {
 func translate_OP_list_alloc(p *hir.Builder, v Instr) {
     p.ADDP  (RS, ST, TP)
     p.LQ    (TP, NbOffset, TR)
-    p.SQ    (TR, WP, 8)
     p.LQ    (WP, 16, UR)
     p.BNE   (TR, hir.Rz, "_alloc_{n}")
+    p.SQ    (TR, WP, 8)
     p.BNE   (UR, hir.Rz, "_done_{n}")
     p.IP    (&_V_zerovalue, TP)
     p.SP    (TP, WP, 0)
     p.SQ    (hir.Rz, WP, 16)
     p.JMP   ("_done_{n}")
     p.Label ("_alloc_{n}")
-    p.BGEU  (UR, TR, "_done_{n}")
-    p.SQ    (TR, WP, 16)
+    p.BGEU  (UR, TR, "_reuse_{n}")
+    p.MULI  (TR, int64(v.Vt.Size), TR)
+    translate_alloc_header(p, "a")
     p.IB    (1, UR)
     p.IP    (v.Vt, TP)
-    p.MULI  (TR, int64(v.Vt.Size), TR)
     p.GCALL (F_mallocgc).
       A0    (TR).
       A1    (TP).
       A2    (UR).
       R0    (TP)
     p.SP    (TP, WP, 0)
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, NbOffset, TR)
+    p.SQ    (TR, WP, 16)
+    p.Label ("_reuse_{n}")
+    p.SQ    (TR, WP, 8)
     p.Label ("_done_{n}")
     p.LP    (WP, 0, WP)
 }
 
+func translate_OP_array_check(p *hir.Builder, v Instr) {
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, NbOffset, TR)
+    translate_length(p, v.Vt)
+}
+
 func translate_OP_struct_skip(p *hir.Builder, _ Instr) {
     p.ADDPI (RS, SkOffset, TP)
-    p.LDAQ  (ARG_nb, TR)
+    p.LQ    (RS, BnOffset, TR)
     p.SUB   (TR, IC, TR)
     p.ADDP  (IP, IC, EP)
     p.CCALL (C_skip).
@@ -669,24 +896,83 @@ func translate_OP_struct_skip(p *hir.Builder, _ Instr) {
       A2    (TR).
       A3    (TG).
       R0    (TR)
-    p.BLT   (TR, hir.Rz, LB_skip)
+    p.BLT   (TR, hir.Rz, "_skip_{n}")
     p.ADD   (IC, TR, IC)
+    p.JMP   ("_done_{n}")
+    p.Label ("_skip_{n}")
+    translate_skip_slow(p, F_reader_skip)
+    p.Label ("_done_{n}")
+}
+
+func translate_skip_slow(p *hir.Builder, fn *hir.CallHandle) {
+    p.GCALL (fn).
+      A0    (RS).
+      A1    (IC).
+      A2    (TG).
+      A3    (TR).
+      R0    (IC).
+      R1    (ET).
+      R2    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.LP    (RS, BpOffset, IP)
 }
 
 func translate_OP_struct_ignore(p *hir.Builder, _ Instr) {
-    p.ADDPI (RS, SkOffset, TP)
-    p.LDAQ  (ARG_nb, TR)
-    p.SUB   (TR, IC, TR)
-    p.ADDP  (IP, IC, EP)
     p.IB    (int8(defs.T_struct), TG)
-    p.CCALL (C_skip).
+    translate_OP_struct_skip(p, Instr{})
+}
+
+func translate_OP_struct_unknown(p *hir.Builder, v Instr) {
+    p.ADDPI (WP, v.Iv, TP)
+    p.GCALL (F_unknown_field).
+      A0    (RS).
+      A1    (IC).
+      A2    (TG).
+      A3    (TR).
+      A4    (TP).
+      R0    (IC).
+      R1    (ET).
+      R2    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.LP    (RS, BpOffset, IP)
+}
+
+func translate_OP_struct_unknown_init(p *hir.Builder, v Instr) {
+    p.SQ    (hir.Rz, WP, v.Iv + abi.PtrSize)
+}
+
+func translate_OP_struct_union_init(p *hir.Builder, _ Instr) {
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (hir.Rz, TP, NbOffset)
+}
+
+func translate_OP_struct_union_reset(p *hir.Builder, v Instr) {
+    p.SP    (hir.Pn, WP, 0)
+
+    /* clear the length and capacity of slices */
+    for i := int64(abi.PtrSize); i < int64(v.Vt.Size); i += abi.PtrSize {
+        p.SQ(hir.Rz, WP, i)
+    }
+}
+
+func translate_OP_struct_union_mark(p *hir.Builder, v Instr) {
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, NbOffset, TR)
+    p.IQ    (int64(v.Id) + 1, UR)
+    p.BEQ   (TR, hir.Rz, "_first_{n}")
+    p.BEQ   (TR, UR, "_first_{n}")
+    p.SUBI  (TR, 1, TR)
+    p.SUBI  (UR, 1, UR)
+    p.IP    (v.Vt, TP)
+    p.GCALL (F_error_union).
       A0    (TP).
-      A1    (EP).
-      A2    (TR).
-      A3    (TG).
-      R0    (TR)
-    p.BLT   (TR, hir.Rz, LB_skip)
-    p.ADD   (IC, TR, IC)
+      A1    (TR).
+      A2    (UR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label ("_first_{n}")
+    p.SQ    (UR, TP, NbOffset)
 }
 
 func translate_OP_struct_bitmap(p *hir.Builder, v Instr) {
@@ -794,6 +1080,13 @@ func translate_OP_struct_check_type(p *hir.Builder, v Instr) {
 func translate_OP_make_state(p *hir.Builder, _ Instr) {
     p.IQ    (StateMax, TR)
     p.BGEU  (ST, TR, LB_overflow)
+    p.LQ    (RS, LdOffset, TR)
+    p.XORI  (TR, -1, TR)
+    p.BLTU  (ST, TR, "_depth_{n}")
+    p.MOV   (ST, TR)
+    p.IB    (int8(LimitNestingDepth), UR)
+    p.JMP   (LB_limit)
+    p.Label ("_depth_{n}")
     p.ADDP  (RS, ST, TP)
     p.SP    (WP, TP, WpOffset)
     p.ADDI  (ST, StateSize, ST)
@@ -807,9 +1100,10 @@ func translate_OP_drop_state(p *hir.Builder, _ Instr) {
 }
 
 func translate_OP_construct(p *hir.Builder, v Instr) {
+    p.IQ    (int64(v.Vt.Size), TR)
+    translate_alloc(p, "o")
     p.IB    (1, UR)
     p.IP    (v.Vt, TP)
-    p.IQ    (int64(v.Vt.Size), TR)
     p.GCALL (F_mallocgc).
       A0    (TR).
       A1    (TP).
@@ -817,15 +1111,33 @@ func translate_OP_construct(p *hir.Builder, v Instr) {
       R0    (WP)
 }
 
+func translate_OP_custom(p *hir.Builder, v Instr) {
+    p.SUBI  (ST, StateSize, TR)
+    p.ADDP  (RS, TR, TP)
+    p.LP    (TP, WpOffset, EP)
+    p.IP    ((*defs.Codec)(v.Fn), TP)
+    p.GCALL (F_unmarshal).
+      A0    (TP).
+      A1    (WP).
+      A2    (EP).
+      R0    (ET).
+      R1    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+}
+
 func translate_OP_initialize(p *hir.Builder, v Instr) {
     p.GCALL (addInitFn(v.Fn)).
       A0    (WP)
 }
 
 func translate_OP_defer(p *hir.Builder, v Instr) {
+    translate_defer(p, v, F_decode)
+}
+
+func translate_defer(p *hir.Builder, v Instr, fn *hir.CallHandle) {
     p.IP    (v.Vt, TP)
-    p.LDAQ  (ARG_nb, TR)
-    p.GCALL (F_decode).
+    p.LQ    (RS, BnOffset, TR)
+    p.GCALL (fn).
       A0    (TP).
       A1    (IP).
       A2    (TR).
@@ -837,6 +1149,7 @@ func translate_OP_defer(p *hir.Builder, v Instr) {
       R1    (ET).
       R2    (EP)
     p.BNEP  (ET, hir.Pn, LB_error)
+    p.LP    (RS, BpOffset, IP)
 }
 
 func translate_OP_goto(p *hir.Builder, v Instr) {
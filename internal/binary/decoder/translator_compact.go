@@ -0,0 +1,437 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func translate_compact_size(p *hir.Builder, nb int64, lb string) {
+    p.ADDI  (IC, nb, TR)
+    translate_check(p, lb)
+}
+
+func translate_compact_varint(p *hir.Builder, lb string) {
+    translate_compact_size(p, 1, "v" + lb)
+    p.ADDP  (IP, IC, EP)
+    p.LB    (EP, 0, TR)
+    p.IQ    (0x80, UR)
+    p.BGEU  (TR, UR, "_vi_slow_" + lb + "_{n}")
+    p.ADDI  (IC, 1, IC)
+    p.JMP   ("_vi_done_" + lb + "_{n}")
+    p.Label ("_vi_slow_" + lb + "_{n}")
+    p.LQ    (RS, BnOffset, UR)
+    p.GCALL (F_compact_varint).
+      A0    (IP).
+      A1    (UR).
+      A2    (IC).
+      R0    (TR).
+      R1    (UR)
+    p.BLT   (UR, hir.Rz, "_vi_error_" + lb + "_{n}")
+    p.MOV   (UR, IC)
+    p.JMP   ("_vi_done_" + lb + "_{n}")
+    p.Label ("_vi_error_" + lb + "_{n}")
+    p.GCALL (F_reader_varint).
+      A0    (RS).
+      A1    (IC).
+      A2    (UR).
+      R0    (TR).
+      R1    (IC).
+      R2    (ET).
+      R3    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.LP    (RS, BpOffset, IP)
+    p.Label ("_vi_done_" + lb + "_{n}")
+}
+
+func translate_compact_zigzag(p *hir.Builder, lb string) {
+    p.ANDI  (TR, 1, UR)
+    p.SHRI  (TR, 1, TR)
+    p.BEQ   (UR, hir.Rz, "_zz_" + lb + "_{n}")
+    p.XORI  (TR, -1, TR)
+    p.Label ("_zz_" + lb + "_{n}")
+}
+
+func translate_compact_length(p *hir.Builder) {
+    translate_compact_varint(p, "n")
+    translate_limit(p, LsOffset, LimitStringSize, "s")
+    translate_count(p, 1, F_reader_check)
+    p.ADD   (IC, TR, TR)
+    translate_check(p, "l")
+    p.SUB   (TR, IC, TR)
+}
+
+func translate_compact_check_type(p *hir.Builder, vt defs.CompactTag, lb string) {
+    if vt != defs.C_bool {
+        p.IB    (int8(vt), UR)
+        p.BNE   (TR, UR, LB_type)
+    } else {
+        p.IB    (int8(defs.C_true), UR)
+        p.BEQ   (TR, UR, "_type_ok_" + lb + "_{n}")
+        p.IB    (int8(defs.C_false), UR)
+        p.BEQ   (TR, UR, "_type_ok_" + lb + "_{n}")
+        p.IB    (int8(defs.C_true), UR)
+        p.JMP   (LB_type)
+        p.Label ("_type_ok_" + lb + "_{n}")
+    }
+}
+
+func translate_OP_compact_bool(p *hir.Builder, _ Instr) {
+    p.ADDP  (IP, IC, EP)
+    p.LB    (EP, 0, TR)
+    p.ADDI  (IC, 1, IC)
+    p.IB    (int8(defs.C_true), UR)
+    p.BEQ   (TR, UR, "_true_{n}")
+    p.SB    (hir.Rz, WP, 0)
+    p.JMP   ("_done_{n}")
+    p.Label ("_true_{n}")
+    p.IB    (1, TR)
+    p.SB    (TR, WP, 0)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_double(p *hir.Builder, _ Instr) {
+    p.ADDP  (IP, IC, EP)
+    p.LQ    (EP, 0, TR)
+    p.SQ    (TR, WP, 0)
+    p.ADDI  (IC, 8, IC)
+}
+
+func translate_OP_compact_vint(p *hir.Builder, v Instr) {
+    translate_compact_varint(p, "x")
+    translate_compact_zigzag(p, "x")
+
+    /* store the value */
+    switch v.Iv {
+        case 2  : p.SW(TR, WP, 0)
+        case 4  : p.SL(TR, WP, 0)
+        case 8  : p.SQ(TR, WP, 0)
+        default : panic("can only decode 2, 4 or 8 bytes integers")
+    }
+}
+
+func translate_OP_compact_uint(p *hir.Builder, v Instr) {
+    translate_compact_varint(p, "x")
+    translate_compact_zigzag(p, "x")
+    translate_range(p, v.Vt, v.Iv)
+    translate_store(p, v.Vt)
+}
+
+func translate_OP_compact_float(p *hir.Builder, _ Instr) {
+    p.ADDP  (IP, IC, EP)
+    p.LQ    (EP, 0, TR)
+    translate_narrow(p)
+    p.SL    (TR, WP, 0)
+    p.ADDI  (IC, 8, IC)
+}
+
+func translate_OP_compact_fixed(p *hir.Builder, v Instr) {
+    translate_compact_length(p)
+    translate_length(p, v.Vt)
+    translate_copy(p, v.Vt)
+}
+
+func translate_OP_compact_str(p *hir.Builder, _ Instr) {
+    p.SP    (hir.Pn, WP, 0)
+    translate_compact_length(p)
+    translate_alloc(p, "s")
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    p.ADDP  (IP, IC, EP)
+    p.ADD   (IC, TR, IC)
+    p.GCALL (F_slicebytetostring).
+      A0    (hir.Pn).
+      A1    (EP).
+      A2    (TR).
+      R0    (TP).
+      R1    (TR)
+    p.SP    (TP, WP, 0)
+    p.Label ("_empty_{n}")
+    p.SQ    (TR, WP, 8)
+}
+
+func translate_OP_compact_str_nocopy(p *hir.Builder, _ Instr) {
+    p.SP    (hir.Pn, WP, 0)
+    translate_compact_binstr_nocopy(p)
+}
+
+func translate_OP_compact_bin(p *hir.Builder, _ Instr) {
+    p.IP    (&_V_zerovalue, TP)
+    p.SP    (TP, WP, 0)
+    translate_compact_length(p)
+    translate_alloc(p, "s")
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    p.ADDP  (IP, IC, EP)
+    p.ADD   (IC, TR, IC)
+    p.IP    (_T_byte, TP)
+    p.GCALL (F_mallocgc).
+      A0    (TR).
+      A1    (TP).
+      A2    (hir.Rz).
+      R0    (TP)
+    p.BCOPY (EP, TR, TP)
+    p.SP    (TP, WP, 0)
+    p.Label ("_empty_{n}")
+    p.SQ    (TR, WP, 8)
+    p.SQ    (TR, WP, 16)
+}
+
+func translate_OP_compact_bin_nocopy(p *hir.Builder, _ Instr) {
+    p.IP    (&_V_zerovalue, TP)
+    p.SP    (TP, WP, 0)
+    translate_compact_binstr_nocopy(p)
+    p.SQ    (TR, WP, 16)
+}
+
+func translate_compact_binstr_nocopy(p *hir.Builder) {
+    translate_compact_length(p)
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    p.ADDP  (IP, IC, EP)
+    p.ADD   (IC, TR, IC)
+    p.SP    (EP, WP, 0)
+    p.Label ("_empty_{n}")
+    p.SQ    (TR, WP, 8)
+}
+
+func translate_OP_compact_list_begin(p *hir.Builder, v Instr) {
+    translate_compact_size(p, 1, "h")
+    p.ADDP  (IP, IC, EP)
+    p.ADDI  (IC, 1, IC)
+    p.LB    (EP, 0, TG)
+    p.ANDI  (TG, 0x0f, TR)
+    translate_compact_check_type(p, defs.CompactTag(v.Tx), "e")
+
+    /* short form: (size << 4) | type, long form: 0xf0 | type, followed by the size */
+    p.SHRI  (TG, 4, TR)
+    p.IB    (0x0f, UR)
+    p.BNE   (TR, UR, "_short_{n}")
+    translate_compact_varint(p, "x")
+    p.Label ("_short_{n}")
+    translate_limit(p, LcOffset, LimitContainerSize, "c")
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (TR, TP, NbOffset)
+    translate_count(p, int64(defs.CompactTag(v.Tx).MinSize()), F_reader_count)
+}
+
+func translate_OP_compact_map_begin(p *hir.Builder, v Instr) {
+    translate_compact_varint(p, "x")
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    translate_limit(p, LcOffset, LimitContainerSize, "c")
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (TR, TP, NbOffset)
+    translate_count(p, int64(defs.CompactTag(v.Iv >> 4).MinSize() + defs.CompactTag(v.Iv & 0x0f).MinSize()), F_reader_count)
+
+    /* key and value types: (key << 4) | value */
+    translate_compact_size(p, 1, "h")
+    p.ADDP  (IP, IC, EP)
+    p.ADDI  (IC, 1, IC)
+    p.LB    (EP, 0, TG)
+    p.SHRI  (TG, 4, TR)
+    translate_compact_check_type(p, defs.CompactTag(v.Iv >> 4), "k")
+    p.ANDI  (TG, 0x0f, TR)
+    translate_compact_check_type(p, defs.CompactTag(v.Iv & 0x0f), "v")
+    p.JMP   ("_done_{n}")
+
+    /* empty maps are encoded as a single zero byte */
+    p.Label ("_empty_{n}")
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (hir.Rz, TP, NbOffset)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_map_set_int(p *hir.Builder, v Instr) {
+    switch v.Tx {
+        default: {
+            panic("invalid compact map key type: " + strconv.Itoa(int(v.Tx)))
+        }
+
+        /* booleans, normalize to 0 or 1 */
+        case defs.T_bool: {
+            translate_compact_size(p, 1, "h")
+            p.ADDP  (IP, IC, EP)
+            p.LB    (EP, 0, TR)
+            p.ADDI  (IC, 1, IC)
+            p.IB    (int8(defs.C_true), UR)
+            p.BEQ   (TR, UR, "_true_{n}")
+            p.MOV   (hir.Rz, TR)
+            p.Label ("_true_{n}")
+            p.SB    (TR, RS, IvOffset)
+        }
+
+        /* bytes */
+        case defs.T_i8: {
+            translate_compact_size(p, 1, "h")
+            p.ADDP  (IP, IC, EP)
+            p.LB    (EP, 0, TR)
+            p.ADDI  (IC, 1, IC)
+            p.SB    (TR, RS, IvOffset)
+        }
+
+        /* doubles */
+        case defs.T_double: {
+            translate_compact_size(p, 8, "h")
+            p.ADDP  (IP, IC, EP)
+            p.LQ    (EP, 0, TR)
+            p.ADDI  (IC, 8, IC)
+            p.SQ    (TR, RS, IvOffset)
+        }
+
+        /* varints */
+        case defs.T_i16: translate_compact_varint(p, "x"); translate_compact_zigzag(p, "x"); p.SW(TR, RS, IvOffset)
+        case defs.T_i32: translate_compact_varint(p, "x"); translate_compact_zigzag(p, "x"); p.SL(TR, RS, IvOffset)
+        case defs.T_i64: translate_compact_varint(p, "x"); translate_compact_zigzag(p, "x"); p.SQ(TR, RS, IvOffset)
+        case defs.T_enum: translate_compact_varint(p, "x"); translate_compact_zigzag(p, "x"); p.SQ(TR, RS, IvOffset)
+    }
+
+    /* assign to the map */
+    p.ADDP  (RS, ST, TP)
+    p.LP    (TP, MpOffset, EP)
+    p.ADDPI (RS, IvOffset, TP)
+    p.IP    (v.Vt, ET)
+    p.GCALL (F_mapassign).
+      A0    (ET).
+      A1    (EP).
+      A2    (TP).
+      R0    (WP)
+}
+
+func translate_OP_compact_map_set_str(p *hir.Builder, v Instr) {
+    translate_compact_length(p)
+    translate_alloc(p, "s")
+    p.SQ    (TR, RS, IvOffset)
+    p.SP    (hir.Pn, RS, PrOffset)
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+    p.ADDP  (IP, IC, ET)
+    p.ADD   (IC, TR, IC)
+    p.GCALL (F_slicebytetostring).
+      A0    (hir.Pn).
+      A1    (ET).
+      A2    (TR).
+      R0    (TP).
+      R1    (TR)
+    p.SP    (TP, RS, PrOffset)
+    p.Label ("_empty_{n}")
+    p.ADDP  (RS, ST, EP)
+    p.LP    (EP, MpOffset, EP)
+    p.IP    (v.Vt, ET)
+    p.ADDPI (RS, PrOffset, TP)
+    p.GCALL (F_mapassign).
+      A0    (ET).
+      A1    (EP).
+      A2    (TP).
+      R0    (WP)
+    p.SP    (hir.Pn, RS, PrOffset)
+}
+
+func translate_OP_compact_begin(p *hir.Builder, _ Instr) {
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (hir.Rz, TP, FiOffset)
+}
+
+func translate_OP_compact_field(p *hir.Builder, _ Instr) {
+    translate_compact_size(p, 1, "h")
+    p.ADDP  (IP, IC, EP)
+    p.ADDI  (IC, 1, IC)
+    p.LB    (EP, 0, TG)
+    p.BEQ   (TG, hir.Rz, "_done_{n}")
+    p.SHRI  (TG, 4, TR)
+    p.ANDI  (TG, 0x0f, TG)
+    p.BEQ   (TR, hir.Rz, "_long_{n}")
+
+    /* short form: field ID delta within the type byte */
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, FiOffset, UR)
+    p.ADD   (UR, TR, TR)
+    p.SQ    (TR, TP, FiOffset)
+    p.JMP   ("_done_{n}")
+
+    /* long form: zigzag-encoded field ID follows the type byte */
+    p.Label ("_long_{n}")
+    translate_compact_varint(p, "x")
+    translate_compact_zigzag(p, "x")
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (TR, TP, FiOffset)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_field_bool(p *hir.Builder, _ Instr) {
+    p.IB    (int8(defs.C_true), TR)
+    p.BEQ   (TG, TR, "_true_{n}")
+    p.SB    (hir.Rz, WP, 0)
+    p.JMP   ("_done_{n}")
+    p.Label ("_true_{n}")
+    p.IB    (1, TR)
+    p.SB    (TR, WP, 0)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_switch(p *hir.Builder, v Instr) {
+    stab := v.IntSeq()
+    ptab := make([]string, v.Iv)
+
+    /* convert the switch table */
+    for i, to := range stab {
+        if to >= 0 {
+            ptab[i] = p.At(to)
+        }
+    }
+
+    /* load and dispatch the field */
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, FiOffset, TR)
+    p.BSW   (TR, ptab)
+}
+
+func translate_OP_compact_check_type(p *hir.Builder, v Instr) {
+    if defs.CompactTag(v.Tx) != defs.C_bool {
+        p.IB    (int8(v.Tx), TR)
+        p.BNE   (TG, TR, p.At(v.To))
+    } else {
+        p.IB    (int8(defs.C_true), TR)
+        p.BEQ   (TG, TR, "_ok_{n}")
+        p.IB    (int8(defs.C_false), TR)
+        p.BNE   (TG, TR, p.At(v.To))
+        p.Label ("_ok_{n}")
+    }
+}
+
+func translate_OP_compact_skip(p *hir.Builder, _ Instr) {
+    p.LQ    (RS, BnOffset, TR)
+    p.GCALL (F_compact_skip).
+      A0    (IP).
+      A1    (TR).
+      A2    (IC).
+      A3    (TG).
+      R0    (TR)
+    p.BLT   (TR, hir.Rz, "_skip_{n}")
+    p.MOV   (TR, IC)
+    p.JMP   ("_done_{n}")
+    p.Label ("_skip_{n}")
+    translate_skip_slow(p, F_reader_compact_skip)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_ignore(p *hir.Builder, _ Instr) {
+    p.IB    (int8(defs.C_struct), TG)
+    translate_OP_compact_skip(p, Instr{})
+}
+
+func translate_OP_compact_defer(p *hir.Builder, v Instr) {
+    translate_defer(p, v, F_decode_compact)
+}
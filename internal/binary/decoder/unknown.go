@@ -0,0 +1,56 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package decoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+var (
+    F_unknown_field = hir.RegisterGCall(unknown_field, emu_gcall_unknown_field)
+)
+
+func unknown_field(rs *RuntimeState, i int, t uint8, id int, p *[]byte) (int, error) {
+    sb := (*_skipbuf_t)(unsafe.Pointer(&rs.Sk))
+    fn := func(s unsafe.Pointer, n int) int { return do_skip(sb, s, n, defs.Tag(t)) }
+
+    /* the field header has already been consumed, re-emit it */
+    *p = append(*p, t, byte(id >> 8), byte(id))
+    rv := fn(unsafe.Pointer(uintptr(rs.Bp) + uintptr(i)), int(rs.Bn) - i)
+
+    /* the value is within the current buffer */
+    if rv >= 0 {
+        *p = append(*p, rt.BytesFrom(rs.Bp, int(rs.Bn), int(rs.Bn))[i:i + rv]...)
+        return i + rv, nil
+    }
+
+    /* the value may straddle segment boundaries, the reader
+     * moves the buffer to the beginning of the value in this case */
+    if rv != EEOF || rs.Rd == nil {
+        return i, error_skip(rv)
+    } else if rv, err := rs.Rd.skip(rs, i, fn); err != nil {
+        return i, err
+    } else {
+        *p = append(*p, rt.BytesFrom(rs.Bp, int(rs.Bn), int(rs.Bn))[:rv]...)
+        return rv, nil
+    }
+}
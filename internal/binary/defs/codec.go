@@ -0,0 +1,113 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package defs
+
+import (
+    `fmt`
+    `reflect`
+    `sync`
+    `unsafe`
+)
+
+var (
+    errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Codec converts a custom Go type from and to the Go type that is actually serialized.
+type Codec struct {
+    Type reflect.Type
+    Wire reflect.Type
+    enc  reflect.Value
+    dec  reflect.Value
+}
+
+var (
+    codecs sync.Map
+)
+
+// NewCodec creates a codec from a pair of functions, marshal must be of type
+// `func(T) (W, error)`, and unmarshal must be of type `func(W) (T, error)`.
+func NewCodec(marshal interface{}, unmarshal interface{}) (*Codec, error) {
+    enc := reflect.ValueOf(marshal)
+    dec := reflect.ValueOf(unmarshal)
+
+    /* both must be functions */
+    if enc.Kind() != reflect.Func || dec.Kind() != reflect.Func {
+        return nil, fmt.Errorf("frugal: codec functions must be funcs, not %T and %T", marshal, unmarshal)
+    }
+
+    /* check the function signatures */
+    if et, dt := enc.Type(), dec.Type(); !isConverter(et) || !isConverter(dt) {
+        return nil, fmt.Errorf("frugal: codec functions must be of type func(T) (W, error) and func(W) (T, error)")
+    } else if et.In(0) != dt.Out(0) || et.Out(0) != dt.In(0) {
+        return nil, fmt.Errorf("frugal: mismatched codec functions: %s and %s", et, dt)
+    } else {
+        return &Codec { Type: et.In(0), Wire: et.Out(0), enc: enc, dec: dec }, nil
+    }
+}
+
+// RegisterCodec registers the codec for its type, types cannot be registered twice.
+func RegisterCodec(cc *Codec) error {
+    if cc.Type.Kind() == reflect.Ptr {
+        return fmt.Errorf("frugal: cannot register codec for pointer type %s", cc.Type)
+    } else if cc.Wire.Kind() == reflect.Ptr {
+        return fmt.Errorf("frugal: wire type of %s must not be a pointer, got %s", cc.Type, cc.Wire)
+    } else if cc.Wire == cc.Type || LookupCodec(cc.Wire) != nil {
+        return fmt.Errorf("frugal: wire type of %s must not be a custom type, got %s", cc.Type, cc.Wire)
+    } else if _, ok := codecs.LoadOrStore(cc.Type, cc); ok {
+        return fmt.Errorf("frugal: duplicated codec for type %s", cc.Type)
+    } else {
+        return nil
+    }
+}
+
+// LookupCodec finds the registered codec of type vt, or nil if none.
+func LookupCodec(vt reflect.Type) *Codec {
+    if cc, ok := codecs.Load(vt); !ok {
+        return nil
+    } else {
+        return cc.(*Codec)
+    }
+}
+
+// Marshal converts the custom value at p into the wire value at w.
+func (self *Codec) Marshal(p unsafe.Pointer, w unsafe.Pointer) error {
+    return self.call(self.enc, self.Type, self.Wire, p, w)
+}
+
+// Unmarshal converts the wire value at w into the custom value at p.
+func (self *Codec) Unmarshal(w unsafe.Pointer, p unsafe.Pointer) error {
+    return self.call(self.dec, self.Wire, self.Type, w, p)
+}
+
+func (self *Codec) call(fn reflect.Value, st reflect.Type, dt reflect.Type, sp unsafe.Pointer, dp unsafe.Pointer) error {
+    rv := fn.Call([]reflect.Value { reflect.NewAt(st, sp).Elem() })
+    ev := rv[1].Interface()
+
+    /* check for errors */
+    if ev != nil {
+        return fmt.Errorf("frugal: cannot convert %s to %s: %w", st, dt, ev.(error))
+    }
+
+    /* store the result */
+    reflect.NewAt(dt, dp).Elem().Set(rv[0])
+    return nil
+}
+
+func isConverter(vt reflect.Type) bool {
+    return vt.NumIn() == 1 && vt.NumOut() == 2 && vt.Out(1) == errorType && !vt.IsVariadic()
+}
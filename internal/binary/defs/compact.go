@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package defs
+
+import (
+    `fmt`
+)
+
+// CompactTag is the 4-bit type identifier used by the Thrift Compact Protocol.
+type CompactTag uint8
+
+const (
+    C_stop   CompactTag = 0
+    C_true   CompactTag = 1
+    C_false  CompactTag = 2
+    C_i8     CompactTag = 3
+    C_i16    CompactTag = 4
+    C_i32    CompactTag = 5
+    C_i64    CompactTag = 6
+    C_double CompactTag = 7
+    C_binary CompactTag = 8
+    C_list   CompactTag = 9
+    C_set    CompactTag = 10
+    C_map    CompactTag = 11
+    C_struct CompactTag = 12
+)
+
+const (
+    C_bool = C_true
+)
+
+var compactTags = [256]CompactTag {
+    T_bool   : C_bool,
+    T_i8     : C_i8,
+    T_double : C_double,
+    T_i16    : C_i16,
+    T_i32    : C_i32,
+    T_i64    : C_i64,
+    T_string : C_binary,
+    T_struct : C_struct,
+    T_map    : C_map,
+    T_set    : C_set,
+    T_list   : C_list,
+}
+
+var compactNames = [16]string {
+    C_stop   : "stop",
+    C_true   : "bool(true)",
+    C_false  : "bool(false)",
+    C_i8     : "i8",
+    C_i16    : "i16",
+    C_i32    : "i32",
+    C_i64    : "i64",
+    C_double : "double",
+    C_binary : "binary",
+    C_list   : "list",
+    C_set    : "set",
+    C_map    : "map",
+    C_struct : "struct",
+}
+
+func (self Tag) Compact() CompactTag {
+    if self.IsWireTag() {
+        return compactTags[self]
+    } else {
+        panic(fmt.Sprintf("no compact type for tag %d", self))
+    }
+}
+
+var binaryTags = [16]Tag {
+    C_true   : T_bool,
+    C_false  : T_bool,
+    C_i8     : T_i8,
+    C_i16    : T_i16,
+    C_i32    : T_i32,
+    C_i64    : T_i64,
+    C_double : T_double,
+    C_binary : T_string,
+    C_list   : T_list,
+    C_set    : T_set,
+    C_map    : T_map,
+    C_struct : T_struct,
+}
+
+// Tag returns the Binary Protocol type of the Compact Protocol type, or 0 if
+// there is no such type.
+func (self CompactTag) Tag() Tag {
+    if self < 16 {
+        return binaryTags[self]
+    } else {
+        return 0
+    }
+}
+
+// MinSize returns the minimum number of bytes a value of the Compact Protocol type takes, every
+// value takes at least one byte except doubles, which are always 8 bytes.
+func (self CompactTag) MinSize() int {
+    if self == C_double {
+        return 8
+    } else {
+        return 1
+    }
+}
+
+func (self CompactTag) String() string {
+    if self < 16 && compactNames[self] != "" {
+        return compactNames[self]
+    } else {
+        return fmt.Sprintf("CompactTag(%d)", self)
+    }
+}
+
+// Compact returns the Compact Protocol type of the value, booleans are always
+// reported as C_true, since the actual value is carried in the type nibble.
+func (self *Type) Compact() CompactTag {
+    return self.Tag().Compact()
+}
@@ -33,6 +33,7 @@ type (
 
 const (
     NoCopy Options = 1 << iota
+    Inferred
 )
 
 const (
@@ -50,6 +51,11 @@ func (self Options) String() string {
         ret = append(ret, "nocopy")
     }
 
+    /* check for types inferred from "thrift" tags */
+    if self & Inferred != 0 {
+        ret = append(ret, "inferred")
+    }
+
     /* join them together */
     return fmt.Sprintf(
         "{%s}",
@@ -69,6 +75,7 @@ func (self Requiredness) String() string {
 type Field struct {
     F       int
     ID      uint16
+    Name    string
     Type    *Type
     Opts    Options
     Spec    Requiredness
@@ -115,6 +122,7 @@ func ResolveFields(vt reflect.Type) ([]Field, error) {
 }
 
 func doResolveFields(vt reflect.Type) ([]Field, error) {
+    var nx int
     var err error
     var ret []Field
     var mem reflect.Value
@@ -146,11 +154,23 @@ func doResolveFields(vt reflect.Type) ([]Field, error) {
             continue
         }
 
-        /* ignore fields that does not declare the "frugal" tag */
-        if tv, ok = sf.Tag.Lookup("frugal"); !ok {
+        /* ignore the unknown fields buffer, or the union marker */
+        if tv, ok = sf.Tag.Lookup("frugal"); ok && (tv == UnknownFieldsTag || tv == UnionTag) {
             continue
         }
 
+        /* fall back to the "thrift" tag generated by thriftgo or Apache Thrift if no "frugal" tag */
+        if !ok {
+            if tv, ok = sf.Tag.Lookup("thrift"); !ok {
+                nx++
+                continue
+            } else if tv, err = convertThriftTag(sf.Type, tv); err != nil {
+                return nil, fmt.Errorf("cannot convert thrift tag for field %s.%s: %w", vt, sf.Name, err)
+            } else {
+                fv |= Inferred
+            }
+        }
+
         /* must have at least 2 fields: ID and Requiredness */
         if ft = strings.Split(tv, ","); len(ft) < 2 {
             return nil, fmt.Errorf("invalid tag for field %s.%s", vt, sf.Name)
@@ -202,7 +222,7 @@ func doResolveFields(vt reflect.Type) ([]Field, error) {
 
                 /* "nocopy" option enables zero-copy string decoding */
                 case "nocopy": {
-                    if pt.Tag() != T_string {
+                    if pt.Tag() != T_string || isConverted(pt) {
                         return nil, fmt.Errorf(`"nocopy" is only applicable to "string" and "binary" types, not %s`, pt)
                     } else if fv & NoCopy != 0 {
                         return nil, fmt.Errorf(`duplicated option "nocopy" for field %s.%s`, vt, sf.Name)
@@ -222,6 +242,7 @@ func doResolveFields(vt reflect.Type) ([]Field, error) {
         ret = append(ret, Field {
             F       : int(sf.Offset),
             ID      : uint16(id),
+            Name    : sf.Name,
             Type    : pt,
             Opts    : fv,
             Spec    : rx,
@@ -229,7 +250,105 @@ func doResolveFields(vt reflect.Type) ([]Field, error) {
         })
     }
 
+    /* exported fields without any tags are likely a mistake, rather than an empty struct */
+    if len(ret) == 0 && nx != 0 {
+        return nil, fmt.Errorf("struct %s has exported fields, but none of them has a \"frugal\" or \"thrift\" tag", vt)
+    }
+
     /* sort the field by ID */
     sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
     return ret, nil
 }
+
+const (
+    UnknownFieldsTag  = "_unknown"
+    UnknownFieldsName = "_unknownFields"
+)
+
+var (
+    byteSliceType = reflect.TypeOf([]byte(nil))
+)
+
+// ResolveUnknownFields finds the field that preserves the raw bytes of unknown fields,
+// which is either named "_unknownFields" or tagged with `frugal:"_unknown"`, and returns
+// its offset, or -1 if the struct does not have such a field.
+func ResolveUnknownFields(vt reflect.Type) (int, error) {
+    var ok bool
+    var sf reflect.StructField
+
+    /* find the field by tag first, then find by name */
+    if sf, ok = findTag(vt, UnknownFieldsTag); !ok {
+        if sf, ok = vt.FieldByName(UnknownFieldsName); !ok || len(sf.Index) != 1 {
+            return -1, nil
+        }
+    }
+
+    /* the field must be a byte slice */
+    if sf.Type != byteSliceType {
+        return -1, fmt.Errorf("unknown fields buffer %s.%s must be []byte, not %s", vt, sf.Name, sf.Type)
+    } else {
+        return int(sf.Offset), nil
+    }
+}
+
+const (
+    UnionTag = "_union"
+)
+
+// ResolveUnion checks if the struct is a Thrift union, which is marked by a field tagged
+// with `frugal:"_union"`, usually a blank `_ struct{}` field. Every field of a union must
+// be optional, and must be a pointer, a slice or a map, so that unset fields are nil.
+func ResolveUnion(vt reflect.Type) (bool, error) {
+    var err error
+    var fvs []Field
+
+    /* find the union marker */
+    if _, ok := findTag(vt, UnionTag); !ok {
+        return false, nil
+    }
+
+    /* resolve all the fields */
+    if fvs, err = ResolveFields(vt); err != nil {
+        return false, err
+    }
+
+    /* check every field */
+    for _, fv := range fvs {
+        if fv.Spec != Optional {
+            return false, fmt.Errorf("union field %s.%s must be optional", vt, fv.Name)
+        } else if !isNullable(fv.Type) {
+            return false, fmt.Errorf("union field %s.%s must be a pointer, a slice or a map, not %s", vt, fv.Name, fv.Type)
+        }
+    }
+
+    /* all fields are valid */
+    return true, nil
+}
+
+func findTag(vt reflect.Type, tag string) (reflect.StructField, bool) {
+    for i := 0; i < vt.NumField(); i++ {
+        if sf := vt.Field(i); sf.Tag.Get("frugal") == tag {
+            return sf, true
+        }
+    }
+    return reflect.StructField{}, false
+}
+
+func isNullable(vt *Type) bool {
+    switch vt.T {
+        case T_pointer : return true
+        case T_binary  : return true
+        case T_map     : return true
+        case T_set     : return true
+        case T_list    : return true
+        default        : return false
+    }
+}
+
+func isConverted(vt *Type) bool {
+    if vt.T == T_pointer {
+        return isConverted(vt.V)
+    } else {
+        return vt.T == T_fixed || vt.T == T_custom
+    }
+}
@@ -40,3 +40,76 @@ func TestResolver_StringOptions(t *testing.T) {
     spew.Config.DisablePointerMethods = true
     spew.Dump(ret)
 }
+
+type UnionFields struct {
+    _ struct{}        `frugal:"_union"`
+    A *int64          `frugal:"1,optional,i64"`
+    B []byte          `frugal:"2,optional,binary"`
+    C map[int64]int64 `frugal:"3,optional,map<i64:i64>"`
+}
+
+type UnionInvalidFields struct {
+    _ struct{} `frugal:"_union"`
+    A string   `frugal:"1,optional,string"`
+}
+
+func TestResolver_Union(t *testing.T) {
+    ok, err := ResolveUnion(reflect.TypeOf(UnionFields{}))
+    require.NoError(t, err)
+    require.True(t, ok)
+    ok, err = ResolveUnion(reflect.TypeOf(NoCopyStringFields{}))
+    require.NoError(t, err)
+    require.False(t, ok)
+    _, err = ResolveUnion(reflect.TypeOf(UnionInvalidFields{}))
+    require.Error(t, err)
+}
+
+type ThriftEnum int64
+
+func (ThriftEnum) String() string {
+    return ""
+}
+
+type ThriftTypedef int64
+
+type ThriftTagFields struct {
+    A int64                 `thrift:"A,1,required"`
+    B []byte                `thrift:"B,2"`
+    C ThriftEnum            `thrift:"C,3"`
+    D ThriftTypedef         `thrift:"D,4"`
+    E *[]string             `thrift:"E,5,optional"`
+    F map[int32]ThriftEnum  `thrift:"F,6"`
+    G *NoCopyStringFields   `thrift:"G,7"`
+    H []int64               `thrift:"H,8" frugal:"8,default,set<i64>"`
+}
+
+type ThriftTagUnsupported struct {
+    A uint32 `thrift:"A,1"`
+}
+
+type UntaggedFields struct {
+    A int64
+}
+
+func TestResolver_ThriftTag(t *testing.T) {
+    ret, err := ResolveFields(reflect.TypeOf(ThriftTagFields{}))
+    require.NoError(t, err)
+    require.Len(t, ret, 8)
+    require.Equal(t, Required, ret[0].Spec)
+    require.Equal(t, "i64", ret[0].Type.String())
+    require.Equal(t, "binary", ret[1].Type.String())
+    require.Equal(t, T_enum, ret[2].Type.T)
+    require.Equal(t, T_i64, ret[3].Type.T)
+    require.Equal(t, Optional, ret[4].Spec)
+    require.Equal(t, T_list, ret[4].Type.V.T)
+    require.Equal(t, T_map, ret[5].Type.T)
+    require.Equal(t, T_enum, ret[5].Type.V.T)
+    require.Equal(t, T_struct, ret[6].Type.V.T)
+    require.Equal(t, T_set, ret[7].Type.T)
+    _, err = ResolveFields(reflect.TypeOf(ThriftTagUnsupported{}))
+    require.Error(t, err)
+    _, err = ResolveFields(reflect.TypeOf(UntaggedFields{}))
+    require.Error(t, err)
+    _, err = ResolveFields(reflect.TypeOf(struct{}{}))
+    require.NoError(t, err)
+}
@@ -26,6 +26,11 @@ const (
 )
 
 func GetSize(vt reflect.Type) int {
+    if LookupCodec(vt) != nil {
+        return -1
+    }
+
+    /* check for value kind */
     switch vt.Kind() {
         case reflect.Bool    : return 1
         case reflect.Int     : return IntSize
@@ -34,6 +39,13 @@ func GetSize(vt reflect.Type) int {
         case reflect.Int32   : return 4
         case reflect.Int64   : return measureInt64(vt)
         case reflect.Float64 : return 8
+        case reflect.Uint    : return -1
+        case reflect.Uint8   : return -1
+        case reflect.Uint16  : return -1
+        case reflect.Uint32  : return -1
+        case reflect.Uint64  : return -1
+        case reflect.Float32 : return -1
+        case reflect.Array   : return -1
         case reflect.Map     : return -1
         case reflect.Ptr     : return -1
         case reflect.Slice   : return -1
@@ -43,6 +55,34 @@ func GetSize(vt reflect.Type) int {
     }
 }
 
+// WireSize returns the size of fixed-size wire types in the Binary Protocol, or
+// -1 if the size is variable.
+func WireSize(tag Tag) int {
+    switch tag {
+        case T_bool   : return 1
+        case T_i8     : return 1
+        case T_double : return 8
+        case T_i16    : return 2
+        case T_i32    : return 4
+        case T_i64    : return 8
+        default       : return -1
+    }
+}
+
+// MinWireSize returns the minimum number of bytes a value of the wire type takes in the Binary
+// Protocol, or -1 if it is not a wire type. It bounds the number of elements a container can hold
+// within the remaining input.
+func MinWireSize(tag Tag) int {
+    switch tag {
+        case T_string : return 4
+        case T_struct : return 1
+        case T_map    : return 6
+        case T_set    : return 5
+        case T_list   : return 5
+        default       : return WireSize(tag)
+    }
+}
+
 func measureInt64(vt reflect.Type) int {
     if vt == i64type {
         return 8
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package defs
+
+import (
+    `fmt`
+    `reflect`
+    `strings`
+
+    `github.com/cloudwego/frugal/internal/utils`
+)
+
+/** Thrift Tags
+ *
+ *  Code generated by thriftgo or Apache Thrift without the "frugal_tag" option only carries
+ *  tags like `thrift:"Name,1,required"`, which does not describe the Thrift type of the field.
+ *  The type descriptor is inferred from the Go type instead, with a few caveats:
+ *
+ *    - sets and lists are both generated as slices, so slices are encoded as lists, but
+ *      decoded from either of them (see the Inferred option),
+ *    - named int64 types with a String method are enums, other integer types are typedefs,
+ *    - unsigned integers, float32, arrays and custom codecs cannot be inferred.
+ *
+ *  Fields that cannot be inferred correctly need a `frugal` tag to override it.
+ */
+
+var (
+    stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+func convertThriftTag(vt reflect.Type, tv string) (string, error) {
+    var err error
+    var ft []string
+    var rx string
+    var td string
+
+    /* must have at least 2 fields: Name and ID */
+    if ft = strings.Split(tv, ","); len(ft) < 2 || len(ft) > 3 {
+        return "", fmt.Errorf("invalid thrift tag %q", tv)
+    }
+
+    /* the requiredness is omitted for default fields */
+    if rx = "default"; len(ft) == 3 {
+        rx = strings.TrimSpace(ft[2])
+    }
+
+    /* infer the type descriptor */
+    if td, err = inferType(vt); err != nil {
+        return "", err
+    }
+
+    /* build the equivalent "frugal" tag */
+    return strings.TrimSpace(ft[1]) + "," + rx + "," + td, nil
+}
+
+func inferType(vt reflect.Type) (string, error) {
+    if vt.Kind() == reflect.Ptr {
+        vt = vt.Elem()
+    }
+
+    /* custom codecs have their own descriptors */
+    if LookupCodec(vt) != nil {
+        return "", utils.EType(vt, "cannot infer the Thrift type of custom types")
+    }
+
+    /* check for value kind */
+    switch vt.Kind() {
+        case reflect.Bool    : return "bool", nil
+        case reflect.Int     : return keywordTab[T_int()], nil
+        case reflect.Int8    : return "byte", nil
+        case reflect.Int16   : return "i16", nil
+        case reflect.Int32   : return "i32", nil
+        case reflect.Int64   : return inferInt64(vt), nil
+        case reflect.Float64 : return "double", nil
+        case reflect.String  : return "string", nil
+        case reflect.Map     : return inferMap(vt)
+        case reflect.Slice   : return inferSlice(vt)
+        case reflect.Struct  : return inferStruct(vt), nil
+        default              : return "", utils.EType(vt, "cannot infer the Thrift type")
+    }
+}
+
+func inferInt64(vt reflect.Type) string {
+    if vt.Name() != "" && vt != i64type && vt.Implements(stringerType) {
+        return vt.Name()
+    } else {
+        return "i64"
+    }
+}
+
+func inferMap(vt reflect.Type) (string, error) {
+    if kt, err := inferType(vt.Key()); err != nil {
+        return "", err
+    } else if et, err := inferType(vt.Elem()); err != nil {
+        return "", err
+    } else {
+        return "map<" + kt + ":" + et + ">", nil
+    }
+}
+
+func inferSlice(vt reflect.Type) (string, error) {
+    if utils.IsByteType(vt.Elem()) {
+        return "binary", nil
+    } else if et, err := inferType(vt.Elem()); err != nil {
+        return "", err
+    } else {
+        return "list<" + et + ">", nil
+    }
+}
+
+func inferStruct(vt reflect.Type) string {
+    if vt.Name() == "" {
+        return "struct"
+    } else {
+        return vt.Name()
+    }
+}
@@ -43,6 +43,11 @@ const (
     T_enum    Tag = 0x80
     T_binary  Tag = 0x81
     T_pointer Tag = 0x82
+    T_uint    Tag = 0x83
+    T_float   Tag = 0x84
+    T_array   Tag = 0x85
+    T_fixed   Tag = 0x86
+    T_custom  Tag = 0x87
 )
 
 var wireTags = [256]bool {
@@ -90,8 +95,10 @@ func (self Tag) IsWireTag() bool {
 
 type Type struct {
     T Tag
+    W Tag
     K *Type
     V *Type
+    C *Codec
     S reflect.Type
 }
 
@@ -117,6 +124,11 @@ func (self *Type) Tag() Tag {
         case T_enum    : return T_i32
         case T_binary  : return T_string
         case T_pointer : return self.V.Tag()
+        case T_uint    : return self.W
+        case T_float   : return T_double
+        case T_array   : return T_list
+        case T_fixed   : return T_string
+        case T_custom  : return self.V.Tag()
         default        : return self.T
     }
 }
@@ -141,6 +153,11 @@ func (self *Type) String() string {
         case T_enum    : return "enum"
         case T_binary  : return "binary"
         case T_pointer : return "*" + self.V.String()
+        case T_uint    : return (&Type { T: self.W }).String()
+        case T_float   : return "double"
+        case T_array   : return fmt.Sprintf("list<%s>", self.V.String())
+        case T_fixed   : return "binary"
+        case T_custom  : return self.V.String()
         default        : return fmt.Sprintf("Type(Tag(%d))", self.T)
     }
 }
@@ -174,6 +191,8 @@ func (self *Type) IsSimpleType() bool {
         case T_i64     : return true
         case T_string  : return true
         case T_enum    : return true
+        case T_uint    : return true
+        case T_float   : return true
         default        : return false
     }
 }
@@ -256,6 +275,11 @@ func doParseType(vt reflect.Type, def string, i *int, allowPtrs bool) (*Type, er
         }
     }
 
+    /* check for custom types */
+    if cc := LookupCodec(vt); cc != nil {
+        return doParseCustom(vt, def, i, ret, cc)
+    }
+
     /* check for value kind */
     switch vt.Kind() {
         case reflect.Bool    : tag = T_bool
@@ -264,14 +288,14 @@ func doParseType(vt reflect.Type, def string, i *int, allowPtrs bool) (*Type, er
         case reflect.Int16   : tag = T_i16
         case reflect.Int32   : tag = T_i32
         case reflect.Int64   : tag = T_i64
-        case reflect.Uint    : return nil, utils.EUseOther(vt, "int")
-        case reflect.Uint8   : return nil, utils.EUseOther(vt, "int8")
-        case reflect.Uint16  : return nil, utils.EUseOther(vt, "int16")
-        case reflect.Uint32  : return nil, utils.EUseOther(vt, "int32")
-        case reflect.Uint64  : return nil, utils.EUseOther(vt, "int64")
-        case reflect.Float32 : return nil, utils.EUseOther(vt, "float64")
+        case reflect.Uint    : return doParseUint(vt, def, i, ret)
+        case reflect.Uint8   : return doParseUint(vt, def, i, ret)
+        case reflect.Uint16  : return doParseUint(vt, def, i, ret)
+        case reflect.Uint32  : return doParseUint(vt, def, i, ret)
+        case reflect.Uint64  : return doParseUint(vt, def, i, ret)
+        case reflect.Float32 : return doParseFloat(vt, def, i, ret)
         case reflect.Float64 : tag = T_double
-        case reflect.Array   : return nil, utils.EUseOther(vt, "[]" + vt.Elem().String())
+        case reflect.Array   : return doParseArray(vt, def, i, ret)
         case reflect.Map     : tag = T_map
         case reflect.Slice   : break
         case reflect.String  : tag = T_string
@@ -414,6 +438,106 @@ func doParseSlice(vt reflect.Type, et reflect.Type, def string, i *int, rt *Type
     return rt, nil
 }
 
+func doParseUint(vt reflect.Type, def string, i *int, rt *Type) (*Type, error) {
+    var err error
+    var tok string
+
+    /* unsigned integers must be explicitly mapped */
+    if def == "" {
+        return nil, utils.EExplicit(vt, `"i8", "i16", "i32" or "i64"`)
+    }
+
+    /* read the wire type */
+    if tok, err = readToken(def, i, false); err != nil {
+        return nil, err
+    }
+
+    /* the value is range-checked when converting */
+    switch tok {
+        case "i8"   : rt.W = T_i8
+        case "byte" : rt.W = T_i8
+        case "i16"  : rt.W = T_i16
+        case "i32"  : rt.W = T_i32
+        case "i64"  : rt.W = T_i64
+        default     : return nil, utils.ESyntax(*i - len(tok), def, `"i8", "i16", "i32" or "i64" expected`)
+    }
+
+    /* set the type */
+    rt.S = vt
+    rt.T = T_uint
+    return rt, nil
+}
+
+func doParseFloat(vt reflect.Type, def string, i *int, rt *Type) (*Type, error) {
+    var err error
+    var tok string
+
+    /* float32 must be explicitly widened */
+    if def == "" {
+        return nil, utils.EExplicit(vt, `"double"`)
+    }
+
+    /* read the wire type */
+    if tok, err = readToken(def, i, false); err != nil {
+        return nil, err
+    } else if tok != "double" {
+        return nil, utils.ESyntax(*i - len(tok), def, `"double" expected`)
+    }
+
+    /* set the type */
+    rt.S = vt
+    rt.T = T_float
+    return rt, nil
+}
+
+func doParseArray(vt reflect.Type, def string, i *int, rt *Type) (*Type, error) {
+    var err error
+    var tok string
+
+    /* arrays must be explicitly mapped */
+    if def == "" {
+        return nil, utils.EExplicit(vt, `"binary" or "list<...>"`)
+    }
+
+    /* byte arrays can be mapped to binaries */
+    if p := *i; utils.IsByteType(vt.Elem()) {
+        if tok, err = readToken(def, &p, false); err != nil {
+            return nil, err
+        } else if tok == "binary" {
+            *i, rt.S, rt.T = p, vt, T_fixed
+            return rt, nil
+        }
+    }
+
+    /* otherwise it must be a list */
+    if _, err = doParseSlice(vt, vt.Elem(), def, i, rt); err != nil {
+        return nil, err
+    } else if rt.T != T_list {
+        return nil, utils.EType(vt, "arrays can only be mapped to lists")
+    }
+
+    /* set the type */
+    rt.T = T_array
+    return rt, nil
+}
+
+func doParseCustom(vt reflect.Type, def string, i *int, rt *Type, cc *Codec) (*Type, error) {
+    var err error
+    var wt *Type
+
+    /* parse the wire type */
+    if wt, err = doParseType(cc.Wire, def, i, false); err != nil {
+        return nil, err
+    }
+
+    /* set the type tag */
+    rt.S = vt
+    rt.C = cc
+    rt.V = wt
+    rt.T = T_custom
+    return rt, nil
+}
+
 func doMatchStruct(vt reflect.Type, def string, i *int, tv *string) (bool, error) {
     var err error
     var tok string
@@ -37,3 +37,30 @@ func TestTypes_MapKeyType(t *testing.T) {
     require.NoError(t, err)
     fmt.Println(tt)
 }
+
+func TestTypes_ExplicitMapping(t *testing.T) {
+    var v struct {
+        A uint16
+        B float32
+        C [16]byte
+        D [4]uint32
+    }
+    tt, err := ParseType(reflect.TypeOf(v.A), "i32")
+    require.NoError(t, err)
+    require.Equal(t, T_uint, tt.T)
+    require.Equal(t, T_i32, tt.Tag())
+    tt, err = ParseType(reflect.TypeOf(v.B), "double")
+    require.NoError(t, err)
+    require.Equal(t, T_double, tt.Tag())
+    tt, err = ParseType(reflect.TypeOf(v.C), "binary")
+    require.NoError(t, err)
+    require.Equal(t, T_fixed, tt.T)
+    tt, err = ParseType(reflect.TypeOf(v.D), "list<i64>")
+    require.NoError(t, err)
+    require.Equal(t, T_array, tt.T)
+    require.Equal(t, "list<i64>", tt.String())
+    _, err = ParseType(reflect.TypeOf(v.A), "")
+    require.Error(t, err)
+    _, err = ParseType(reflect.TypeOf(v.D), "set<i64>")
+    require.Error(t, err)
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `fmt`
+    `hash/fnv`
+    `math/bits`
+    `reflect`
+    `sync`
+    `sync/atomic`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/iov`
+)
+
+/** Generated Encoders
+ *
+ *  Encoders generated ahead-of-time are registered with the fingerprint of the program they were
+ *  generated from, and only replace the JIT when the program compiled at runtime still matches.
+ */
+
+var (
+    GeneratedCount uint64 = 0
+)
+
+var (
+    generatedLoader func()
+    generatedTable  sync.Map
+)
+
+type generated struct {
+    fp uint64
+    fn Encoder
+}
+
+func SetGeneratedLoader(fn func()) {
+    generatedLoader = fn
+}
+
+func RegisterGenerated(vt *rt.GoType, fp uint64, fn Encoder) {
+    generatedTable.Store(vt, generated { fp, fn })
+}
+
+func link(vt *rt.GoType, pp Program) Encoder {
+    if fn := findGenerated(vt, pp); fn != nil {
+        atomic.AddUint64(&GeneratedCount, 1)
+        return fn
+    } else {
+        return LinkProgram(vt, pp)
+    }
+}
+
+func findGenerated(vt *rt.GoType, pp Program) Encoder {
+    if generatedLoader != nil {
+        generatedLoader()
+    }
+
+    /* the generated code must come from the same program */
+    if v, ok := generatedTable.Load(vt); !ok {
+        return nil
+    } else if gv := v.(generated); gv.fp != pp.Fingerprint() {
+        return nil
+    } else {
+        return gv.fn
+    }
+}
+
+func (self Program) Fingerprint() uint64 {
+    h := fnv.New64a()
+    p := make([]byte, 0, 64)
+
+    /* hash every instruction */
+    for _, v := range self {
+        p = p[:0]
+        p = append(p, v.Disassemble()...)
+
+        /* type sizes matter to the generated code */
+        switch v.Op {
+            case OP_size_defer  : fallthrough
+            case OP_defer       : fallthrough
+            case OP_map_begin   : fallthrough
+            case OP_union_check : fallthrough
+            case OP_unique      : fallthrough
+            case OP_uint        : p = append(p, fmt.Sprintf(" #%d", v.Vt().Size)...)
+        }
+
+        /* add to the hash */
+        p = append(p, '\n')
+        _, _ = h.Write(p)
+    }
+
+    /* all done */
+    return h.Sum64()
+}
+
+/** Runtime Support
+ *
+ *  The generated encoders have the same prototype as the JIT-compiled ones, each of the following
+ *  methods mirrors an instruction of the translator, and returns the output buffer, the output length
+ *  and the buffer capacity in the same way as the translated code.
+ */
+
+func (self *RuntimeState) Return(p unsafe.Pointer, nc int, nb int, err error) (int, error) {
+    self.Bp = p
+    self.Bn = uint64(nc)
+    return nb, err
+}
+
+func (self *RuntimeState) State(st int) *StateItem {
+    return (*StateItem)(unsafe.Pointer(uintptr(unsafe.Pointer(self)) + uintptr(st)))
+}
+
+func (self *RuntimeState) Flush(p unsafe.Pointer, nb int, need int) (unsafe.Pointer, int, int, error) {
+    return flush(self, p, nb, need)
+}
+
+func (self *RuntimeState) Memcpy(p unsafe.Pointer, nb int, nc int, src unsafe.Pointer, n int) (unsafe.Pointer, int, int, error) {
+    var err error
+
+    /* the bytes must fit in the buffer */
+    if nb + n > nc {
+        if p, nb, nc, err = flush(self, p, nb, n); err != nil {
+            return p, nb, nc, err
+        }
+    }
+
+    /* copy the bytes */
+    copy(rt.BytesFrom(unsafe.Pointer(uintptr(p) + uintptr(nb)), n, n), rt.BytesFrom(src, n, n))
+    return p, nb + n, nc, nil
+}
+
+func (self *RuntimeState) MemcpyBE(p unsafe.Pointer, nb int, nc int, mem iov.BufferWriter, vp unsafe.Pointer, off int, unit int) (unsafe.Pointer, int, int, error) {
+    var err error
+    var src = *(*unsafe.Pointer)(vp)
+    var num = *(*int)(unsafe.Pointer(uintptr(vp) + uintptr(off)))
+
+    /* empty slices have nothing to copy, and bytes may be written directly */
+    if num == 0 {
+        return p, nb, nc, nil
+    } else if unit == 1 {
+        return self.memcpy1(p, nb, nc, mem, src, num)
+    }
+
+    /* the elements must fit in the buffer */
+    if nb + num * unit > nc {
+        if p, nb, nc, err = flush(self, p, nb, num * unit); err != nil {
+            return p, nb, nc, err
+        }
+    }
+
+    /* load-swap-store sequence */
+    for i := 0; i < num; i++ {
+        dp := unsafe.Pointer(uintptr(p) + uintptr(nb))
+        sp := unsafe.Pointer(uintptr(src) + uintptr(i * unit))
+
+        /* swap according to the unit size */
+        switch unit {
+            case 2  : *(*uint16)(dp) = bits.ReverseBytes16(*(*uint16)(sp))
+            case 4  : *(*uint32)(dp) = bits.ReverseBytes32(*(*uint32)(sp))
+            case 8  : *(*uint64)(dp) = bits.ReverseBytes64(*(*uint64)(sp))
+            default : panic("can only swap 2, 4 or 8 bytes at a time")
+        }
+
+        /* move to the next unit */
+        nb += unit
+    }
+
+    /* all done */
+    return p, nb, nc, nil
+}
+
+func (self *RuntimeState) memcpy1(p unsafe.Pointer, nb int, nc int, mem iov.BufferWriter, src unsafe.Pointer, n int) (unsafe.Pointer, int, int, error) {
+    if int64(n) <= _N_page || (*rt.GoIface)(unsafe.Pointer(&mem)).Value == nil {
+        return self.Memcpy(p, nb, nc, src, n)
+    } else if err := mem.WriteDirect(rt.BytesFrom(src, n, n), nc - nb); err != nil {
+        return p, nb, nc, err
+    } else {
+        return p, nb, nc, nil
+    }
+}
+
+func (self *RuntimeState) Marshal(vt *rt.GoType, p unsafe.Pointer) (unsafe.Pointer, error) {
+    return marshal(defs.LookupCodec(vt.Pack()), p)
+}
+
+func (self *RuntimeState) Size(vt *rt.GoType, p unsafe.Pointer, st int) (int, error) {
+    return encode(vt, nil, 0, nil, p, self, st)
+}
+
+func (self *RuntimeState) Encode(vt *rt.GoType, p unsafe.Pointer, nb int, nc int, mem iov.BufferWriter, vp unsafe.Pointer, st int) (unsafe.Pointer, int, int, error) {
+    dp := unsafe.Pointer(uintptr(p) + uintptr(nb))
+    rv, err := encode(vt, dp, nc - nb, mem, vp, self, st)
+
+    /* the callee has flushed the buffer, continue with its output buffer */
+    if self.Bp != dp {
+        if err != nil {
+            return self.Bp, nb, int(self.Bn), err
+        } else {
+            return self.Bp, rv, int(self.Bn), nil
+        }
+    }
+
+    /* still in the same buffer */
+    if err != nil {
+        return p, nb, nc, err
+    } else {
+        return p, nb + rv, nc, nil
+    }
+}
+
+func (self *RuntimeState) MapBegin(st int, vt *rt.GoType, p unsafe.Pointer) {
+    mapiterstart(rt.MapType(vt), *(**rt.GoMap)(p), &self.State(st).Mi)
+}
+
+func (self *RuntimeState) MapNext(st int) {
+    mapiternext(&self.State(st).Mi)
+}
+
+func (self *RuntimeState) Unique(p unsafe.Pointer, vt *rt.GoType) error {
+    if n := (*rt.GoSlice)(p).Len; n >= 2 && self.duplicated((*rt.GoSlice)(p).Ptr, n, vt) {
+        return _E_duplicated
+    } else {
+        return nil
+    }
+}
+
+func (self *RuntimeState) duplicated(p unsafe.Pointer, n int, vt *rt.GoType) bool {
+    switch vt.Kind() {
+        case reflect.Bool    : return n > 2 || *(*uint8)(p) == *(*uint8)(unsafe.Pointer(uintptr(p) + 1))
+        case reflect.Int     : return self.duplicatedInt(p, n)
+        case reflect.Int8    : return self.duplicatedSmall(p, n, 1, RangeUint8)
+        case reflect.Int16   : return self.duplicatedSmall(p, n, 2, RangeUint16)
+        case reflect.Int32   : return unique32(p, n)
+        case reflect.Int64   : return unique64(p, n)
+        case reflect.Uint    : return self.duplicatedInt(p, n)
+        case reflect.Uint8   : return self.duplicatedSmall(p, n, 1, RangeUint8)
+        case reflect.Uint16  : return self.duplicatedSmall(p, n, 2, RangeUint16)
+        case reflect.Uint32  : return unique32(p, n)
+        case reflect.Uint64  : return unique64(p, n)
+        case reflect.Float32 : return unique32(p, n)
+        case reflect.Float64 : return unique64(p, n)
+        case reflect.Array   : return false
+        case reflect.Map     : return false
+        case reflect.Ptr     : return false
+        case reflect.Slice   : return false
+        case reflect.String  : return uniquestr(p, n)
+        case reflect.Struct  : return false
+        default              : panic("unique: invalid type: " + vt.String())
+    }
+}
+
+func (self *RuntimeState) duplicatedInt(p unsafe.Pointer, n int) bool {
+    switch defs.IntSize {
+        case 4  : return unique32(p, n)
+        case 8  : return unique64(p, n)
+        default : panic("invalid int size")
+    }
+}
+
+func (self *RuntimeState) duplicatedSmall(p unsafe.Pointer, n int, dv int, nr int) bool {
+    var v int
+    var m uint64
+
+    /* there must be duplicated elements if there are more elements than values */
+    if n > nr {
+        return true
+    }
+
+    /* clear the bitmap */
+    for i := 0; i < nr / 64; i++ {
+        self.Bm[i] = 0
+    }
+
+    /* mark every element */
+    for i := 0; i < n; i++ {
+        if dv == 1 {
+            v = int(*(*uint8)(unsafe.Pointer(uintptr(p) + uintptr(i))))
+        } else {
+            v = int(*(*uint16)(unsafe.Pointer(uintptr(p) + uintptr(i * 2))))
+        }
+
+        /* check and set the bit */
+        if m = 1 << (v % 64); self.Bm[v / 64] & m != 0 {
+            return true
+        } else {
+            self.Bm[v / 64] |= m
+        }
+    }
+
+    /* no duplicated elements */
+    return false
+}
+
+func (self *RuntimeState) RangeError(vt *rt.GoType, v uint64, nb int) error {
+    return error_range(vt, v, nb)
+}
+
+func (self *RuntimeState) UnionError(vt *rt.GoType, n int) error {
+    return error_union(vt, n)
+}
+
+func (self *RuntimeState) Push(st int, p unsafe.Pointer) (int, error) {
+    if int64(st) >= StateMax {
+        return st, _E_overflow
+    } else {
+        self.State(st).Wp = p
+        return st + int(StateSize), nil
+    }
+}
+
+func (self *RuntimeState) Pop(st int) (int, unsafe.Pointer) {
+    st -= int(StateSize)
+    wp := self.State(st).Wp
+    self.State(st).Wp = nil
+    return st, wp
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package encoder
+
+import (
+    `sync/atomic`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/internal/utils`
+    `github.com/cloudwego/frugal/iov`
+)
+
+var (
+    EmulatedCount uint64 = 0
+)
+
+func linkAsync(pc *utils.ProgramCache, vt *rt.GoType, pp Program, fn func(*rt.GoType, Program) Encoder) Encoder {
+    if linker == nil || !utils.AsyncEnabled() || findGenerated(vt, pp) != nil {
+        return fn(vt, pp)
+    }
+
+    /* compile the native code in background, and replace the emulated one when done,
+     * the type keeps running in the emulator if it failed to compile for some reason */
+    utils.Async(func() {
+        defer func() { _ = recover() }()
+        pc.Update(vt, fn(vt, pp))
+    })
+
+    /* run in the emulator before the native code is ready */
+    return emulated(link_emu(Translate(pp)))
+}
+
+func emulated(fn Encoder) Encoder {
+    return func(buf unsafe.Pointer, nb int, mem iov.BufferWriter, p unsafe.Pointer, rs *RuntimeState, st int) (int, error) {
+        atomic.AddUint64(&EmulatedCount, 1)
+        return fn(buf, nb, mem, p, rs, st)
+    }
+}
@@ -44,7 +44,7 @@ func (self Instr) Vt() *rt.GoType {
 }
 
 func (self Instr) Str() string {
-    return rt.StringFrom(self.Pr, int(self.Uv))
+    return rt.StringFrom(self.Pr, int(self.Iv))
 }
 
 func (self Instr) Byte(i int64) int8 {
@@ -65,32 +65,52 @@ func (self Instr) Quad(i int64) int64 {
 
 func (self Instr) Disassemble() string {
     switch self.Op {
-        case OP_size_check    : fallthrough
-        case OP_size_const    : fallthrough
-        case OP_size_map      : fallthrough
-        case OP_seek          : fallthrough
-        case OP_sint          : fallthrough
-        case OP_length        : return fmt.Sprintf("%-18s%d", self.Op, self.Iv)
-        case OP_size_dyn      : fallthrough
-        case OP_memcpy_be     : return fmt.Sprintf("%-18s%d, %d", self.Op, self.Uv, self.Iv)
-        case OP_size_defer    : fallthrough
-        case OP_defer         : fallthrough
-        case OP_map_begin     : fallthrough
-        case OP_unique        : return fmt.Sprintf("%-18s%s", self.Op, self.Vt())
-        case OP_byte          : return fmt.Sprintf("%-18s0x%02x", self.Op, self.Iv)
-        case OP_word          : return fmt.Sprintf("%-18s0x%04x", self.Op, self.Iv)
-        case OP_long          : return fmt.Sprintf("%-18s0x%08x", self.Op, self.Iv)
-        case OP_quad          : return fmt.Sprintf("%-18s0x%016x", self.Op, self.Iv)
-        case OP_map_if_next   : fallthrough
-        case OP_map_if_empty  : fallthrough
-        case OP_list_if_next  : fallthrough
-        case OP_list_if_empty : fallthrough
-        case OP_goto          : fallthrough
-        case OP_if_nil        : fallthrough
-        case OP_if_hasbuf     : return fmt.Sprintf("%-18sL_%d", self.Op, self.To)
-        case OP_if_eq_imm     : return fmt.Sprintf("%-18s%d:%d, L_%d", self.Op, self.Iv, self.Uv, self.To)
-        case OP_if_eq_str     : return fmt.Sprintf("%-18s%q, L_%d", self.Op, self.Str(), self.To)
-        default               : return self.Op.String()
+        case OP_size_check         : fallthrough
+        case OP_size_const         : fallthrough
+        case OP_size_map           : fallthrough
+        case OP_seek               : fallthrough
+        case OP_sint               : fallthrough
+        case OP_union_count        : fallthrough
+        case OP_memcpy             : fallthrough
+        case OP_array_begin        : fallthrough
+        case OP_length             : return fmt.Sprintf("%-20s%d", self.Op, self.Iv)
+        case OP_size_dyn           : fallthrough
+        case OP_memcpy_be          : return fmt.Sprintf("%-20s%d, %d", self.Op, self.Uv, self.Iv)
+        case OP_size_defer         : fallthrough
+        case OP_defer              : fallthrough
+        case OP_map_begin          : fallthrough
+        case OP_union_check        : fallthrough
+        case OP_unique             : return fmt.Sprintf("%-20s%s", self.Op, self.Vt())
+        case OP_custom             : return fmt.Sprintf("%-20s%s", self.Op, (*defs.Codec)(self.Pr).Type)
+        case OP_uint               : fallthrough
+        case OP_compact_uint       : fallthrough
+        case OP_compact_size_uint  : return fmt.Sprintf("%-20s%d, %s", self.Op, self.Iv, self.Vt())
+        case OP_byte               : return fmt.Sprintf("%-20s0x%02x", self.Op, self.Iv)
+        case OP_word               : return fmt.Sprintf("%-20s0x%04x", self.Op, self.Iv)
+        case OP_long               : return fmt.Sprintf("%-20s0x%08x", self.Op, self.Iv)
+        case OP_quad               : return fmt.Sprintf("%-20s0x%016x", self.Op, self.Iv)
+        case OP_map_if_next        : fallthrough
+        case OP_map_if_empty       : fallthrough
+        case OP_list_if_next       : fallthrough
+        case OP_list_if_empty      : fallthrough
+        case OP_goto               : fallthrough
+        case OP_if_nil             : fallthrough
+        case OP_if_hasbuf          : return fmt.Sprintf("%-20sL_%d", self.Op, self.To)
+        case OP_if_eq_imm          : return fmt.Sprintf("%-20s%d:%d, L_%d", self.Op, self.Iv, self.Uv, self.To)
+        case OP_if_eq_str          : return fmt.Sprintf("%-20s%q, L_%d", self.Op, self.Str(), self.To)
+        case OP_compact_field      : return fmt.Sprintf("%-20s%d, %s", self.Op, self.Iv, defs.CompactTag(self.Uv))
+        case OP_compact_list       : return fmt.Sprintf("%-20s%s, %d", self.Op, defs.CompactTag(self.Uv), self.Iv)
+        case OP_compact_map        : return fmt.Sprintf("%-20s%s, %s", self.Op, defs.CompactTag(self.Uv >> 4), defs.CompactTag(self.Uv & 0x0f))
+        case OP_compact_field_bool : fallthrough
+        case OP_compact_size_field : fallthrough
+        case OP_compact_vint       : fallthrough
+        case OP_compact_size_vint  : fallthrough
+        case OP_compact_vlen       : fallthrough
+        case OP_compact_size_vlen  : fallthrough
+        case OP_compact_size_list  : return fmt.Sprintf("%-20s%d", self.Op, self.Iv)
+        case OP_compact_defer      : fallthrough
+        case OP_compact_size_defer : return fmt.Sprintf("%-20s%s", self.Op, self.Vt())
+        default                    : return self.Op.String()
     }
 }
 
@@ -110,11 +130,22 @@ func (self *Program) i64(op OpCode, iv int64)           { self.ins(Instr { Op: o
 func (self *Program) str(op OpCode, sv string)          { self.ins(Instr { Op: op, Iv: int64(len(sv)), Pr: rt.StringPtr(sv) }) }
 func (self *Program) rtt(op OpCode, vt reflect.Type)    { self.ins(Instr { Op: op, Pr: unsafe.Pointer(rt.UnpackType(vt)) }) }
 func (self *Program) dyn(op OpCode, uv int32, iv int64) { self.ins(Instr { Op: op, Uv: uv, Iv: iv }) }
+func (self *Program) cvt(op OpCode, iv int64, vt reflect.Type) { self.ins(Instr { Op: op, Iv: iv, Pr: unsafe.Pointer(rt.UnpackType(vt)) }) }
+func (self *Program) cdc(op OpCode, cc *defs.Codec)             { self.ins(Instr { Op: op, Pr: unsafe.Pointer(cc) }) }
 
 func (self Program) Free() {
     freeProgram(self)
 }
 
+func (self Program) deferred() (ret int) {
+    for _, v := range self {
+        if v.Op == OP_defer || v.Op == OP_compact_defer {
+            ret++
+        }
+    }
+    return
+}
+
 func (self Program) Disassemble() string {
     nb  := len(self)
     tab := make([]bool, nb + 1)
@@ -145,6 +176,7 @@ func (self Program) Disassemble() string {
 }
 
 type Compiler struct {
+    n int
     o opts.Options
     t map[reflect.Type]bool
 }
@@ -167,6 +199,13 @@ func (self *Compiler) Free() {
     freeCompiler(self)
 }
 
+func (self *Compiler) enter(vt reflect.Type) {
+    if len(self.t) != 0 {
+        self.n++
+    }
+    self.t[vt] = true
+}
+
 func (self *Compiler) Apply(o opts.Options) *Compiler {
     self.o = o
     return self
@@ -182,6 +221,7 @@ func (self *Compiler) Compile(vt reflect.Type) (_ Program, err error) {
     }
 
     /* catch the exceptions, and free the type */
+    self.n = 0
     defer self.rescue(&err)
     defer vtp.Free()
 
@@ -207,3 +247,40 @@ func (self *Compiler) CompileAndFree(vt reflect.Type) (ret Program, err error) {
     self.Free()
     return
 }
+
+func (self *Compiler) CompileCompact(vt reflect.Type) (_ Program, err error) {
+    ret := newProgram()
+    vtp := (*defs.Type)(nil)
+
+    /* parse the type */
+    if vtp, err = defs.ParseType(vt, ""); err != nil {
+        return nil, err
+    }
+
+    /* catch the exceptions, and free the type */
+    self.n = 0
+    defer self.rescue(&err)
+    defer vtp.Free()
+
+    /* object measuring */
+    i := ret.pc()
+    ret.add(OP_if_hasbuf)
+    resetCompiler(self).measureCompact(&ret, 0, vtp, ret.pc())
+
+    /* object encoding */
+    j := ret.pc()
+    ret.add(OP_goto)
+    ret.pin(i)
+    resetCompiler(self).compileCompact(&ret, 0, vtp, ret.pc())
+
+    /* halt the program */
+    ret.pin(j)
+    ret.add(OP_halt)
+    return Optimize(ret), nil
+}
+
+func (self *Compiler) CompileCompactAndFree(vt reflect.Type) (ret Program, err error) {
+    ret, err = self.CompileCompact(vt)
+    self.Free()
+    return
+}
@@ -0,0 +1,431 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `math`
+
+    `github.com/cloudwego/frugal/internal/atm/abi`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func (self *Compiler) compileCompact(p *Program, sp int, vt *defs.Type, startpc int) {
+    rt := vt.S
+    tt := vt.T
+
+    /* only recurse on structs */
+    if tt != defs.T_struct {
+        self.compileCompactOne(p, sp, vt, startpc)
+        return
+    }
+
+    /* check for loops */
+    if self.t[rt] || !self.o.CanInline(sp, (p.pc() - startpc) * 2) {
+        p.rtt(OP_compact_defer, rt)
+        return
+    }
+
+    /* compile the type recursively */
+    self.enter(rt)
+    self.compileCompactOne(p, sp, vt, startpc)
+    delete(self.t, rt)
+}
+
+func (self *Compiler) compileCompactOne(p *Program, sp int, vt *defs.Type, startpc int) {
+    switch vt.T {
+        case defs.T_bool    : p.i64(OP_size_check, 1); p.add(OP_compact_bool)
+        case defs.T_i8      : p.i64(OP_size_check, 1); p.i64(OP_sint, 1)
+        case defs.T_i16     : p.i64(OP_compact_vint, 2)
+        case defs.T_i32     : p.i64(OP_compact_vint, 4)
+        case defs.T_i64     : p.i64(OP_compact_vint, 8)
+        case defs.T_enum    : p.i64(OP_compact_vint, 4)
+        case defs.T_double  : p.i64(OP_size_check, 8); p.add(OP_compact_double)
+        case defs.T_string  : p.i64(OP_compact_vlen, abi.PtrSize); p.dyn(OP_memcpy_be, abi.PtrSize, 1)
+        case defs.T_binary  : p.i64(OP_compact_vlen, abi.PtrSize); p.dyn(OP_memcpy_be, abi.PtrSize, 1)
+        case defs.T_uint    : self.compileCompactUint(p, vt)
+        case defs.T_float   : p.i64(OP_size_check, 8); p.add(OP_compact_float)
+        case defs.T_fixed   : self.compileCompactFixed(p, vt)
+        case defs.T_array   : self.compileCompactArray(p, sp, vt, startpc)
+        case defs.T_custom  : self.compileCompactCustom(p, sp, vt, startpc)
+        case defs.T_map     : self.compileCompactMap(p, sp, vt, startpc)
+        case defs.T_set     : self.compileCompactSeq(p, sp, vt, startpc, true)
+        case defs.T_list    : self.compileCompactSeq(p, sp, vt, startpc, false)
+        case defs.T_struct  : self.compileCompactStruct(p, sp, vt, startpc)
+        case defs.T_pointer : self.compileCompactPtr(p, sp, vt, startpc)
+        default             : panic("unreachable")
+    }
+}
+
+func (self *Compiler) compileCompactUint(p *Program, vt *defs.Type) {
+    if nb := int64(defs.WireSize(vt.W)); nb != 1 {
+        p.cvt(OP_compact_uint, nb, vt.S)
+    } else {
+        p.i64(OP_size_check, 1)
+        p.cvt(OP_uint, 1, vt.S)
+    }
+}
+
+func (self *Compiler) compileCompactFixed(p *Program, vt *defs.Type) {
+    nb := vt.S.Len()
+    hdr := varint(uint64(nb))
+
+    /* the length is known in advance */
+    p.i64(OP_size_check, int64(len(hdr)))
+    for _, v := range hdr {
+        p.i64(OP_byte, int64(v))
+    }
+
+    /* copy the content */
+    p.i64(OP_memcpy, int64(nb))
+}
+
+func (self *Compiler) compileCompactCustom(p *Program, sp int, vt *defs.Type, startpc int) {
+    p.tag(sp)
+    p.add(OP_make_state)
+    p.cdc(OP_custom, vt.C)
+    self.compileCompact(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactPtr(p *Program, sp int, vt *defs.Type, startpc int) {
+    i := p.pc()
+    p.tag(sp)
+    p.add(OP_if_nil)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.compileCompact(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+    p.pin(i)
+}
+
+func (self *Compiler) compileCompactMap(p *Program, sp int, vt *defs.Type, startpc int) {
+    kt := vt.K
+    et := vt.V
+
+    /* map header, also handles nil or empty maps */
+    p.tag(sp)
+    p.dyn(OP_compact_map, int32(kt.Compact() << 4 | et.Compact()), 0)
+
+    /* check for nil or empty maps */
+    i := p.pc()
+    p.add(OP_if_nil)
+    j := p.pc()
+    p.add(OP_map_if_empty)
+
+    /* encode the map */
+    p.add(OP_make_state)
+    p.rtt(OP_map_begin, vt.S)
+    k := p.pc()
+    p.add(OP_map_key)
+    self.compileCompactItem(p, sp + 1, kt, startpc)
+    p.add(OP_map_value)
+    self.compileCompactItem(p, sp + 1, et, startpc)
+    p.add(OP_map_next)
+    p.jmp(OP_map_if_next, k)
+    p.add(OP_drop_state)
+    p.pin(i)
+    p.pin(j)
+}
+
+func (self *Compiler) compileCompactSeq(p *Program, sp int, vt *defs.Type, startpc int, verifyUnique bool) {
+    et := vt.V
+
+    /* set or list header */
+    p.tag(sp)
+    p.dyn(OP_compact_list, int32(et.Compact()), abi.PtrSize)
+
+    /* check for nil slice */
+    i := p.pc()
+    p.add(OP_if_nil)
+
+    /* check for uniqueness if needed */
+    if verifyUnique {
+        p.rtt(OP_unique, et.S)
+    }
+
+    /* byte sequences can be copied directly */
+    if et.T == defs.T_i8 {
+        p.dyn(OP_memcpy_be, abi.PtrSize, 1)
+        p.pin(i)
+        return
+    }
+
+    /* other sets or lists */
+    j := p.pc()
+    p.add(OP_list_if_empty)
+    p.add(OP_make_state)
+    p.add(OP_list_begin)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.compileCompactItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+    p.pin(i)
+    p.pin(j)
+}
+
+func (self *Compiler) compileCompactArray(p *Program, sp int, vt *defs.Type, startpc int) {
+    et := vt.V
+    nb := int64(vt.S.Len())
+    hdr := compactListHeader(et.Compact(), nb)
+
+    /* list header, the length is known in advance */
+    p.tag(sp)
+    p.i64(OP_size_check, int64(len(hdr)))
+    for _, v := range hdr {
+        p.i64(OP_byte, int64(v))
+    }
+
+    /* empty arrays */
+    if nb == 0 {
+        return
+    }
+
+    /* encode every element */
+    p.add(OP_make_state)
+    p.i64(OP_array_begin, nb)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.compileCompactItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactItem(p *Program, sp int, vt *defs.Type, startpc int) {
+    tag := vt.T
+    elem := vt.V
+
+    /* special handling for pointers */
+    if tag != defs.T_pointer {
+        self.compileCompact(p, sp, vt, startpc)
+        return
+    }
+
+    /* must be pointer struct at this point */
+    if elem.T != defs.T_struct {
+        panic("fatal: non-struct pointers within container elements")
+    }
+
+    /* always add the STOP field for structs */
+    i := p.pc()
+    p.tag(sp)
+    p.add(OP_if_nil)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.compileCompact(p, sp + 1, elem, startpc)
+    p.add(OP_drop_state)
+    j := p.pc()
+    p.add(OP_goto)
+    p.pin(i)
+    p.i64(OP_size_check, 1)
+    p.i64(OP_byte, 0)
+    p.pin(j)
+}
+
+func (self *Compiler) compileCompactStruct(p *Program, sp int, vt *defs.Type, startpc int) {
+    var isu bool
+    var err error
+    var fvs []defs.Field
+
+    /* resolve the field */
+    if fvs, err = defs.ResolveFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* unions must have exactly one field set */
+    if isu {
+        self.compileUnionCheck(p, vt, fvs)
+    }
+
+    /* field IDs are delta-encoded, which requires a state slot to track the last ID */
+    p.tag(sp)
+    p.add(OP_make_state)
+    p.add(OP_compact_begin)
+
+    /* compile every field, binary fields of unions are omitted when nil */
+    for _, fv := range fvs {
+        p.i64(OP_seek, int64(fv.F))
+
+        /* compile the field */
+        if isu && fv.Type.T == defs.T_binary {
+            self.compileCompactStructIterable(p, sp + 1, fv, startpc)
+        } else {
+            self.compileCompactStructField(p, sp + 1, fv, startpc)
+        }
+
+        /* seek back to the beginning */
+        p.i64(OP_seek, -int64(fv.F))
+    }
+
+    /* add the STOP field */
+    p.i64(OP_size_check, 1)
+    p.i64(OP_byte, 0)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) compileCompactStructField(p *Program, sp int, fv defs.Field, startpc int) {
+    switch fv.Type.T {
+        default: {
+            panic("fatal: invalid field type: " + fv.Type.String())
+        }
+
+        /* non-pointer types */
+        case defs.T_bool   : fallthrough
+        case defs.T_i8     : fallthrough
+        case defs.T_double : fallthrough
+        case defs.T_i16    : fallthrough
+        case defs.T_i32    : fallthrough
+        case defs.T_i64    : fallthrough
+        case defs.T_string : fallthrough
+        case defs.T_enum   : fallthrough
+        case defs.T_uint   : fallthrough
+        case defs.T_float  : fallthrough
+        case defs.T_binary : {
+            if fv.Default.IsValid() && fv.Spec == defs.Optional {
+                self.compileCompactStructDefault(p, sp, fv, startpc)
+            } else {
+                self.compileCompactStructRequired(p, sp, fv, startpc)
+            }
+        }
+
+        /* struct, array and custom types, only available in hand-written structs */
+        case defs.T_struct : fallthrough
+        case defs.T_fixed  : fallthrough
+        case defs.T_array  : fallthrough
+        case defs.T_custom : {
+            self.compileCompactStructRequired(p, sp, fv, startpc)
+        }
+
+        /* sequencial types */
+        case defs.T_map  : fallthrough
+        case defs.T_set  : fallthrough
+        case defs.T_list : {
+            if fv.Spec == defs.Optional {
+                self.compileCompactStructIterable(p, sp, fv, startpc)
+            } else {
+                self.compileCompactStructRequired(p, sp, fv, startpc)
+            }
+        }
+
+        /* pointers */
+        case defs.T_pointer: {
+            if fv.Spec == defs.Optional {
+                self.compileCompactStructOptional(p, sp, fv, startpc)
+            } else if fv.Type.V.T == defs.T_struct {
+                self.compileCompactStructPointer(p, sp, fv, startpc)
+            } else {
+                panic("fatal: non-optional non-struct pointers")
+            }
+        }
+    }
+}
+
+func (self *Compiler) compileCompactStructDefault(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    t := fv.Type.T
+
+    /* check for default values */
+    switch t {
+        case defs.T_bool   : p.dyn(OP_if_eq_imm, 1, bool2i64(fv.Default.Bool()))
+        case defs.T_i8     : p.dyn(OP_if_eq_imm, 1, fv.Default.Int())
+        case defs.T_double : p.dyn(OP_if_eq_imm, 8, int64(math.Float64bits(fv.Default.Float())))
+        case defs.T_i16    : p.dyn(OP_if_eq_imm, 2, fv.Default.Int())
+        case defs.T_i32    : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
+        case defs.T_i64    : p.dyn(OP_if_eq_imm, 8, fv.Default.Int())
+        case defs.T_string : p.str(OP_if_eq_str, fv.Default.String())
+        case defs.T_enum   : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
+        case defs.T_binary : p.str(OP_if_eq_str, mem2str(fv.Default.Bytes()))
+        case defs.T_uint   : p.dyn(OP_if_eq_imm, int32(fv.Type.S.Size()), int64(fv.Default.Uint()))
+        case defs.T_float  : p.dyn(OP_if_eq_imm, 4, int64(math.Float32bits(float32(fv.Default.Float()))))
+        default            : panic("unreachable")
+    }
+
+    /* compile if it's not the default value */
+    self.compileCompactStructFieldBegin(p, fv, fv.Type, false)
+    self.compileCompactStructFieldValue(p, sp, fv.Type, startpc)
+    p.pin(i)
+}
+
+func (self *Compiler) compileCompactStructPointer(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    p.add(OP_if_nil)
+    self.compileCompactStructFieldBegin(p, fv, fv.Type.V, false)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.compileCompact(p, sp + 1, fv.Type.V, startpc)
+    p.add(OP_drop_state)
+    j := p.pc()
+    p.add(OP_goto)
+    p.pin(i)
+    self.compileCompactStructFieldBegin(p, fv, fv.Type.V, false)
+    p.i64(OP_size_check, 1)
+    p.i64(OP_byte, 0)
+    p.pin(j)
+}
+
+func (self *Compiler) compileCompactStructIterable(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    p.add(OP_if_nil)
+    self.compileCompactStructFieldBegin(p, fv, fv.Type, false)
+    self.compileCompact(p, sp, fv.Type, startpc)
+    p.pin(i)
+}
+
+func (self *Compiler) compileCompactStructOptional(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    p.add(OP_if_nil)
+    self.compileCompactStructFieldBegin(p, fv, fv.Type.V, true)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.compileCompactStructFieldValue(p, sp + 1, fv.Type.V, startpc)
+    p.add(OP_drop_state)
+    p.pin(i)
+}
+
+func (self *Compiler) compileCompactStructRequired(p *Program, sp int, fv defs.Field, startpc int) {
+    self.compileCompactStructFieldBegin(p, fv, fv.Type, false)
+    self.compileCompactStructFieldValue(p, sp, fv.Type, startpc)
+}
+
+func (self *Compiler) compileCompactStructFieldBegin(p *Program, fv defs.Field, vt *defs.Type, indirect bool) {
+    if vt.T != defs.T_bool {
+        p.dyn(OP_compact_field, int32(vt.Compact()), int64(fv.ID))
+    } else if indirect {
+        p.dyn(OP_compact_field_bool, 1, int64(fv.ID))
+    } else {
+        p.dyn(OP_compact_field_bool, 0, int64(fv.ID))
+    }
+}
+
+func (self *Compiler) compileCompactStructFieldValue(p *Program, sp int, vt *defs.Type, startpc int) {
+    if vt.T != defs.T_bool {
+        self.compileCompact(p, sp, vt, startpc)
+    }
+}
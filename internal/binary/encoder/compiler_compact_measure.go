@@ -0,0 +1,429 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `math`
+
+    `github.com/cloudwego/frugal/internal/atm/abi`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func compactSize(vt *defs.Type) int {
+    switch vt.T {
+        case defs.T_bool   : return 1
+        case defs.T_i8     : return 1
+        case defs.T_double : return 8
+        case defs.T_float  : return 8
+        case defs.T_uint   : if vt.W == defs.T_i8 { return 1 } else { return -1 }
+        default            : return -1
+    }
+}
+
+func (self *Compiler) measureCompact(p *Program, sp int, vt *defs.Type, startpc int) {
+    rt := vt.S
+    tt := vt.T
+
+    /* only recurse on structs */
+    if tt != defs.T_struct {
+        self.measureCompactOne(p, sp, vt, startpc)
+        return
+    }
+
+    /* check for loops with inlining depth limit */
+    if self.t[rt] || !self.o.CanInline(sp, (p.pc() - startpc) * 2) {
+        p.rtt(OP_compact_size_defer, rt)
+        return
+    }
+
+    /* measure the type recursively */
+    self.enter(rt)
+    self.measureCompactOne(p, sp, vt, startpc)
+    delete(self.t, rt)
+}
+
+func (self *Compiler) measureCompactOne(p *Program, sp int, vt *defs.Type, startpc int) {
+    switch vt.T {
+        case defs.T_bool    : p.i64(OP_size_const, 1)
+        case defs.T_i8      : p.i64(OP_size_const, 1)
+        case defs.T_i16     : p.i64(OP_compact_size_vint, 2)
+        case defs.T_i32     : p.i64(OP_compact_size_vint, 4)
+        case defs.T_i64     : p.i64(OP_compact_size_vint, 8)
+        case defs.T_enum    : p.i64(OP_compact_size_vint, 4)
+        case defs.T_double  : p.i64(OP_size_const, 8)
+        case defs.T_string  : p.i64(OP_compact_size_vlen, abi.PtrSize); p.dyn(OP_size_dyn, abi.PtrSize, 1)
+        case defs.T_binary  : p.i64(OP_compact_size_vlen, abi.PtrSize); p.dyn(OP_size_dyn, abi.PtrSize, 1)
+        case defs.T_uint    : self.measureCompactUint(p, vt)
+        case defs.T_float   : p.i64(OP_size_const, 8)
+        case defs.T_fixed   : p.i64(OP_size_const, int64(len(varint(uint64(vt.S.Len()))) + vt.S.Len()))
+        case defs.T_array   : self.measureCompactArray(p, sp, vt, startpc)
+        case defs.T_custom  : self.measureCompactCustom(p, sp, vt, startpc)
+        case defs.T_map     : self.measureCompactMap(p, sp, vt, startpc)
+        case defs.T_set     : self.measureCompactSeq(p, sp, vt, startpc)
+        case defs.T_list    : self.measureCompactSeq(p, sp, vt, startpc)
+        case defs.T_struct  : self.measureCompactStruct(p, sp, vt, startpc)
+        case defs.T_pointer : self.measureCompactPtr(p, sp, vt, startpc)
+        default             : panic("measureCompactOne: unreachable")
+    }
+}
+
+func (self *Compiler) measureCompactUint(p *Program, vt *defs.Type) {
+    if nb := int64(defs.WireSize(vt.W)); nb != 1 {
+        p.cvt(OP_compact_size_uint, nb, vt.S)
+    } else {
+        p.i64(OP_size_const, 1)
+    }
+}
+
+func (self *Compiler) measureCompactCustom(p *Program, sp int, vt *defs.Type, startpc int) {
+    p.tag(sp)
+    p.add(OP_make_state)
+    p.cdc(OP_custom, vt.C)
+    self.measureCompact(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) measureCompactPtr(p *Program, sp int, vt *defs.Type, startpc int) {
+    i := p.pc()
+    p.tag(sp)
+    p.add(OP_if_nil)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.measureCompact(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+    p.pin(i)
+}
+
+func (self *Compiler) measureCompactMap(p *Program, sp int, vt *defs.Type, startpc int) {
+    nk := compactSize(vt.K)
+    nv := compactSize(vt.V)
+
+    /* map header, also handles nil or empty maps */
+    p.tag(sp)
+    p.add(OP_compact_size_map)
+
+    /* check for nil maps */
+    i := p.pc()
+    p.add(OP_if_nil)
+
+    /* key and value are both trivially measuable */
+    if nk > 0 && nv > 0 {
+        p.i64(OP_size_map, int64(nk + nv))
+        p.pin(i)
+        return
+    }
+
+    /* key or value is trivially measuable */
+    if nk > 0 { p.i64(OP_size_map, int64(nk)) }
+    if nv > 0 { p.i64(OP_size_map, int64(nv)) }
+
+    /* complex maps */
+    j := p.pc()
+    p.add(OP_map_if_empty)
+    p.add(OP_make_state)
+    p.rtt(OP_map_begin, vt.S)
+    k := p.pc()
+
+    /* complex keys */
+    if nk <= 0 {
+        p.add(OP_map_key)
+        self.measureCompactItem(p, sp + 1, vt.K, startpc)
+    }
+
+    /* complex values */
+    if nv <= 0 {
+        p.add(OP_map_value)
+        self.measureCompactItem(p, sp + 1, vt.V, startpc)
+    }
+
+    /* move to the next state */
+    p.add(OP_map_next)
+    p.jmp(OP_map_if_next, k)
+    p.add(OP_drop_state)
+    p.pin(i)
+    p.pin(j)
+}
+
+func (self *Compiler) measureCompactSeq(p *Program, sp int, vt *defs.Type, startpc int) {
+    et := vt.V
+    nb := compactSize(et)
+
+    /* list or set header */
+    p.tag(sp)
+    p.i64(OP_compact_size_list, abi.PtrSize)
+
+    /* check for nil slice */
+    i := p.pc()
+    p.add(OP_if_nil)
+
+    /* element is trivially measuable */
+    if nb > 0 {
+        p.dyn(OP_size_dyn, abi.PtrSize, int64(nb))
+        p.pin(i)
+        return
+    }
+
+    /* complex lists or sets */
+    j := p.pc()
+    p.add(OP_list_if_empty)
+    p.add(OP_make_state)
+    p.add(OP_list_begin)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.measureCompactItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+    p.pin(i)
+    p.pin(j)
+}
+
+func (self *Compiler) measureCompactArray(p *Program, sp int, vt *defs.Type, startpc int) {
+    et := vt.V
+    nb := compactSize(et)
+    nv := int64(vt.S.Len())
+
+    /* list header */
+    p.tag(sp)
+    p.i64(OP_size_const, int64(len(compactListHeader(et.Compact(), nv))))
+
+    /* element is trivially measuable */
+    if nb > 0 || nv == 0 {
+        p.i64(OP_size_const, int64(nb) * nv)
+        return
+    }
+
+    /* complex arrays */
+    p.add(OP_make_state)
+    p.i64(OP_array_begin, nv)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.measureCompactItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) measureCompactItem(p *Program, sp int, vt *defs.Type, startpc int) {
+    tag := vt.T
+    elem := vt.V
+
+    /* special handling for pointers */
+    if tag != defs.T_pointer {
+        self.measureCompact(p, sp, vt, startpc)
+        return
+    }
+
+    /* must be pointer struct at this point */
+    if elem.T != defs.T_struct {
+        panic("fatal: non-struct pointers within container elements")
+    }
+
+    /* always add the STOP field for structs */
+    i := p.pc()
+    p.tag(sp)
+    p.add(OP_if_nil)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.measureCompact(p, sp + 1, elem, startpc)
+    p.add(OP_drop_state)
+    j := p.pc()
+    p.add(OP_goto)
+    p.pin(i)
+    p.i64(OP_size_const, 1)
+    p.pin(j)
+}
+
+func (self *Compiler) measureCompactStruct(p *Program, sp int, vt *defs.Type, startpc int) {
+    var isu bool
+    var err error
+    var fvs []defs.Field
+
+    /* resolve the field */
+    if fvs, err = defs.ResolveFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* empty structs */
+    if len(fvs) == 0 {
+        p.i64(OP_size_const, 1)
+        return
+    }
+
+    /* 1-byte stop field, and the state slot to track the last field ID */
+    p.tag(sp)
+    p.i64(OP_size_const, 1)
+    p.add(OP_make_state)
+    p.add(OP_compact_begin)
+
+    /* measure every field, binary fields of unions are omitted when nil */
+    for _, fv := range fvs {
+        p.i64(OP_seek, int64(fv.F))
+
+        /* measure the field */
+        if isu && fv.Type.T == defs.T_binary {
+            self.measureCompactStructIterable(p, sp + 1, fv, startpc)
+        } else {
+            self.measureCompactField(p, sp + 1, fv, startpc)
+        }
+
+        /* seek back to the beginning */
+        p.i64(OP_seek, -int64(fv.F))
+    }
+
+    /* restore the working pointer */
+    p.add(OP_drop_state)
+}
+
+func (self *Compiler) measureCompactField(p *Program, sp int, fv defs.Field, startpc int) {
+    switch fv.Type.T {
+        default: {
+            panic("fatal: invalid field type: " + fv.Type.String())
+        }
+
+        /* non-pointer types */
+        case defs.T_bool   : fallthrough
+        case defs.T_i8     : fallthrough
+        case defs.T_double : fallthrough
+        case defs.T_i16    : fallthrough
+        case defs.T_i32    : fallthrough
+        case defs.T_i64    : fallthrough
+        case defs.T_string : fallthrough
+        case defs.T_enum   : fallthrough
+        case defs.T_uint   : fallthrough
+        case defs.T_float  : fallthrough
+        case defs.T_binary : {
+            if fv.Default.IsValid() && fv.Spec == defs.Optional {
+                self.measureCompactStructDefault(p, sp, fv, startpc)
+            } else {
+                self.measureCompactStructRequired(p, sp, fv, startpc)
+            }
+        }
+
+        /* struct, array and custom types, only available in hand-written structs */
+        case defs.T_struct : fallthrough
+        case defs.T_fixed  : fallthrough
+        case defs.T_array  : fallthrough
+        case defs.T_custom : {
+            self.measureCompactStructRequired(p, sp, fv, startpc)
+        }
+
+        /* sequencial types */
+        case defs.T_map  : fallthrough
+        case defs.T_set  : fallthrough
+        case defs.T_list : {
+            if fv.Spec == defs.Optional {
+                self.measureCompactStructIterable(p, sp, fv, startpc)
+            } else {
+                self.measureCompactStructRequired(p, sp, fv, startpc)
+            }
+        }
+
+        /* pointers */
+        case defs.T_pointer: {
+            if fv.Spec == defs.Optional {
+                self.measureCompactStructOptional(p, sp, fv, startpc)
+            } else if fv.Type.V.T == defs.T_struct {
+                self.measureCompactStructPointer(p, sp, fv, startpc)
+            } else {
+                panic("fatal: non-optional non-struct pointers")
+            }
+        }
+    }
+}
+
+func (self *Compiler) measureCompactStructDefault(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    t := fv.Type.T
+
+    /* check for default values */
+    switch t {
+        case defs.T_bool   : p.dyn(OP_if_eq_imm, 1, bool2i64(fv.Default.Bool()))
+        case defs.T_i8     : p.dyn(OP_if_eq_imm, 1, fv.Default.Int())
+        case defs.T_double : p.dyn(OP_if_eq_imm, 8, int64(math.Float64bits(fv.Default.Float())))
+        case defs.T_i16    : p.dyn(OP_if_eq_imm, 2, fv.Default.Int())
+        case defs.T_i32    : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
+        case defs.T_i64    : p.dyn(OP_if_eq_imm, 8, fv.Default.Int())
+        case defs.T_string : p.str(OP_if_eq_str, fv.Default.String())
+        case defs.T_enum   : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
+        case defs.T_binary : p.str(OP_if_eq_str, mem2str(fv.Default.Bytes()))
+        case defs.T_uint   : p.dyn(OP_if_eq_imm, int32(fv.Type.S.Size()), int64(fv.Default.Uint()))
+        case defs.T_float  : p.dyn(OP_if_eq_imm, 4, int64(math.Float32bits(float32(fv.Default.Float()))))
+        default            : panic("unreachable")
+    }
+
+    /* measure if it's not the default value */
+    p.i64(OP_compact_size_field, int64(fv.ID))
+    self.measureCompactStructFieldValue(p, sp, fv.Type, startpc)
+    p.pin(i)
+}
+
+func (self *Compiler) measureCompactStructPointer(p *Program, sp int, fv defs.Field, startpc int) {
+    p.i64(OP_compact_size_field, int64(fv.ID))
+    i := p.pc()
+    p.add(OP_if_nil)
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.measureCompact(p, sp + 1, fv.Type.V, startpc)
+    p.add(OP_drop_state)
+    j := p.pc()
+    p.add(OP_goto)
+    p.pin(i)
+    p.i64(OP_size_const, 1)
+    p.pin(j)
+}
+
+func (self *Compiler) measureCompactStructIterable(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    p.add(OP_if_nil)
+    p.i64(OP_compact_size_field, int64(fv.ID))
+    self.measureCompact(p, sp, fv.Type, startpc)
+    p.pin(i)
+}
+
+func (self *Compiler) measureCompactStructOptional(p *Program, sp int, fv defs.Field, startpc int) {
+    i := p.pc()
+    p.add(OP_if_nil)
+    p.i64(OP_compact_size_field, int64(fv.ID))
+    p.add(OP_make_state)
+    p.add(OP_deref)
+    self.measureCompactStructFieldValue(p, sp + 1, fv.Type.V, startpc)
+    p.add(OP_drop_state)
+    p.pin(i)
+}
+
+func (self *Compiler) measureCompactStructRequired(p *Program, sp int, fv defs.Field, startpc int) {
+    p.i64(OP_compact_size_field, int64(fv.ID))
+    self.measureCompactStructFieldValue(p, sp, fv.Type, startpc)
+}
+
+func (self *Compiler) measureCompactStructFieldValue(p *Program, sp int, vt *defs.Type, startpc int) {
+    if vt.T != defs.T_bool {
+        self.measureCompact(p, sp, vt, startpc)
+    }
+}
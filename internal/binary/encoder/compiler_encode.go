@@ -40,7 +40,7 @@ func (self *Compiler) compile(p *Program, sp int, vt *defs.Type, startpc int) {
     }
 
     /* compile the type recursively */
-    self.t[rt] = true
+    self.enter(rt)
     self.compileOne(p, sp, vt, startpc)
     delete(self.t, rt)
 }
@@ -56,6 +56,11 @@ func (self *Compiler) compileOne(p *Program, sp int, vt *defs.Type, startpc int)
         case defs.T_double  : p.i64(OP_size_check, 8); p.i64(OP_sint, 8)
         case defs.T_string  : p.i64(OP_size_check, 4); p.i64(OP_length, abi.PtrSize); p.dyn(OP_memcpy_be, abi.PtrSize, 1)
         case defs.T_binary  : p.i64(OP_size_check, 4); p.i64(OP_length, abi.PtrSize); p.dyn(OP_memcpy_be, abi.PtrSize, 1)
+        case defs.T_uint    : self.compileUint(p, vt)
+        case defs.T_float   : p.i64(OP_size_check, 8); p.add(OP_float)
+        case defs.T_fixed   : p.i64(OP_size_check, 4); p.i64(OP_long, int64(vt.S.Len())); p.i64(OP_memcpy, int64(vt.S.Len()))
+        case defs.T_array   : self.compileArray(p, sp, vt, startpc)
+        case defs.T_custom  : self.compileCustom(p, sp, vt, startpc)
         case defs.T_map     : self.compileMap(p, sp, vt, startpc)
         case defs.T_set     : self.compileSeq(p, sp, vt, startpc, true)
         case defs.T_list    : self.compileSeq(p, sp, vt, startpc, false)
@@ -65,6 +70,20 @@ func (self *Compiler) compileOne(p *Program, sp int, vt *defs.Type, startpc int)
     }
 }
 
+func (self *Compiler) compileUint(p *Program, vt *defs.Type) {
+    nb := int64(defs.WireSize(vt.W))
+    p.i64(OP_size_check, nb)
+    p.cvt(OP_uint, nb, vt.S)
+}
+
+func (self *Compiler) compileCustom(p *Program, sp int, vt *defs.Type, startpc int) {
+    p.tag(sp)
+    p.add(OP_make_state)
+    p.cdc(OP_custom, vt.C)
+    self.compile(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+}
+
 func (self *Compiler) compilePtr(p *Program, sp int, vt *defs.Type, startpc int) {
     i := p.pc()
     p.tag(sp)
@@ -168,6 +187,35 @@ func (self *Compiler) compileSeq(p *Program, sp int, vt *defs.Type, startpc int,
     p.pin(j)
 }
 
+func (self *Compiler) compileArray(p *Program, sp int, vt *defs.Type, startpc int) {
+    et := vt.V
+    nb := int64(vt.S.Len())
+
+    /* 5-byte list header, the length is known in advance */
+    p.tag(sp)
+    p.i64(OP_size_check, 5)
+    p.i64(OP_byte, int64(et.Tag()))
+    p.i64(OP_long, nb)
+
+    /* empty arrays */
+    if nb == 0 {
+        return
+    }
+
+    /* encode every element */
+    p.add(OP_make_state)
+    p.i64(OP_array_begin, nb)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.compileItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+}
+
 func (self *Compiler) compileItem(p *Program, sp int, vt *defs.Type, startpc int) {
     tag := vt.T
     elem := vt.V
@@ -200,6 +248,8 @@ func (self *Compiler) compileItem(p *Program, sp int, vt *defs.Type, startpc int
 }
 
 func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type, startpc int) {
+    var uid int
+    var isu bool
     var err error
     var fvs []defs.Field
 
@@ -208,19 +258,61 @@ func (self *Compiler) compileStruct(p *Program, sp int, vt *defs.Type, startpc i
         panic(err)
     }
 
-    /* compile every field */
+    /* resolve the unknown fields, if any */
+    if uid, err = defs.ResolveUnknownFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* unions must have exactly one field set */
+    if isu {
+        self.compileUnionCheck(p, vt, fvs)
+    }
+
+    /* compile every field, binary fields of unions are omitted when nil */
     for _, fv := range fvs {
         p.tag(sp)
         p.i64(OP_seek, int64(fv.F))
-        self.compileStructField(p, sp + 1, fv, startpc)
+
+        /* compile the field */
+        if isu && fv.Type.T == defs.T_binary {
+            self.compileStructIterable(p, sp + 1, fv, startpc)
+        } else {
+            self.compileStructField(p, sp + 1, fv, startpc)
+        }
+
+        /* seek back to the beginning */
         p.i64(OP_seek, -int64(fv.F))
     }
 
+    /* write back the unknown fields verbatim */
+    if uid >= 0 {
+        p.i64(OP_seek, int64(uid))
+        p.dyn(OP_memcpy_be, abi.PtrSize, 1)
+        p.i64(OP_seek, -int64(uid))
+    }
+
     /* add the STOP field */
     p.i64(OP_size_check, 1)
     p.i64(OP_byte, 0)
 }
 
+func (self *Compiler) compileUnionCheck(p *Program, vt *defs.Type, fvs []defs.Field) {
+    p.add(OP_union_begin)
+
+    /* every member of a union is nullable */
+    for _, fv := range fvs {
+        p.i64(OP_union_count, int64(fv.F))
+    }
+
+    /* check for the number of members that are set */
+    p.rtt(OP_union_check, vt.S)
+}
+
 func (self *Compiler) compileStructField(p *Program, sp int, fv defs.Field, startpc int) {
     switch fv.Type.T {
         default: {
@@ -236,6 +328,8 @@ func (self *Compiler) compileStructField(p *Program, sp int, fv defs.Field, star
         case defs.T_i64    : fallthrough
         case defs.T_string : fallthrough
         case defs.T_enum   : fallthrough
+        case defs.T_uint   : fallthrough
+        case defs.T_float  : fallthrough
         case defs.T_binary : {
             if fv.Default.IsValid() && fv.Spec == defs.Optional {
                 self.compileStructDefault(p, sp, fv, startpc)
@@ -244,8 +338,11 @@ func (self *Compiler) compileStructField(p *Program, sp int, fv defs.Field, star
             }
         }
 
-        /* struct types, only available in hand-written structs */
-        case defs.T_struct: {
+        /* struct, array and custom types, only available in hand-written structs */
+        case defs.T_struct : fallthrough
+        case defs.T_fixed  : fallthrough
+        case defs.T_array  : fallthrough
+        case defs.T_custom : {
             self.compileStructRequired(p, sp, fv, startpc)
         }
 
@@ -288,6 +385,8 @@ func (self *Compiler) compileStructDefault(p *Program, sp int, fv defs.Field, st
         case defs.T_string : p.str(OP_if_eq_str, fv.Default.String())
         case defs.T_enum   : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
         case defs.T_binary : p.str(OP_if_eq_str, mem2str(fv.Default.Bytes()))
+        case defs.T_uint   : p.dyn(OP_if_eq_imm, int32(fv.Type.S.Size()), int64(fv.Default.Uint()))
+        case defs.T_float  : p.dyn(OP_if_eq_imm, 4, int64(math.Float32bits(float32(fv.Default.Float()))))
         default            : panic("unreachable")
     }
 
@@ -40,7 +40,7 @@ func (self *Compiler) measure(p *Program, sp int, vt *defs.Type, startpc int) {
     }
 
     /* measure the type recursively */
-    self.t[rt] = true
+    self.enter(rt)
     self.measureOne(p, sp, vt, startpc)
     delete(self.t, rt)
 }
@@ -56,6 +56,11 @@ func (self *Compiler) measureOne(p *Program, sp int, vt *defs.Type, startpc int)
         case defs.T_double  : p.i64(OP_size_const, 8)
         case defs.T_string  : p.i64(OP_size_const, 4); p.dyn(OP_size_dyn, abi.PtrSize, 1)
         case defs.T_binary  : p.i64(OP_size_const, 4); p.dyn(OP_size_dyn, abi.PtrSize, 1)
+        case defs.T_uint    : p.i64(OP_size_const, int64(defs.WireSize(vt.W)))
+        case defs.T_float   : p.i64(OP_size_const, 8)
+        case defs.T_fixed   : p.i64(OP_size_const, 4 + int64(vt.S.Len()))
+        case defs.T_array   : self.measureArray(p, sp, vt, startpc)
+        case defs.T_custom  : self.measureCustom(p, sp, vt, startpc)
         case defs.T_map     : self.measureMap(p, sp, vt, startpc)
         case defs.T_set     : self.measureSeq(p, sp, vt, startpc)
         case defs.T_list    : self.measureSeq(p, sp, vt, startpc)
@@ -65,6 +70,14 @@ func (self *Compiler) measureOne(p *Program, sp int, vt *defs.Type, startpc int)
     }
 }
 
+func (self *Compiler) measureCustom(p *Program, sp int, vt *defs.Type, startpc int) {
+    p.tag(sp)
+    p.add(OP_make_state)
+    p.cdc(OP_custom, vt.C)
+    self.measure(p, sp + 1, vt.V, startpc)
+    p.add(OP_drop_state)
+}
+
 func (self *Compiler) measurePtr(p *Program, sp int, vt *defs.Type, startpc int) {
     i := p.pc()
     p.tag(sp)
@@ -163,6 +176,35 @@ func (self *Compiler) measureSeq(p *Program, sp int, vt *defs.Type, startpc int)
     p.pin(j)
 }
 
+func (self *Compiler) measureArray(p *Program, sp int, vt *defs.Type, startpc int) {
+    et := vt.V
+    nb := defs.GetSize(et.S)
+    nv := int64(vt.S.Len())
+
+    /* 5-byte list header */
+    p.tag(sp)
+    p.i64(OP_size_const, 5)
+
+    /* element is trivially measuable */
+    if nb > 0 || nv == 0 {
+        p.i64(OP_size_const, int64(nb) * nv)
+        return
+    }
+
+    /* complex arrays */
+    p.add(OP_make_state)
+    p.i64(OP_array_begin, nv)
+    k := p.pc()
+    p.add(OP_goto)
+    r := p.pc()
+    p.i64(OP_seek, int64(et.S.Size()))
+    p.pin(k)
+    self.measureItem(p, sp + 1, et, startpc)
+    p.add(OP_list_decr)
+    p.jmp(OP_list_if_next, r)
+    p.add(OP_drop_state)
+}
+
 func (self *Compiler) measureItem(p *Program, sp int, vt *defs.Type, startpc int) {
     tag := vt.T
     elem := vt.V
@@ -194,6 +236,8 @@ func (self *Compiler) measureItem(p *Program, sp int, vt *defs.Type, startpc int
 }
 
 func (self *Compiler) measureStruct(p *Program, sp int, vt *defs.Type, startpc int) {
+    var uid int
+    var isu bool
     var err error
     var fvs []defs.Field
 
@@ -208,8 +252,18 @@ func (self *Compiler) measureStruct(p *Program, sp int, vt *defs.Type, startpc i
         panic(err)
     }
 
+    /* resolve the unknown fields, if any */
+    if uid, err = defs.ResolveUnknownFields(vt.S); err != nil {
+        panic(err)
+    }
+
+    /* check for unions */
+    if isu, err = defs.ResolveUnion(vt.S); err != nil {
+        panic(err)
+    }
+
     /* empty structs */
-    if len(fvs) == 0 {
+    if len(fvs) == 0 && uid < 0 {
         p.i64(OP_size_const, 4)
         return
     }
@@ -218,12 +272,27 @@ func (self *Compiler) measureStruct(p *Program, sp int, vt *defs.Type, startpc i
     p.tag(sp)
     p.i64(OP_size_const, 1)
 
-    /* measure every field */
+    /* measure every field, binary fields of unions are omitted when nil */
     for _, fv := range fvs {
         p.i64(OP_seek, int64(fv.F))
-        self.measureField(p, sp + 1, fv, startpc)
+
+        /* measure the field */
+        if isu && fv.Type.T == defs.T_binary {
+            self.measureStructIterable(p, sp + 1, fv, startpc)
+        } else {
+            self.measureField(p, sp + 1, fv, startpc)
+        }
+
+        /* seek back to the beginning */
         p.i64(OP_seek, -int64(fv.F))
     }
+
+    /* unknown fields are written back verbatim */
+    if uid >= 0 {
+        p.i64(OP_seek, int64(uid))
+        p.dyn(OP_size_dyn, abi.PtrSize, 1)
+        p.i64(OP_seek, -int64(uid))
+    }
 }
 
 func (self *Compiler) measureField(p *Program, sp int, fv defs.Field, startpc int) {
@@ -241,6 +310,8 @@ func (self *Compiler) measureField(p *Program, sp int, fv defs.Field, startpc in
         case defs.T_i64    : fallthrough
         case defs.T_string : fallthrough
         case defs.T_enum   : fallthrough
+        case defs.T_uint   : fallthrough
+        case defs.T_float  : fallthrough
         case defs.T_binary : {
             if fv.Default.IsValid() && fv.Spec == defs.Optional {
                 self.measureStructDefault(p, sp, fv, startpc)
@@ -249,8 +320,11 @@ func (self *Compiler) measureField(p *Program, sp int, fv defs.Field, startpc in
             }
         }
 
-        /* struct types, only available in hand-written structs */
-        case defs.T_struct: {
+        /* struct, array and custom types, only available in hand-written structs */
+        case defs.T_struct : fallthrough
+        case defs.T_fixed  : fallthrough
+        case defs.T_array  : fallthrough
+        case defs.T_custom : {
             self.measureStructRequired(p, sp, fv, startpc)
         }
 
@@ -293,6 +367,8 @@ func (self *Compiler) measureStructDefault(p *Program, sp int, fv defs.Field, st
         case defs.T_string : p.str(OP_if_eq_str, fv.Default.String())
         case defs.T_enum   : p.dyn(OP_if_eq_imm, 4, fv.Default.Int())
         case defs.T_binary : p.str(OP_if_eq_str, mem2str(fv.Default.Bytes()))
+        case defs.T_uint   : p.dyn(OP_if_eq_imm, int32(fv.Type.S.Size()), int64(fv.Default.Uint()))
+        case defs.T_float  : p.dyn(OP_if_eq_imm, 4, int64(math.Float32bits(float32(fv.Default.Float()))))
         default            : panic("unreachable")
     }
 
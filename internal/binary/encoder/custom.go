@@ -0,0 +1,34 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `reflect`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func marshal(cc *defs.Codec, p unsafe.Pointer) (unsafe.Pointer, error) {
+    w := unsafe.Pointer(reflect.New(cc.Wire).Pointer())
+    return w, cc.Marshal(p, w)
+}
+
+var (
+    F_marshal = hir.RegisterGCall(marshal, emu_gcall_marshal)
+)
@@ -18,7 +18,10 @@ package encoder
 
 import (
     `fmt`
+    `reflect`
+    `runtime`
     `sync/atomic`
+    `time`
     `unsafe`
 
     `github.com/cloudwego/frugal/internal/opts`
@@ -37,36 +40,66 @@ type Encoder func (
 ) (int, error)
 
 var (
-    HitCount  uint64 = 0
-    MissCount uint64 = 0
-    TypeCount uint64 = 0
+    HitCount    uint64 = 0
+    MissCount   uint64 = 0
+    TypeCount   uint64 = 0
+    CachedCount uint64 = 0
+)
+
+var (
+    TypeStats utils.StatsTable
 )
 
 var (
     programCache = utils.CreateProgramCache()
+    compactCache = utils.CreateProgramCache()
 )
 
 func encode(vt *rt.GoType, buf unsafe.Pointer, len int, mem iov.BufferWriter, p unsafe.Pointer, rs *RuntimeState, st int) (int, error) {
     if enc, err := resolve(vt); err != nil {
         return -1, err
     } else {
-        return enc(buf, len, mem, p, rs, st)
+        rv, ex := enc(buf, len, mem, p, rs, st)
+
+        /* the machine code is unloaded once the encoder becomes unreachable, keep it until it returns */
+        runtime.KeepAlive(enc)
+        return rv, ex
+    }
+}
+
+func encode_compact(vt *rt.GoType, buf unsafe.Pointer, len int, mem iov.BufferWriter, p unsafe.Pointer, rs *RuntimeState, st int) (int, error) {
+    if enc, err := resolveCompact(vt); err != nil {
+        return -1, err
+    } else {
+        rv, ex := enc(buf, len, mem, p, rs, st)
+
+        /* the machine code is unloaded once the encoder becomes unreachable, keep it until it returns */
+        runtime.KeepAlive(enc)
+        return rv, ex
     }
 }
 
 func resolve(vt *rt.GoType) (Encoder, error) {
+    return resolveWith(programCache, vt, compile)
+}
+
+func resolveCompact(vt *rt.GoType) (Encoder, error) {
+    return resolveWith(compactCache, vt, compileCompact)
+}
+
+func resolveWith(pc *utils.ProgramCache, vt *rt.GoType, fn func(*rt.GoType) (interface{}, error)) (Encoder, error) {
     var err error
     var val interface{}
 
     /* fast-path: type is cached */
-    if val = programCache.Get(vt); val != nil {
+    if val = pc.Get(vt); val != nil {
         atomic.AddUint64(&HitCount, 1)
         return val.(Encoder), nil
     }
 
     /* record the cache miss, and compile the type */
     atomic.AddUint64(&MissCount, 1)
-    val, err = programCache.Compute(vt, compile)
+    val, err = pc.Compute(vt, fn)
 
     /* check for errors */
     if err != nil {
@@ -78,24 +111,69 @@ func resolve(vt *rt.GoType) (Encoder, error) {
     return val.(Encoder), nil
 }
 
+func Unload(vt *rt.GoType) (ret bool) {
+    pt := rt.UnpackType(reflect.PtrTo(vt.Pack()))
+    pc := []*utils.ProgramCache { programCache, compactCache }
+
+    /* values are encoded with the type of the interface, which might also be a pointer */
+    for _, t := range []*rt.GoType { vt, pt } {
+        for _, c := range pc {
+            if c.Remove(t) {
+                ret = true
+                atomic.AddUint64(&TypeCount, ^uint64(0))
+            }
+        }
+    }
+
+    /* the statistics are shared between the type and the pointer type */
+    TypeStats.Remove(vt)
+    return
+}
+
 func compile(vt *rt.GoType) (interface{}, error) {
-    if pp, err := CreateCompiler().CompileAndFree(vt.Pack()); err != nil {
+    if pp, err := frontend(vt, opts.GetDefaultOptions(), (*Compiler).Compile); err != nil {
         return nil, err
     } else {
-        return Link(Translate(pp)), nil
+        return linkAsync(programCache, vt, pp, link), nil
+    }
+}
+
+func compileCompact(vt *rt.GoType) (interface{}, error) {
+    if pp, err := frontend(vt, opts.GetDefaultOptions(), (*Compiler).CompileCompact); err != nil {
+        return nil, err
+    } else {
+        return linkAsync(compactCache, vt, pp, LinkProgram), nil
     }
 }
 
 func mkcompile(opts opts.Options) func(*rt.GoType) (interface{}, error) {
     return func(vt *rt.GoType) (interface{}, error) {
-        if pp, err := CreateCompiler().Apply(opts).CompileAndFree(vt.Pack()); err != nil {
+        if pp, err := frontend(vt, opts, (*Compiler).Compile); err != nil {
             return nil, err
         } else {
-            return Link(Translate(pp)), nil
+            return link(vt, pp), nil
         }
     }
 }
 
+func frontend(vt *rt.GoType, o opts.Options, fn func(*Compiler, reflect.Type) (Program, error)) (Program, error) {
+    ts := time.Now()
+    cc := CreateCompiler().Apply(o)
+    pp, err := fn(cc, vt.Pack())
+
+    /* record the compilation statistics */
+    if err == nil {
+        st := TypeStats.Of(vt)
+        st.Since(&st.Frontend, ts)
+        st.Store(&st.Inlined, cc.n)
+        st.Store(&st.Deferred, pp.deferred())
+    }
+
+    /* release the compiler */
+    cc.Free()
+    return pp, err
+}
+
 func Pretouch(vt *rt.GoType, opts opts.Options) error {
     if programCache.Get(vt) != nil {
         return nil
@@ -116,18 +194,42 @@ func EncodedSize(val interface{}) int {
 }
 
 func EncodeObject(buf []byte, mem iov.BufferWriter, val interface{}) (ret int, err error) {
+    return encodeWith(encode, buf, mem, val)
+}
+
+func CompactEncodedSize(val interface{}) int {
+    if ret, err := EncodeCompact(nil, nil, val); err != nil {
+        panic(fmt.Errorf("frugal: cannot measure encoded size: %w", err))
+    } else {
+        return ret
+    }
+}
+
+func EncodeCompact(buf []byte, mem iov.BufferWriter, val interface{}) (ret int, err error) {
+    return encodeWith(encode_compact, buf, mem, val)
+}
+
+func encodeWith(fn func(*rt.GoType, unsafe.Pointer, int, iov.BufferWriter, unsafe.Pointer, *RuntimeState, int) (int, error), buf []byte, mem iov.BufferWriter, val interface{}) (ret int, err error) {
     rst := newRuntimeState()
     efv := rt.UnpackEface(val)
     out := (*rt.GoSlice)(unsafe.Pointer(&buf))
 
     /* check for indirect types */
     if efv.Type.IsIndirect() {
-        ret, err = encode(efv.Type, out.Ptr, out.Len, mem, efv.Value, rst, 0)
+        ret, err = fn(efv.Type, out.Ptr, out.Len, mem, efv.Value, rst, 0)
     } else {
-        ret, err = encode(efv.Type, out.Ptr, out.Len, mem, rt.NoEscape(unsafe.Pointer(&efv.Value)), rst, 0)
+        rst.Pv = efv.Value
+        ret, err = fn(efv.Type, out.Ptr, out.Len, mem, unsafe.Pointer(&rst.Pv), rst, 0)
+    }
+
+    /* sample the call if it actually encoded something */
+    if err == nil && buf != nil {
+        TypeStats.Sample(efv.Type, ret)
     }
 
     /* return the state into pool */
+    rst.Bp = nil
+    rst.Pv = nil
     freeRuntimeState(rst)
     return
 }
@@ -122,3 +122,36 @@ func TestEncoder_ContainerPointerElement(t *testing.T) {
         0x00,                                                   // end
     }, buf[:nx])
 }
+
+type CompactTestStruct struct {
+    A bool             `frugal:"1,default,bool"`
+    B int32            `frugal:"2,default,i32"`
+    C []int64          `frugal:"3,default,list<i64>"`
+    D map[string]int16 `frugal:"100,default,map<string:i16>"`
+    E *bool            `frugal:"101,optional,bool"`
+}
+
+func TestEncoder_Compact(t *testing.T) {
+    v := CompactTestStruct {
+        A: true,
+        B: -2,
+        C: []int64{1, -1},
+        D: map[string]int16{"a": 3},
+        E: &(&struct{ x bool }{false}).x,
+    }
+    nb := CompactEncodedSize(v)
+    buf := make([]byte, nb)
+    ret, err := EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, nb, ret)
+    spew.Dump(buf[:ret])
+    require.Equal(t, []byte{
+        0x11,                                                   // field 1: bool, true
+        0x15, 0x03,                                             // field 2: i32, -2
+        0x19, 0x26, 0x02, 0x01,                                 // field 3: list<i64>, len = 2, [1, -1]
+        0x0b, 0xc8, 0x01, 0x01, 0x84,                           // field 100: map<string, i16>, len = 1
+        0x01, 'a', 0x06,                                        //     "a" = 3
+        0x12,                                                   // field 101: bool, false
+        0x00,                                                   // end
+    }, buf[:ret])
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `fmt`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+//go:nosplit
+func error_union(t *rt.GoType, n int) error {
+    return fmt.Errorf("frugal: union %s must have exactly one field set, got %d", t, n)
+}
+
+//go:nosplit
+func error_range(t *rt.GoType, v uint64, nb int) error {
+    return fmt.Errorf("frugal: value %d of type %s overflows i%d", v, t, nb * 8)
+}
+
+var (
+    F_error_union = hir.RegisterGCall(error_union, emu_gcall_error_union)
+    F_error_range = hir.RegisterGCall(error_range, emu_gcall_error_range)
+)
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+func emu_gcall_error_union(ctx hir.CallContext) {
+    if !ctx.Verify("*i", "**") {
+        panic("invalid error_union call")
+    } else {
+        err := error_union((*rt.GoType)(ctx.Ap(0)), int(ctx.Au(1)))
+        ctx.Rp(0, unsafe.Pointer((*rt.GoIface)(unsafe.Pointer(&err)).Itab))
+        ctx.Rp(1, (*rt.GoIface)(unsafe.Pointer(&err)).Value)
+    }
+}
+
+func emu_gcall_error_range(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid error_range call")
+    } else {
+        err := error_range((*rt.GoType)(ctx.Ap(0)), ctx.Au(1), int(ctx.Au(2)))
+        ctx.Rp(0, unsafe.Pointer((*rt.GoIface)(unsafe.Pointer(&err)).Itab))
+        ctx.Rp(1, (*rt.GoIface)(unsafe.Pointer(&err)).Value)
+    }
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `encoding/binary`
+    `fmt`
+    `math`
+    `sort`
+
+    `github.com/cloudwego/frugal/generic`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* Generic values are measured (and validated) first, then written into the buffer
+ * without any further checks, the nesting limit is the same as the decoders. */
+
+func error_generic(t generic.Type, v generic.Value) error {
+    if v == nil {
+        return fmt.Errorf("frugal: nil value where %s is expected", t)
+    } else {
+        return fmt.Errorf("frugal: value of type %s where %s is expected", v.Type(), t)
+    }
+}
+
+// GenericEncodedSize measures the encoded size of a generic struct.
+func GenericEncodedSize(v generic.Struct) int {
+    if ret, err := EncodeGeneric(nil, v); err != nil {
+        panic(fmt.Errorf("frugal: cannot measure encoded size: %w", err))
+    } else {
+        return ret
+    }
+}
+
+// EncodeGeneric serializes a generic struct into buf, or measures the encoded size if buf is nil.
+func EncodeGeneric(buf []byte, v generic.Struct) (int, error) {
+    nb, err := sizeOf(generic.TypeStruct, v, 0)
+
+    /* check for errors and buffer size */
+    if err != nil {
+        return 0, err
+    } else if buf == nil {
+        return nb, nil
+    } else if len(buf) < nb {
+        return 0, _E_nomem
+    } else {
+        return writeValue(buf, v), nil
+    }
+}
+
+func sizeOf(t generic.Type, v generic.Value, depth int) (int, error) {
+    if depth >= defs.StackSize {
+        return 0, _E_overflow
+    } else if v == nil || v.Type() != t {
+        return 0, error_generic(t, v)
+    }
+
+    /* check for the value type */
+    switch vv := v.(type) {
+        case generic.Bool   : return 1, nil
+        case generic.I8     : return 1, nil
+        case generic.I16    : return 2, nil
+        case generic.I32    : return 4, nil
+        case generic.I64    : return 8, nil
+        case generic.Double : return 8, nil
+        case generic.Binary : return sizeOfBinary(vv)
+        case generic.Struct : return sizeOfStruct(vv, depth)
+        case generic.Map    : return sizeOfMap(vv, depth)
+        case generic.Set    : return sizeOfValues(vv.Elem, vv.Values, depth)
+        case generic.List   : return sizeOfValues(vv.Elem, vv.Values, depth)
+        default             : return 0, fmt.Errorf("frugal: unsupported generic value type %T", v)
+    }
+}
+
+func sizeOfBinary(v generic.Binary) (int, error) {
+    if len(v) > math.MaxInt32 {
+        return 0, fmt.Errorf("frugal: binary too long: %d bytes", len(v))
+    } else {
+        return len(v) + 4, nil
+    }
+}
+
+func sizeOfStruct(v generic.Struct, depth int) (int, error) {
+    ret := 1
+
+    /* measure every field */
+    for id, fv := range v.Fields {
+        if fv == nil {
+            return 0, fmt.Errorf("frugal: nil value of field %d", id)
+        } else if nb, err := sizeOf(fv.Type(), fv, depth + 1); err != nil {
+            return 0, err
+        } else {
+            ret += nb + 3
+        }
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func sizeOfValues(t generic.Type, vv []generic.Value, depth int) (int, error) {
+    ret := 5
+
+    /* check for element type */
+    if !t.Valid() {
+        return 0, fmt.Errorf("frugal: invalid element type %s", t)
+    }
+
+    /* measure every element */
+    for _, v := range vv {
+        if nb, err := sizeOf(t, v, depth + 1); err != nil {
+            return 0, err
+        } else {
+            ret += nb
+        }
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func sizeOfMap(v generic.Map, depth int) (int, error) {
+    ret := 6
+
+    /* check for key and value types */
+    if !v.Key.Valid() || !v.Elem.Valid() {
+        return 0, fmt.Errorf("frugal: invalid map key or value type %s, %s", v.Key, v.Elem)
+    }
+
+    /* measure every key-value pair */
+    for _, p := range v.Pairs {
+        if nk, err := sizeOf(v.Key, p.Key, depth + 1); err != nil {
+            return 0, err
+        } else if nv, err := sizeOf(v.Elem, p.Value, depth + 1); err != nil {
+            return 0, err
+        } else {
+            ret += nk + nv
+        }
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func writeValue(buf []byte, v generic.Value) int {
+    switch vv := v.(type) {
+        case generic.Bool   : return writeBool(buf, vv)
+        case generic.I8     : buf[0] = byte(vv); return 1
+        case generic.I16    : binary.BigEndian.PutUint16(buf, uint16(vv)); return 2
+        case generic.I32    : binary.BigEndian.PutUint32(buf, uint32(vv)); return 4
+        case generic.I64    : binary.BigEndian.PutUint64(buf, uint64(vv)); return 8
+        case generic.Double : binary.BigEndian.PutUint64(buf, math.Float64bits(float64(vv))); return 8
+        case generic.Binary : binary.BigEndian.PutUint32(buf, uint32(len(vv))); return copy(buf[4:], vv) + 4
+        case generic.Struct : return writeStruct(buf, vv)
+        case generic.Map    : return writeMap(buf, vv)
+        case generic.Set    : return writeValues(buf, vv.Elem, vv.Values)
+        case generic.List   : return writeValues(buf, vv.Elem, vv.Values)
+        default             : panic("unreachable")
+    }
+}
+
+func writeBool(buf []byte, v generic.Bool) int {
+    if buf[0] = 0; v {
+        buf[0] = 1
+    }
+    return 1
+}
+
+func writeStruct(buf []byte, v generic.Struct) int {
+    i := 0
+    id := make([]int, 0, len(v.Fields))
+
+    /* fields are written in the order of IDs */
+    for k := range v.Fields {
+        id = append(id, int(k))
+    }
+
+    /* write every field */
+    sort.Ints(id)
+    for _, k := range id {
+        fv := v.Fields[int16(k)]
+        buf[i] = byte(fv.Type())
+        binary.BigEndian.PutUint16(buf[i + 1:], uint16(k))
+        i += writeValue(buf[i + 3:], fv) + 3
+    }
+
+    /* add the STOP field */
+    buf[i] = 0
+    return i + 1
+}
+
+func writeValues(buf []byte, t generic.Type, vv []generic.Value) int {
+    i := 5
+    buf[0] = byte(t)
+    binary.BigEndian.PutUint32(buf[1:], uint32(len(vv)))
+
+    /* write every element */
+    for _, v := range vv {
+        i += writeValue(buf[i:], v)
+    }
+
+    /* all done */
+    return i
+}
+
+func writeMap(buf []byte, v generic.Map) int {
+    i := 6
+    buf[0], buf[1] = byte(v.Key), byte(v.Elem)
+    binary.BigEndian.PutUint32(buf[2:], uint32(len(v.Pairs)))
+
+    /* write every key-value pair */
+    for _, p := range v.Pairs {
+        i += writeValue(buf[i:], p.Key)
+        i += writeValue(buf[i:], p.Value)
+    }
+
+    /* all done */
+    return i
+}
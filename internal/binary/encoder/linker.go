@@ -17,28 +17,75 @@
 package encoder
 
 import (
+    `time`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/cache`
+    `github.com/cloudwego/frugal/internal/atm/dump`
     `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
     `github.com/cloudwego/frugal/internal/utils`
 )
 
 type Linker interface {
-    Link(p hir.Program) Encoder
+    Link(vt *rt.GoType, p hir.Program) Encoder
+    LinkCached(vt *rt.GoType, pp Program) Encoder
 }
 
 var (
-    linker   Linker
-    F_encode *hir.CallHandle
+    linker           Linker
+    F_encode         *hir.CallHandle
+    F_encode_compact *hir.CallHandle
 )
 
 func init() {
     F_encode = hir.RegisterGCall(encode, emu_gcall_encode)
+    F_encode_compact = hir.RegisterGCall(encode_compact, emu_gcall_encode_compact)
 }
 
-func Link(p hir.Program) Encoder {
+func Link(vt *rt.GoType, p hir.Program) Encoder {
     if linker == nil || utils.ForceEmulator {
         return link_emu(p)
     } else {
-        return linker.Link(p)
+        return linker.Link(vt, p)
+    }
+}
+
+func LinkProgram(vt *rt.GoType, pp Program) (fn Encoder) {
+    if linker == nil || utils.ForceEmulator || !cache.Enabled() {
+        st := TypeStats.Of(vt)
+        ts := time.Now()
+        p := Translate(pp)
+
+        /* the code generation is timed by the linker */
+        st.Since(&st.Translate, ts)
+        fn = Link(vt, p)
+    } else {
+        fn = linker.LinkCached(vt, pp)
+    }
+
+    /* dump the compilation pipeline if needed */
+    if dump.Enabled(vt) {
+        dumpProgram(vt, pp, fn)
+    }
+
+    /* all done */
+    return
+}
+
+func dumpProgram(vt *rt.GoType, pp Program, fn Encoder) {
+    p := Translate(pp)
+    d := dump.Open("encoder", vt, pp.Fingerprint())
+
+    /* the opcode program, the HIR and the SSA form */
+    d.Write("program.txt", pp.Disassemble())
+    d.Write("hir.txt", p.Disassemble())
+    st := TypeStats.Of(vt)
+    st.Add(&st.SSA, d.SSA(p, (Encoder)(nil)))
+
+    /* the machine code is only available with the native backend */
+    if linker != nil && !utils.ForceEmulator {
+        d.Code(*(*unsafe.Pointer)(unsafe.Pointer(&fn)))
     }
 }
 
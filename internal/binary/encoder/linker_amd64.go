@@ -17,11 +17,15 @@
 package encoder
 
 import (
+    `sync/atomic`
+    `time`
     `unsafe`
 
+    `github.com/cloudwego/frugal/internal/atm/cache`
     `github.com/cloudwego/frugal/internal/atm/hir`
     `github.com/cloudwego/frugal/internal/atm/pgen`
     `github.com/cloudwego/frugal/internal/loader`
+    `github.com/cloudwego/frugal/internal/rt`
 )
 
 type (
@@ -32,8 +36,54 @@ func init() {
     SetLinker(new(LinkerAMD64))
 }
 
-func (LinkerAMD64) Link(p hir.Program) Encoder {
+func (LinkerAMD64) Link(vt *rt.GoType, p hir.Program) Encoder {
+    ts := time.Now()
     fn := pgen.CreateCodeGen((Encoder)(nil)).Generate(p, 0)
-    fp := loader.Loader(fn.Code).Load("encoder", fn.Frame)
+    fp := loader.Loader(fn.Code).LoadCollectable("encoder_" + vt.String(), fn.Frame)
+    st := TypeStats.Of(vt)
+
+    /* record the statistics */
+    st.Since(&st.Codegen, ts)
+    st.Store(&st.CodeSize, len(loader.CodeOf(fp)))
     return *(*Encoder)(unsafe.Pointer(&fp))
-}
\ No newline at end of file
+}
+
+func (LinkerAMD64) LinkCached(vt *rt.GoType, pp Program) Encoder {
+    td := time.Duration(0)
+    ts := time.Now()
+
+    /* the program is only translated if the cache missed */
+    fp, ok := cache.Link("encoder", vt, pp.Fingerprint(), pp.symbols(), func() *pgen.Func {
+        p := Translate(pp)
+        td = time.Since(ts)
+        return pgen.CreateCodeGen((Encoder)(nil)).Relocatable().Generate(p, 0)
+    })
+
+    /* record the statistics */
+    st := TypeStats.Of(vt)
+    atomic.AddInt64(&st.Translate, int64(td))
+    atomic.AddInt64(&st.Codegen, int64(time.Since(ts) - td))
+    st.Store(&st.CodeSize, len(loader.CodeOf(fp)))
+
+    /* loaded from the cache */
+    if ok {
+        atomic.AddUint64(&CachedCount, 1)
+    }
+
+    /* convert to the function */
+    return *(*Encoder)(unsafe.Pointer(&fp))
+}
+
+func (self Program) symbols() *cache.Symbols {
+    ret := new(cache.Symbols)
+    ret.Add(unsafe.Pointer(&_E_overflow))
+    ret.Add(unsafe.Pointer(&_E_duplicated))
+
+    /* types, codecs and strings referenced by the instructions */
+    for _, v := range self {
+        ret.Add(v.Pr)
+    }
+
+    /* all done */
+    return ret
+}
@@ -33,10 +33,14 @@ const (
     OP_long
     OP_quad
     OP_sint
+    OP_uint
+    OP_float
     OP_length
+    OP_memcpy
     OP_memcpy_be
     OP_seek
     OP_deref
+    OP_custom
     OP_defer
     OP_map_len
     OP_map_key
@@ -47,6 +51,7 @@ const (
     OP_map_if_empty
     OP_list_decr
     OP_list_begin
+    OP_array_begin
     OP_list_if_next
     OP_list_if_empty
     OP_unique
@@ -55,47 +60,96 @@ const (
     OP_if_hasbuf
     OP_if_eq_imm
     OP_if_eq_str
+    OP_union_begin
+    OP_union_count
+    OP_union_check
     OP_make_state
     OP_drop_state
+    OP_compact_field
+    OP_compact_field_bool
+    OP_compact_size_field
+    OP_compact_begin
+    OP_compact_bool
+    OP_compact_double
+    OP_compact_float
+    OP_compact_vint
+    OP_compact_size_vint
+    OP_compact_uint
+    OP_compact_size_uint
+    OP_compact_vlen
+    OP_compact_size_vlen
+    OP_compact_list
+    OP_compact_size_list
+    OP_compact_map
+    OP_compact_size_map
+    OP_compact_defer
+    OP_compact_size_defer
     OP_halt
 )
 
 var _OpNames = [256]string {
-    OP_size_check    : "size_check",
-    OP_size_const    : "size_const",
-    OP_size_dyn      : "size_dyn",
-    OP_size_map      : "size_map",
-    OP_size_defer    : "size_defer",
-    OP_byte          : "byte",
-    OP_word          : "word",
-    OP_long          : "long",
-    OP_quad          : "quad",
-    OP_sint          : "sint",
-    OP_length        : "length",
-    OP_memcpy_be     : "memcpy_be",
-    OP_seek          : "seek",
-    OP_deref         : "deref",
-    OP_defer         : "defer",
-    OP_map_len       : "map_len",
-    OP_map_key       : "map_key",
-    OP_map_next      : "map_next",
-    OP_map_value     : "map_value",
-    OP_map_begin     : "map_begin",
-    OP_map_if_next   : "map_if_next",
-    OP_map_if_empty  : "map_if_empty",
-    OP_list_decr     : "list_decr",
-    OP_list_begin    : "list_begin",
-    OP_list_if_next  : "list_if_next",
-    OP_list_if_empty : "list_if_empty",
-    OP_unique        : "unique",
-    OP_goto          : "goto",
-    OP_if_nil        : "if_nil",
-    OP_if_hasbuf     : "if_hasbuf",
-    OP_if_eq_imm     : "if_eq_imm",
-    OP_if_eq_str     : "if_eq_str",
-    OP_make_state    : "make_state",
-    OP_drop_state    : "drop_state",
-    OP_halt          : "halt",
+    OP_size_check         : "size_check",
+    OP_size_const         : "size_const",
+    OP_size_dyn           : "size_dyn",
+    OP_size_map           : "size_map",
+    OP_size_defer         : "size_defer",
+    OP_byte               : "byte",
+    OP_word               : "word",
+    OP_long               : "long",
+    OP_quad               : "quad",
+    OP_sint               : "sint",
+    OP_uint               : "uint",
+    OP_float              : "float",
+    OP_length             : "length",
+    OP_memcpy             : "memcpy",
+    OP_memcpy_be          : "memcpy_be",
+    OP_seek               : "seek",
+    OP_deref              : "deref",
+    OP_custom             : "custom",
+    OP_defer              : "defer",
+    OP_map_len            : "map_len",
+    OP_map_key            : "map_key",
+    OP_map_next           : "map_next",
+    OP_map_value          : "map_value",
+    OP_map_begin          : "map_begin",
+    OP_map_if_next        : "map_if_next",
+    OP_map_if_empty       : "map_if_empty",
+    OP_list_decr          : "list_decr",
+    OP_list_begin         : "list_begin",
+    OP_array_begin        : "array_begin",
+    OP_list_if_next       : "list_if_next",
+    OP_list_if_empty      : "list_if_empty",
+    OP_unique             : "unique",
+    OP_goto               : "goto",
+    OP_if_nil             : "if_nil",
+    OP_if_hasbuf          : "if_hasbuf",
+    OP_if_eq_imm          : "if_eq_imm",
+    OP_if_eq_str          : "if_eq_str",
+    OP_union_begin        : "union_begin",
+    OP_union_count        : "union_count",
+    OP_union_check        : "union_check",
+    OP_make_state         : "make_state",
+    OP_drop_state         : "drop_state",
+    OP_compact_field      : "compact_field",
+    OP_compact_field_bool : "compact_field_bool",
+    OP_compact_size_field : "compact_size_field",
+    OP_compact_begin      : "compact_begin",
+    OP_compact_bool       : "compact_bool",
+    OP_compact_double     : "compact_double",
+    OP_compact_float      : "compact_float",
+    OP_compact_vint       : "compact_vint",
+    OP_compact_size_vint  : "compact_size_vint",
+    OP_compact_uint       : "compact_uint",
+    OP_compact_size_uint  : "compact_size_uint",
+    OP_compact_vlen       : "compact_vlen",
+    OP_compact_size_vlen  : "compact_size_vlen",
+    OP_compact_list       : "compact_list",
+    OP_compact_size_list  : "compact_size_list",
+    OP_compact_map        : "compact_map",
+    OP_compact_size_map   : "compact_size_map",
+    OP_compact_defer      : "compact_defer",
+    OP_compact_size_defer : "compact_size_defer",
+    OP_halt               : "halt",
 }
 
 var _OpBranches = [256]bool {
@@ -360,10 +360,11 @@ func _PASS_SizeCheckMerging(bb *BasicBlock) {
                     case OP_long       : break
                     case OP_quad       : break
                     case OP_sint       : break
+                    case OP_uint       : break
+                    case OP_float      : break
                     case OP_seek       : break
                     case OP_deref      : break
                     case OP_length     : break
-                    case OP_memcpy_be  : break
                     case OP_size_check : p.Iv += bb.P[j].Iv; bb.P[j].Op = _NOP
                     default            : r = false
                 }
@@ -26,9 +26,12 @@ import (
 
 const (
     LnOffset = int64(unsafe.Offsetof(StateItem{}.Ln))
+    FiOffset = int64(unsafe.Offsetof(StateItem{}.Fi))
     MiOffset = int64(unsafe.Offsetof(StateItem{}.Mi))
     WpOffset = int64(unsafe.Offsetof(StateItem{}.Wp))
     BmOffset = int64(unsafe.Offsetof(RuntimeState{}.Bm))
+    BpOffset = int64(unsafe.Offsetof(RuntimeState{}.Bp))
+    BnOffset = int64(unsafe.Offsetof(RuntimeState{}.Bn))
 )
 
 const (
@@ -48,6 +51,7 @@ const (
 
 type StateItem struct {
     Ln uintptr
+    Fi int64        // Last field ID, used by the Compact Protocol.
     Wp unsafe.Pointer
     Mi rt.GoMapIterator
 }
@@ -55,4 +59,8 @@ type StateItem struct {
 type RuntimeState struct {
     St [defs.StackSize]StateItem    // Must be the first field.
     Bm [1024]uint64                 // Bitmap, used for uniqueness check of set<i8> and set<i16>.
+    Bp unsafe.Pointer               // Output buffer of the last returned encoder, may move to a new chunk when streaming.
+    Bn uint64                       // Capacity of the output buffer.
+    Wr *Stream                      // Streaming output, nil if encoding into a contiguous buffer.
+    Pv unsafe.Pointer               // Boxed value of direct types, keeps the value pointer out of the stack.
 }
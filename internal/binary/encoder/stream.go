@@ -0,0 +1,163 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package encoder
+
+import (
+    `io`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/iov`
+)
+
+const (
+    _StreamChunkSize = 65536
+)
+
+var (
+    F_flush = hir.RegisterGCall(flush, emu_gcall_flush)
+)
+
+type Stream struct {
+    w   io.Writer
+    n   int         // Number of bytes written into the writer (or the buffer) by the current encoding.
+    buf []byte
+}
+
+func NewStream(w io.Writer) *Stream {
+    return &Stream {
+        w   : w,
+        buf : make([]byte, _StreamChunkSize),
+    }
+}
+
+// NewBuffer creates a Stream without a writer, which keeps the encoded bytes in a buffer
+// that grows as needed, so objects are encoded in a single pass without measuring them.
+func NewBuffer(size int) *Stream {
+    return &Stream {
+        buf: make([]byte, size),
+    }
+}
+
+func (self *Stream) Encode(val interface{}) (int, error) {
+    return encodeStreamWith(encode, self, val)
+}
+
+// Bytes returns the bytes encoded by the last Encode call of a Stream created by NewBuffer,
+// they are only valid until the next Encode call.
+func (self *Stream) Bytes() []byte {
+    return self.buf[:self.n]
+}
+
+// Cap returns the size of the buffer.
+func (self *Stream) Cap() int {
+    return len(self.buf)
+}
+
+func (self *Stream) flush(p unsafe.Pointer, n int, need int) (unsafe.Pointer, int, error) {
+    sl := (*rt.GoSlice)(unsafe.Pointer(&self.buf))
+    nb := int(uintptr(p) - uintptr(sl.Ptr)) + n
+
+    /* no writer, keep the bytes in the buffer */
+    if self.w == nil {
+        return self.grow(nb, need)
+    }
+
+    /* write all the encoded bytes */
+    if _, err := self.w.Write(self.buf[:nb]); err != nil {
+        return nil, 0, err
+    }
+
+    /* the next chunk must be large enough for the value */
+    if self.n += nb; need > len(self.buf) {
+        self.buf = make([]byte, need)
+    }
+
+    /* reuse the chunk, the bytes had already been written */
+    sl = (*rt.GoSlice)(unsafe.Pointer(&self.buf))
+    return sl.Ptr, sl.Len, nil
+}
+
+/* grow keeps the first nb bytes, and continues right after them, in a larger buffer if needed */
+func (self *Stream) grow(nb int, need int) (unsafe.Pointer, int, error) {
+    self.n = nb
+
+    /* the last flush only records the size */
+    if need == 0 {
+        return nil, 0, nil
+    }
+
+    /* double the buffer until the value fits */
+    if nb + need > len(self.buf) {
+        nc := len(self.buf) * 2
+        if nc < nb + need { nc = nb + need }
+        buf := make([]byte, nc)
+        copy(buf, self.buf[:nb])
+        self.buf = buf
+    }
+
+    /* continue after the encoded bytes */
+    sl := (*rt.GoSlice)(unsafe.Pointer(&self.buf))
+    return unsafe.Pointer(uintptr(sl.Ptr) + uintptr(nb)), sl.Len - nb, nil
+}
+
+func flush(rs *RuntimeState, p unsafe.Pointer, n int, need int) (unsafe.Pointer, int, int, error) {
+    if rs.Wr == nil {
+        return p, n, 0, _E_nomem
+    } else if buf, nb, err := rs.Wr.flush(p, n, need); err != nil {
+        return p, n, 0, err
+    } else {
+        return buf, 0, nb, nil
+    }
+}
+
+func encodeStreamWith(fn func(*rt.GoType, unsafe.Pointer, int, iov.BufferWriter, unsafe.Pointer, *RuntimeState, int) (int, error), w *Stream, val interface{}) (ret int, err error) {
+    rst := newRuntimeState()
+    efv := rt.UnpackEface(val)
+    out := (*rt.GoSlice)(unsafe.Pointer(&w.buf))
+
+    /* the encoder flushes the chunk into the writer when it's full */
+    w.n = 0
+    rst.Wr = w
+
+    /* check for indirect types */
+    if efv.Type.IsIndirect() {
+        ret, err = fn(efv.Type, out.Ptr, out.Len, nil, efv.Value, rst, 0)
+    } else {
+        rst.Pv = efv.Value
+        ret, err = fn(efv.Type, out.Ptr, out.Len, nil, unsafe.Pointer(&rst.Pv), rst, 0)
+    }
+
+    /* write the remaining bytes */
+    if err == nil {
+        _, _, err = w.flush(rst.Bp, ret, 0)
+    }
+
+    /* sample the call if succeeded */
+    if err == nil {
+        TypeStats.Sample(efv.Type, w.n)
+    }
+
+    /* return the state into pool */
+    rst.Wr = nil
+    rst.Bp = nil
+    rst.Pv = nil
+    freeRuntimeState(rst)
+    return w.n, err
+}
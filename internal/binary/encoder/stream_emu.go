@@ -0,0 +1,38 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package encoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+func emu_gcall_flush(ctx hir.CallContext) {
+    if !ctx.Verify("**ii", "*ii**") {
+        panic("invalid flush call")
+    } else {
+        buf, ret, nb, err := flush((*RuntimeState)(ctx.Ap(0)), ctx.Ap(1), int(ctx.Au(2)), int(ctx.Au(3)))
+        ctx.Rp(0, buf)
+        ctx.Ru(1, uint64(ret))
+        ctx.Ru(2, uint64(nb))
+        ctx.Rp(3, unsafe.Pointer((*rt.GoIface)(unsafe.Pointer(&err)).Itab))
+        ctx.Rp(4, (*rt.GoIface)(unsafe.Pointer(&err)).Value)
+    }
+}
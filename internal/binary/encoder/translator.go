@@ -89,7 +89,6 @@ const (
 const (
     LB_halt       = "_halt"
     LB_error      = "_error"
-    LB_nomem      = "_nomem"
     LB_overflow   = "_overflow"
     LB_duplicated = "_duplicated"
 )
@@ -111,10 +110,6 @@ func Translate(s Program) hir.Program {
 }
 
 func errors(p *hir.Builder) {
-    p.Label (LB_nomem)
-    p.MOV   (UR, RL)
-    p.IP    (&_E_nomem, TP)
-    p.JMP   ("_basic_error")
     p.Label (LB_overflow)
     p.IP    (&_E_overflow, TP)
     p.JMP   ("_basic_error")
@@ -148,6 +143,8 @@ func epilogue(p *hir.Builder) {
     p.MOVP  (hir.Pn, ET)
     p.MOVP  (hir.Pn, EP)
     p.Label (LB_error)
+    p.SP    (RP, RS, BpOffset)
+    p.SQ    (RC, RS, BnOffset)
     p.RET   ().
       R0    (RL).
       R1    (ET).
@@ -155,46 +152,91 @@ func epilogue(p *hir.Builder) {
 }
 
 var translators = [256]func(*hir.Builder, Instr) {
-    OP_size_check    : translate_OP_size_check,
-    OP_size_const    : translate_OP_size_const,
-    OP_size_dyn      : translate_OP_size_dyn,
-    OP_size_map      : translate_OP_size_map,
-    OP_size_defer    : translate_OP_size_defer,
-    OP_byte          : translate_OP_byte,
-    OP_word          : translate_OP_word,
-    OP_long          : translate_OP_long,
-    OP_quad          : translate_OP_quad,
-    OP_sint          : translate_OP_sint,
-    OP_length        : translate_OP_length,
-    OP_memcpy_be     : translate_OP_memcpy_be,
-    OP_seek          : translate_OP_seek,
-    OP_deref         : translate_OP_deref,
-    OP_defer         : translate_OP_defer,
-    OP_map_len       : translate_OP_map_len,
-    OP_map_key       : translate_OP_map_key,
-    OP_map_next      : translate_OP_map_next,
-    OP_map_value     : translate_OP_map_value,
-    OP_map_begin     : translate_OP_map_begin,
-    OP_map_if_next   : translate_OP_map_if_next,
-    OP_map_if_empty  : translate_OP_map_if_empty,
-    OP_list_decr     : translate_OP_list_decr,
-    OP_list_begin    : translate_OP_list_begin,
-    OP_list_if_next  : translate_OP_list_if_next,
-    OP_list_if_empty : translate_OP_list_if_empty,
-    OP_unique        : translate_OP_unique,
-    OP_goto          : translate_OP_goto,
-    OP_if_nil        : translate_OP_if_nil,
-    OP_if_hasbuf     : translate_OP_if_hasbuf,
-    OP_if_eq_imm     : translate_OP_if_eq_imm,
-    OP_if_eq_str     : translate_OP_if_eq_str,
-    OP_make_state    : translate_OP_make_state,
-    OP_drop_state    : translate_OP_drop_state,
-    OP_halt          : translate_OP_halt,
+    OP_size_check         : translate_OP_size_check,
+    OP_size_const         : translate_OP_size_const,
+    OP_size_dyn           : translate_OP_size_dyn,
+    OP_size_map           : translate_OP_size_map,
+    OP_size_defer         : translate_OP_size_defer,
+    OP_byte               : translate_OP_byte,
+    OP_word               : translate_OP_word,
+    OP_long               : translate_OP_long,
+    OP_quad               : translate_OP_quad,
+    OP_sint               : translate_OP_sint,
+    OP_uint               : translate_OP_uint,
+    OP_float              : translate_OP_float,
+    OP_length             : translate_OP_length,
+    OP_memcpy             : translate_OP_memcpy,
+    OP_memcpy_be          : translate_OP_memcpy_be,
+    OP_seek               : translate_OP_seek,
+    OP_deref              : translate_OP_deref,
+    OP_custom             : translate_OP_custom,
+    OP_defer              : translate_OP_defer,
+    OP_map_len            : translate_OP_map_len,
+    OP_map_key            : translate_OP_map_key,
+    OP_map_next           : translate_OP_map_next,
+    OP_map_value          : translate_OP_map_value,
+    OP_map_begin          : translate_OP_map_begin,
+    OP_map_if_next        : translate_OP_map_if_next,
+    OP_map_if_empty       : translate_OP_map_if_empty,
+    OP_list_decr          : translate_OP_list_decr,
+    OP_list_begin         : translate_OP_list_begin,
+    OP_array_begin        : translate_OP_array_begin,
+    OP_list_if_next       : translate_OP_list_if_next,
+    OP_list_if_empty      : translate_OP_list_if_empty,
+    OP_unique             : translate_OP_unique,
+    OP_goto               : translate_OP_goto,
+    OP_if_nil             : translate_OP_if_nil,
+    OP_if_hasbuf          : translate_OP_if_hasbuf,
+    OP_if_eq_imm          : translate_OP_if_eq_imm,
+    OP_if_eq_str          : translate_OP_if_eq_str,
+    OP_union_begin        : translate_OP_union_begin,
+    OP_union_count        : translate_OP_union_count,
+    OP_union_check        : translate_OP_union_check,
+    OP_make_state         : translate_OP_make_state,
+    OP_drop_state         : translate_OP_drop_state,
+    OP_compact_field      : translate_OP_compact_field,
+    OP_compact_field_bool : translate_OP_compact_field_bool,
+    OP_compact_size_field : translate_OP_compact_size_field,
+    OP_compact_begin      : translate_OP_compact_begin,
+    OP_compact_bool       : translate_OP_compact_bool,
+    OP_compact_double     : translate_OP_compact_double,
+    OP_compact_float      : translate_OP_compact_float,
+    OP_compact_vint       : translate_OP_compact_vint,
+    OP_compact_size_vint  : translate_OP_compact_size_vint,
+    OP_compact_uint       : translate_OP_compact_uint,
+    OP_compact_size_uint  : translate_OP_compact_size_uint,
+    OP_compact_vlen       : translate_OP_compact_vlen,
+    OP_compact_size_vlen  : translate_OP_compact_size_vlen,
+    OP_compact_list       : translate_OP_compact_list,
+    OP_compact_size_list  : translate_OP_compact_size_list,
+    OP_compact_map        : translate_OP_compact_map,
+    OP_compact_size_map   : translate_OP_compact_size_map,
+    OP_compact_defer      : translate_OP_compact_defer,
+    OP_compact_size_defer : translate_OP_compact_size_defer,
+    OP_halt               : translate_OP_halt,
 }
 
 func translate_OP_size_check(p *hir.Builder, v Instr) {
     p.ADDI  (RL, v.Iv, UR)
-    p.BLTU  (RC, UR, LB_nomem)
+    translate_check(p, "s")
+}
+
+func translate_check(p *hir.Builder, lb string) {
+    p.BGEU  (RC, UR, "_check_" + lb + "_{n}")
+    p.SUB   (UR, RL, UR)
+    p.GCALL (F_flush).
+      A0    (RS).
+      A1    (RP).
+      A2    (RL).
+      A3    (UR).
+      R0    (RP).
+      R1    (RL).
+      R2    (RC).
+      R3    (ET).
+      R4    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.ADD   (RL, UR, UR)
+    p.Label ("_check_" + lb + "_{n}")
 }
 
 func translate_OP_size_const(p *hir.Builder, v Instr) {
@@ -215,8 +257,12 @@ func translate_OP_size_map(p *hir.Builder, v Instr) {
 }
 
 func translate_OP_size_defer(p *hir.Builder, v Instr) {
+    translate_size_defer(p, v, F_encode)
+}
+
+func translate_size_defer(p *hir.Builder, v Instr, fn *hir.CallHandle) {
     p.IP    (v.Vt(), TP)
-    p.GCALL (F_encode).
+    p.GCALL (fn).
       A0    (TP).
       A1    (hir.Pn).
       A2    (hir.Rz).
@@ -274,6 +320,67 @@ func translate_OP_sint(p *hir.Builder, v Instr) {
     }
 }
 
+func translate_OP_uint(p *hir.Builder, v Instr) {
+    translate_load(p, v.Vt())
+    translate_range(p, v.Vt(), v.Iv)
+    p.ADDP  (RP, RL, TP)
+    p.ADDI  (RL, v.Iv, RL)
+
+    /* swap and store the value */
+    switch v.Iv {
+        case 1  : p.SB(TR, TP, 0)
+        case 2  : p.SWAPW(TR, TR); p.SW(TR, TP, 0)
+        case 4  : p.SWAPL(TR, TR); p.SL(TR, TP, 0)
+        case 8  : p.SWAPQ(TR, TR); p.SQ(TR, TP, 0)
+        default : panic("can only convert 1, 2, 4 or 8 bytes at a time")
+    }
+}
+
+func translate_OP_float(p *hir.Builder, _ Instr) {
+    translate_widen(p)
+    p.ADDP  (RP, RL, TP)
+    p.ADDI  (RL, 8, RL)
+    p.SWAPQ (TR, TR)
+    p.SQ    (TR, TP, 0)
+}
+
+func translate_load(p *hir.Builder, vt *rt.GoType) {
+    switch vt.Size {
+        case 1  : p.LB(WP, 0, TR)
+        case 2  : p.LW(WP, 0, TR)
+        case 4  : p.LL(WP, 0, TR)
+        case 8  : p.LQ(WP, 0, TR)
+        default : panic("can only load 1, 2, 4 or 8 bytes at a time")
+    }
+}
+
+func translate_range(p *hir.Builder, vt *rt.GoType, nb int64) {
+    if int64(vt.Size) < nb {
+        return
+    }
+
+    /* the value must fit the signed wire type */
+    p.IQ    (uintMax(nb), UR)
+    p.BGEU  (UR, TR, "_range_ok_{n}")
+    p.IP    (vt, TP)
+    p.IQ    (nb, UR)
+    p.GCALL (F_error_range).
+      A0    (TP).
+      A1    (TR).
+      A2    (UR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label ("_range_ok_{n}")
+}
+
+func translate_widen(p *hir.Builder) {
+    p.LL    (WP, 0, TR)
+    p.GCALL (F_float32to64).
+      A0    (TR).
+      R0    (TR)
+}
+
 func translate_OP_length(p *hir.Builder, v Instr) {
     p.LL    (WP, v.Iv, TR)
     p.SWAPL (TR, TR)
@@ -300,13 +407,22 @@ func translate_OP_memcpy_1(p *hir.Builder) {
     p.JMP   ("_done_{n}")
     p.Label ("_do_copy_{n}")
     p.ADD   (RL, TR, UR)
-    p.BLTU  (RC, UR, LB_nomem)
+    translate_check(p, "m")
     p.ADDP  (RP, RL, EP)
     p.MOV   (UR, RL)
     p.BCOPY (TP, TR, EP)
     p.Label ("_done_{n}")
 }
 
+func translate_OP_memcpy(p *hir.Builder, v Instr) {
+    p.ADDI  (RL, v.Iv, UR)
+    translate_check(p, "m")
+    p.ADDP  (RP, RL, EP)
+    p.MOV   (UR, RL)
+    p.IQ    (v.Iv, TR)
+    p.BCOPY (WP, TR, EP)
+}
+
 func translate_OP_memcpy_be(p *hir.Builder, v Instr) {
     p.LQ    (WP, int64(v.Uv), TR)
     p.BEQ   (TR, hir.Rz, "_done_{n}")
@@ -321,11 +437,10 @@ func translate_OP_memcpy_be(p *hir.Builder, v Instr) {
     /* adjust the buffer length */
     p.MULI  (TR, v.Iv, UR)
     p.ADD   (RL, UR, UR)
-    p.BLTU  (RC, UR, LB_nomem)
+    translate_check(p, "m")
     p.ADDP  (RP, RL, EP)
     p.MOV   (UR, RL)
     p.Label ("_loop_{n}")
-    p.BEQ   (TR, hir.Rz, "_done_{n}")
 
     /* load-swap-store sequence */
     switch v.Iv {
@@ -335,8 +450,9 @@ func translate_OP_memcpy_be(p *hir.Builder, v Instr) {
         default : panic("can only swap 2, 4 or 8 bytes at a time")
     }
 
-    /* update loop counter */
+    /* update loop counter, the pointers must not go past the end */
     p.SUBI  (TR, 1, TR)
+    p.BEQ   (TR, hir.Rz, "_done_{n}")
     p.ADDPI (TP, v.Iv, TP)
     p.ADDPI (EP, v.Iv, EP)
     p.JMP   ("_loop_{n}")
@@ -351,13 +467,28 @@ func translate_OP_deref(p *hir.Builder, _ Instr) {
     p.LP    (WP, 0, WP)
 }
 
+func translate_OP_custom(p *hir.Builder, v Instr) {
+    p.IP    ((*defs.Codec)(v.Pr), TP)
+    p.GCALL (F_marshal).
+      A0    (TP).
+      A1    (WP).
+      R0    (WP).
+      R1    (ET).
+      R2    (EP)
+    p.BNEP  (ET, hir.Pn, LB_error)
+}
+
 func translate_OP_defer(p *hir.Builder, v Instr) {
+    translate_defer(p, v, F_encode)
+}
+
+func translate_defer(p *hir.Builder, v Instr, fn *hir.CallHandle) {
     p.IP    (v.Vt(), TP)
     p.LDAP  (ARG_mem_itab, ET)
     p.LDAP  (ARG_mem_data, EP)
     p.SUB   (RC, RL, TR)
     p.ADDP  (RP, RL, RP)
-    p.GCALL (F_encode).
+    p.GCALL (fn).
       A0    (TP).
       A1    (RP).
       A2    (TR).
@@ -369,9 +500,20 @@ func translate_OP_defer(p *hir.Builder, v Instr) {
       R0    (TR).
       R1    (ET).
       R2    (EP)
+    p.LP    (RS, BpOffset, TP)
+    p.BNEP  (TP, RP, "_flushed_{n}")
     p.SUBP  (RP, RL, RP)
     p.BNEP  (ET, hir.Pn, LB_error)
     p.ADD   (RL, TR, RL)
+    p.JMP   ("_done_{n}")
+
+    /* the callee has flushed the buffer, continue with its output buffer */
+    p.Label ("_flushed_{n}")
+    p.MOVP  (TP, RP)
+    p.LQ    (RS, BnOffset, RC)
+    p.BNEP  (ET, hir.Pn, LB_error)
+    p.MOV   (TR, RL)
+    p.Label ("_done_{n}")
 }
 
 func translate_OP_map_len(p *hir.Builder, _ Instr) {
@@ -436,6 +578,12 @@ func translate_OP_list_begin(p *hir.Builder, _ Instr) {
     p.SQ    (TR, TP, LnOffset)
 }
 
+func translate_OP_array_begin(p *hir.Builder, v Instr) {
+    p.IQ    (v.Iv, TR)
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (TR, TP, LnOffset)
+}
+
 func translate_OP_list_if_next(p *hir.Builder, v Instr) {
     p.ADDP  (RS, ST, TP)
     p.LQ    (TP, LnOffset, TR)
@@ -463,7 +611,14 @@ func translate_OP_unique_type(p *hir.Builder, vt *rt.GoType) {
         case reflect.Int16   : translate_OP_unique_i16(p)
         case reflect.Int32   : translate_OP_unique_i32(p)
         case reflect.Int64   : translate_OP_unique_i64(p)
+        case reflect.Uint    : translate_OP_unique_int(p)
+        case reflect.Uint8   : translate_OP_unique_i8(p)
+        case reflect.Uint16  : translate_OP_unique_i16(p)
+        case reflect.Uint32  : translate_OP_unique_i32(p)
+        case reflect.Uint64  : translate_OP_unique_i64(p)
+        case reflect.Float32 : translate_OP_unique_i32(p)
         case reflect.Float64 : translate_OP_unique_i64(p)
+        case reflect.Array   : break
         case reflect.Map     : break
         case reflect.Ptr     : break
         case reflect.Slice   : break
@@ -502,6 +657,8 @@ func translate_OP_unique_int(p *hir.Builder) {
 }
 
 func translate_OP_unique_small(p *hir.Builder, nb int64, dv int64, ld func(hir.PointerRegister, int64, hir.GenericRegister) *hir.Ir) {
+    /* RC is borrowed as a temporary register, it may differ from the argument after flushing */
+    p.SQ    (RC, RS, BnOffset)
     p.ADDPI (RS, BmOffset, ET)
     p.BZERO (nb, ET)
     p.LP    (WP, 0, EP)
@@ -520,7 +677,7 @@ func translate_OP_unique_small(p *hir.Builder, nb int64, dv int64, ld func(hir.P
     p.BNE   (RC, hir.Rz, LB_duplicated)
     p.SUBI  (TR, 1, TR)
     p.BNE   (TR, hir.Rz, "_loop_{n}")
-    p.LDAQ  (ARG_len, RC)
+    p.LQ    (RS, BnOffset, RC)
 }
 
 func translate_OP_unique_i32(p *hir.Builder) {
@@ -621,6 +778,30 @@ func translate_OP_if_eq_str(p *hir.Builder, v Instr) {
     p.Label ("_neq_{n}")
 }
 
+func translate_OP_union_begin(p *hir.Builder, _ Instr) {
+    p.MOV   (hir.Rz, TR)
+}
+
+func translate_OP_union_count(p *hir.Builder, v Instr) {
+    p.LP    (WP, v.Iv, TP)
+    p.BEQP  (TP, hir.Pn, "_unset_{n}")
+    p.ADDI  (TR, 1, TR)
+    p.Label ("_unset_{n}")
+}
+
+func translate_OP_union_check(p *hir.Builder, v Instr) {
+    p.IB    (1, UR)
+    p.BEQ   (TR, UR, "_ok_{n}")
+    p.IP    (v.Vt(), TP)
+    p.GCALL (F_error_union).
+      A0    (TP).
+      A1    (TR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label ("_ok_{n}")
+}
+
 func translate_OP_make_state(p *hir.Builder, _ Instr) {
     p.IQ    (StateMax, TR)
     p.BGEU  (ST, TR, LB_overflow)
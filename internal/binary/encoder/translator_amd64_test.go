@@ -0,0 +1,42 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package encoder
+
+import (
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal/internal/atm/ssa`
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/stretchr/testify/require`
+)
+
+type TranslatorSSATestStruct struct {
+    A int64   `frugal:"1,default,i64"`
+    B string  `frugal:"2,default,string"`
+    C *string `frugal:"3,optional,string"`
+}
+
+func TestTranslator_VerifySSA(t *testing.T) {
+    var v TranslatorSSATestStruct
+    p, err := CreateCompiler().Compile(reflect.TypeOf(v))
+    require.NoError(t, err)
+    defer func(v bool) { opts.VerifySSA = v }(opts.VerifySSA)
+    opts.VerifySSA = true
+    ssa.Compile(Translate(p), (Encoder)(nil))
+}
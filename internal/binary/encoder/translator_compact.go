@@ -0,0 +1,271 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func translate_compact_reserve(p *hir.Builder, nb int64, lb string) {
+    p.ADDI  (RL, nb, UR)
+    translate_check(p, lb)
+    p.ADDP  (RP, RL, TP)
+    p.MOV   (UR, RL)
+}
+
+func translate_compact_zigzag(p *hir.Builder, nb int64) {
+    switch nb {
+        case 2  : p.LW(WP, 0, TR); p.XORI(TR, 0x8000, TR); p.SUBI(TR, 0x8000, TR)
+        case 4  : p.LL(WP, 0, TR); p.SXLQ(TR, TR)
+        case 8  : p.LQ(WP, 0, TR)
+        default : panic("can only encode 2, 4 or 8 bytes integers")
+    }
+
+    /* (v << 1) ^ (v >> 63) */
+    p.BLT   (TR, hir.Rz, "_zz_neg_{n}")
+    p.ADD   (TR, TR, TR)
+    p.JMP   ("_zz_done_{n}")
+    p.Label ("_zz_neg_{n}")
+    p.XORI  (TR, -1, TR)
+    p.ADD   (TR, TR, TR)
+    p.ADDI  (TR, 1, TR)
+    p.Label ("_zz_done_{n}")
+}
+
+func translate_compact_varint(p *hir.Builder, lb string) {
+    p.Label ("_vi_loop_" + lb + "_{n}")
+    p.IQ    (0x80, UR)
+    p.BLTU  (TR, UR, "_vi_last_" + lb + "_{n}")
+    translate_compact_reserve(p, 1, "vl" + lb)
+    p.ANDI  (TR, 0x7f, UR)
+    p.BSI   (UR, 7, UR)
+    p.SB    (UR, TP, 0)
+    p.SHRI  (TR, 7, TR)
+    p.JMP   ("_vi_loop_" + lb + "_{n}")
+    p.Label ("_vi_last_" + lb + "_{n}")
+    translate_compact_reserve(p, 1, "ve" + lb)
+    p.SB    (TR, TP, 0)
+}
+
+func translate_compact_size_varint(p *hir.Builder, lb string) {
+    p.ADDI  (RL, 1, RL)
+    p.IQ    (0x80, UR)
+    p.Label ("_vs_loop_" + lb + "_{n}")
+    p.BLTU  (TR, UR, "_vs_done_" + lb + "_{n}")
+    p.SHRI  (TR, 7, TR)
+    p.ADDI  (RL, 1, RL)
+    p.JMP   ("_vs_loop_" + lb + "_{n}")
+    p.Label ("_vs_done_" + lb + "_{n}")
+}
+
+func translate_compact_field_delta(p *hir.Builder, id int16, lb string) {
+    p.ADDP  (RS, ST, TP)
+    p.LQ    (TP, FiOffset, TR)
+    p.IQ    (int64(id), UR)
+    p.SQ    (UR, TP, FiOffset)
+    p.SUB   (UR, TR, TR)
+    p.SUBI  (TR, 1, TR)
+    p.IQ    (15, UR)
+    p.BLTU  (TR, UR, "_short_" + lb + "_{n}")
+}
+
+func translate_compact_field(p *hir.Builder, id int16, tag defs.CompactTag, lb string) {
+    buf := append([]byte { byte(tag) }, varint(zigzag(int64(id)))...)
+    translate_compact_field_delta(p, id, lb)
+
+    /* long form: type byte, followed by the zigzag-encoded field ID */
+    translate_compact_reserve(p, int64(len(buf)), "fl" + lb)
+    for i, v := range buf {
+        p.IB(int8(v), TR)
+        p.SB(TR, TP, int64(i))
+    }
+
+    /* short form: (delta << 4) | type */
+    p.JMP   ("_done_" + lb + "_{n}")
+    p.Label ("_short_" + lb + "_{n}")
+    translate_compact_reserve(p, 1, "fs" + lb)
+    p.ADDI  (TR, 1, TR)
+    p.MULI  (TR, 16, TR)
+    p.ADDI  (TR, int64(tag), TR)
+    p.SB    (TR, TP, 0)
+    p.Label ("_done_" + lb + "_{n}")
+}
+
+func translate_OP_compact_field(p *hir.Builder, v Instr) {
+    translate_compact_field(p, int16(v.Iv), defs.CompactTag(v.Uv), "x")
+}
+
+func translate_OP_compact_field_bool(p *hir.Builder, v Instr) {
+    if v.Uv == 0 {
+        p.LB(WP, 0, TR)
+    } else {
+        p.LP(WP, 0, TP)
+        p.LB(TP, 0, TR)
+    }
+
+    /* boolean values are encoded within the field type */
+    p.BEQ   (TR, hir.Rz, "_false_{n}")
+    translate_compact_field(p, int16(v.Iv), defs.C_true, "t")
+    p.JMP   ("_end_{n}")
+    p.Label ("_false_{n}")
+    translate_compact_field(p, int16(v.Iv), defs.C_false, "f")
+    p.Label ("_end_{n}")
+}
+
+func translate_OP_compact_size_field(p *hir.Builder, v Instr) {
+    translate_compact_field_delta(p, int16(v.Iv), "x")
+    p.ADDI  (RL, int64(len(varint(zigzag(int64(int16(v.Iv)))))) + 1, RL)
+    p.JMP   ("_done_x_{n}")
+    p.Label ("_short_x_{n}")
+    p.ADDI  (RL, 1, RL)
+    p.Label ("_done_x_{n}")
+}
+
+func translate_OP_compact_begin(p *hir.Builder, _ Instr) {
+    p.ADDP  (RS, ST, TP)
+    p.SQ    (hir.Rz, TP, FiOffset)
+}
+
+func translate_OP_compact_bool(p *hir.Builder, _ Instr) {
+    p.LB    (WP, 0, TR)
+    p.IB    (int8(defs.C_false), UR)
+    p.BEQ   (TR, hir.Rz, "_set_{n}")
+    p.IB    (int8(defs.C_true), UR)
+    p.Label ("_set_{n}")
+    p.ADDP  (RP, RL, TP)
+    p.ADDI  (RL, 1, RL)
+    p.SB    (UR, TP, 0)
+}
+
+func translate_OP_compact_double(p *hir.Builder, _ Instr) {
+    p.ADDP  (RP, RL, TP)
+    p.ADDI  (RL, 8, RL)
+    p.LQ    (WP, 0, TR)
+    p.SQ    (TR, TP, 0)
+}
+
+func translate_OP_compact_float(p *hir.Builder, _ Instr) {
+    translate_widen(p)
+    p.ADDP  (RP, RL, TP)
+    p.ADDI  (RL, 8, RL)
+    p.SQ    (TR, TP, 0)
+}
+
+func translate_OP_compact_vint(p *hir.Builder, v Instr) {
+    translate_compact_zigzag(p, v.Iv)
+    translate_compact_varint(p, "x")
+}
+
+func translate_OP_compact_size_vint(p *hir.Builder, v Instr) {
+    translate_compact_zigzag(p, v.Iv)
+    translate_compact_size_varint(p, "x")
+}
+
+func translate_OP_compact_uint(p *hir.Builder, v Instr) {
+    translate_load(p, v.Vt())
+    translate_range(p, v.Vt(), v.Iv)
+    p.ADD   (TR, TR, TR)
+    translate_compact_varint(p, "x")
+}
+
+func translate_OP_compact_size_uint(p *hir.Builder, v Instr) {
+    translate_load(p, v.Vt())
+    p.ADD   (TR, TR, TR)
+    translate_compact_size_varint(p, "x")
+}
+
+func translate_OP_compact_vlen(p *hir.Builder, v Instr) {
+    p.LQ    (WP, v.Iv, TR)
+    translate_compact_varint(p, "x")
+}
+
+func translate_OP_compact_size_vlen(p *hir.Builder, v Instr) {
+    p.LQ    (WP, v.Iv, TR)
+    translate_compact_size_varint(p, "x")
+}
+
+func translate_OP_compact_list(p *hir.Builder, v Instr) {
+    p.LQ    (WP, v.Iv, TR)
+    p.IQ    (15, UR)
+    p.BGEU  (TR, UR, "_long_{n}")
+
+    /* short form: (size << 4) | type */
+    translate_compact_reserve(p, 1, "s")
+    p.MULI  (TR, 16, TR)
+    p.ADDI  (TR, int64(v.Uv), TR)
+    p.SB    (TR, TP, 0)
+    p.JMP   ("_done_{n}")
+
+    /* long form: 0xf0 | type, followed by the size */
+    p.Label ("_long_{n}")
+    translate_compact_reserve(p, 1, "l")
+    p.IB    (int8(0xf0 | v.Uv), UR)
+    p.SB    (UR, TP, 0)
+    translate_compact_varint(p, "x")
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_size_list(p *hir.Builder, v Instr) {
+    p.LQ    (WP, v.Iv, TR)
+    p.IQ    (15, UR)
+    p.BGEU  (TR, UR, "_long_{n}")
+    p.ADDI  (RL, 1, RL)
+    p.JMP   ("_done_{n}")
+    p.Label ("_long_{n}")
+    p.ADDI  (RL, 1, RL)
+    translate_compact_size_varint(p, "x")
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_map(p *hir.Builder, v Instr) {
+    p.LP    (WP, 0, TP)
+    p.BEQP  (TP, hir.Pn, "_empty_{n}")
+    p.LQ    (TP, 0, TR)
+    p.BEQ   (TR, hir.Rz, "_empty_{n}")
+
+    /* map size, followed by (key << 4) | value */
+    translate_compact_varint(p, "x")
+    translate_compact_reserve(p, 1, "t")
+    p.IB    (int8(v.Uv), TR)
+    p.SB    (TR, TP, 0)
+    p.JMP   ("_done_{n}")
+
+    /* empty maps are encoded as a single zero byte */
+    p.Label ("_empty_{n}")
+    translate_compact_reserve(p, 1, "e")
+    p.SB    (hir.Rz, TP, 0)
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_size_map(p *hir.Builder, _ Instr) {
+    p.ADDI  (RL, 1, RL)
+    p.LP    (WP, 0, TP)
+    p.BEQP  (TP, hir.Pn, "_done_{n}")
+    p.LQ    (TP, 0, TR)
+    p.BEQ   (TR, hir.Rz, "_done_{n}")
+    translate_compact_size_varint(p, "x")
+    p.Label ("_done_{n}")
+}
+
+func translate_OP_compact_defer(p *hir.Builder, v Instr) {
+    translate_defer(p, v, F_encode_compact)
+}
+
+func translate_OP_compact_size_defer(p *hir.Builder, v Instr) {
+    translate_size_defer(p, v, F_encode_compact)
+}
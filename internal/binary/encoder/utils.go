@@ -19,6 +19,8 @@ package encoder
 import (
     `math/bits`
     `unsafe`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
 )
 
 func bswap16(v int64) int16 {
@@ -44,3 +46,30 @@ func bool2i64(v bool) int64 {
         return 0
     }
 }
+
+func zigzag(v int64) uint64 {
+    return uint64(v << 1) ^ uint64(v >> 63)
+}
+
+func varint(v uint64) []byte {
+    var i int
+    var r [10]byte
+
+    /* 7 bits at a time, LSB first */
+    for i = 0; v >= 0x80; i++ {
+        r[i] = byte(v) | 0x80
+        v >>= 7
+    }
+
+    /* the last byte */
+    r[i] = byte(v)
+    return r[:i + 1]
+}
+
+func compactListHeader(et defs.CompactTag, n int64) []byte {
+    if n < 15 {
+        return []byte { byte(n << 4) | byte(et) }
+    } else {
+        return append([]byte { 0xf0 | byte(et) }, varint(uint64(n))...)
+    }
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `fmt`
+    `reflect`
+    `strings`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* Types are compiled into a graph of pre-resolved descriptors, so the transcoders never
+ * need to look at the Go types or the struct tags while running. */
+
+type _Kind uint8
+
+const (
+    K_plain _Kind = iota
+    K_binary
+    K_fixed
+    K_array
+    K_unsigned
+)
+
+type _Type struct {
+    T defs.Tag
+    F _Kind
+    N int
+    K *_Type
+    V *_Type
+    S *_Struct
+    R reflect.Type
+}
+
+type _Field struct {
+    ID       uint16
+    Key      []byte
+    Tag      []byte
+    Name     string
+    Type     *_Type
+    Alias    []byte
+    Required bool
+}
+
+type _Struct struct {
+    vt      reflect.Type
+    fields  []_Field
+    ids     map[uint16]int
+    names   map[string]int
+    aliases map[string]int
+    reqs    []int
+}
+
+func (self *_Type) isBinary() bool {
+    return self.F == K_binary || self.F == K_fixed
+}
+
+func (self *_Struct) fieldByID(id uint16) (int, *_Field) {
+    if i, ok := self.ids[id]; !ok {
+        return -1, nil
+    } else {
+        return i, &self.fields[i]
+    }
+}
+
+
+/* required returns the ordinal of the i-th field in the required fields */
+func (self *_Struct) required(i int) int {
+    for j, v := range self.reqs {
+        if v == i {
+            return j
+        }
+    }
+    panic("not a required field")
+}
+
+/* thriftName returns the name of the field in the IDL, which is the first item of the
+ * "thrift" tag, or the Go field name if there is none */
+func thriftName(vt reflect.Type, name string) string {
+    if sf, ok := vt.FieldByName(name); !ok {
+        return name
+    } else if tv, ok := sf.Tag.Lookup("thrift"); !ok {
+        return name
+    } else if tn := strings.TrimSpace(strings.Split(tv, ",")[0]); tn == "" {
+        return name
+    } else {
+        return tn
+    }
+}
+
+type _Compiler struct {
+    s map[reflect.Type]*_Struct
+}
+
+func newCompiler() *_Compiler {
+    return &_Compiler {
+        s: make(map[reflect.Type]*_Struct),
+    }
+}
+
+func (self *_Compiler) compile(vt reflect.Type) (*_Struct, error) {
+    var err error
+    var fvs []defs.Field
+
+    /* structs may be recursive */
+    if sv, ok := self.s[vt]; ok {
+        return sv, nil
+    }
+
+    /* resolve all the fields */
+    if fvs, err = defs.ResolveFields(vt); err != nil {
+        return nil, err
+    }
+
+    /* create the struct descriptor */
+    ret := &_Struct {
+        vt      : vt,
+        fields  : make([]_Field, len(fvs)),
+        ids     : make(map[uint16]int, len(fvs)),
+        names   : make(map[string]int, len(fvs)),
+        aliases : make(map[string]int, len(fvs)),
+    }
+
+    /* register the struct before compiling the fields */
+    self.s[vt] = ret
+
+    /* compile every field */
+    for i, fv := range fvs {
+        ret.ids[fv.ID] = i
+        ret.names[fv.Name] = i
+        ret.fields[i].ID = fv.ID
+        ret.fields[i].Name = fv.Name
+        ret.fields[i].Key = append(appendString(nil, []byte(fv.Name)), ':')
+
+        /* TSimpleJSON names the fields after the IDL */
+        tn := thriftName(vt, fv.Name)
+        ret.aliases[tn] = i
+        ret.fields[i].Alias = append(appendString(nil, []byte(tn)), ':')
+        ret.fields[i].Required = fv.Spec == defs.Required
+
+        /* required fields are numbered in the order of appearance */
+        if fv.Spec == defs.Required {
+            ret.reqs = append(ret.reqs, i)
+        }
+
+        /* compile the field type */
+        if ret.fields[i].Type, err = self.typeOf(fv.Type); err != nil {
+            return nil, err
+        }
+
+        /* the field ID and the type name of TJSON */
+        ret.fields[i].Tag = []byte(fmt.Sprintf(`"%d":{"%s":`, int16(fv.ID), _TJSONNames[ret.fields[i].Type.T]))
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func (self *_Compiler) typeOf(vt *defs.Type) (*_Type, error) {
+    var err error
+    var ret *_Type
+
+    /* pointers and custom types are transcoded as what they point to or serialize into */
+    for vt.T == defs.T_pointer || vt.T == defs.T_custom {
+        vt = vt.V
+    }
+
+    /* the wire type */
+    ret = &_Type {
+        T: vt.Tag(),
+        R: vt.S,
+    }
+
+    /* check for the value type */
+    switch vt.T {
+        case defs.T_binary : ret.F = K_binary
+        case defs.T_fixed  : ret.F, ret.N = K_fixed, vt.S.Len()
+        case defs.T_uint   : ret.F, ret.N = K_unsigned, vt.S.Bits()
+        case defs.T_array  : ret.F, ret.N = K_array, vt.S.Len()
+    }
+
+    /* check for container types */
+    switch vt.T {
+        default: {
+            return ret, nil
+        }
+
+        /* structs */
+        case defs.T_struct: {
+            ret.S, err = self.compile(vt.S)
+            return ret, err
+        }
+
+        /* maps */
+        case defs.T_map: {
+            if ret.K, err = self.typeOf(vt.K); err != nil {
+                return nil, err
+            } else {
+                ret.V, err = self.typeOf(vt.V)
+                return ret, err
+            }
+        }
+
+        /* lists, sets and arrays */
+        case defs.T_set, defs.T_list, defs.T_array: {
+            ret.V, err = self.typeOf(vt.V)
+            return ret, err
+        }
+    }
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `fmt`
+    `math/bits`
+    `reflect`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+var (
+    F_error_eof     = hir.RegisterGCall(error_eof, emu_gcall_error_eof)
+    F_error_type    = hir.RegisterGCall(error_type, emu_gcall_error_type)
+    F_error_length  = hir.RegisterGCall(error_length, emu_gcall_error_length)
+    F_error_mapkey  = hir.RegisterGCall(error_mapkey, emu_gcall_error_mapkey)
+    F_error_missing = hir.RegisterGCall(error_missing, emu_gcall_error_missing)
+)
+
+func error_eof(n int) error {
+    return fmt.Errorf("frugal: unexpected EOF: %d bytes short", n)
+}
+
+func error_type(e defs.Tag, t defs.Tag) error {
+    return fmt.Errorf("frugal: type mismatch: %d expected, got %d", e, t)
+}
+
+/* error_missing reports the first required field that is missing in the w-th word
+ * of the bitmap, m has the bits of the missing fields set */
+func error_missing(vt *_Struct, w int, m uint64) error {
+    fv := &vt.fields[vt.reqs[w * 64 + bits.TrailingZeros64(m)]]
+    return fmt.Errorf("frugal: missing required field %d for type %s", fv.ID, vt.vt)
+}
+
+func error_range(vt reflect.Type, v int64) error {
+    return fmt.Errorf("frugal: value %d out of range for %s", v, vt)
+}
+
+func error_length(vt *_Type, n int) error {
+    return fmt.Errorf("frugal: length mismatch for %s: expected %d, got %d", vt.R, vt.N, n)
+}
+
+func error_mapkey(vt *_Type) error {
+    return fmt.Errorf("frugal: TJSON does not support map keys of type %s", vt.R)
+}
+
+func error_value(v interface{}) error {
+    return fmt.Errorf("frugal: unsupported value: %v", v)
+}
+
+func error_json(src []byte, i int, msg string) error {
+    if i >= len(src) {
+        return fmt.Errorf("frugal: invalid JSON at offset %d: %s", i, msg)
+    } else {
+        return fmt.Errorf("frugal: invalid JSON at offset %d (near %q): %s", i, src[i], msg)
+    }
+}
+
+var (
+    _E_stack = fmt.Errorf("frugal: value nesting too deep")
+)
@@ -0,0 +1,347 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `encoding/base64`
+    `encoding/binary`
+    `math`
+    `strconv`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/* JSON input is accepted in the same representation as the output of ToJSON, unknown
+ * object keys are ignored, and null values are treated as absent fields. Binaries may
+ * be either padded or not.
+ *
+ * The scanning functions below start at offset i of the input, and return the offset
+ * after what they have consumed. */
+
+var (
+    F_read_key    = hir.RegisterGCall(read_key, emu_gcall_read_key)
+    F_read_bool   = hir.RegisterGCall(read_bool, emu_gcall_read_bool)
+    F_read_next   = hir.RegisterGCall(read_next, emu_gcall_read_next)
+    F_read_field  = hir.RegisterGCall(read_field, emu_gcall_read_field)
+    F_read_sfield = hir.RegisterGCall(read_sfield, emu_gcall_read_sfield)
+    F_read_expect = hir.RegisterGCall(read_expect, emu_gcall_read_expect)
+    F_read_number = hir.RegisterGCall(read_number, emu_gcall_read_number)
+    F_read_string = hir.RegisterGCall(read_string, emu_gcall_read_string)
+)
+
+func read_expect(rs *RuntimeState, i int, c int) (int, error) {
+    rs.Sc.pos = i
+    err := rs.Sc.expect(byte(c))
+    return rs.Sc.pos, err
+}
+
+/* read_next returns 1 if there are more elements before the delimiter, see _Scanner.next */
+func read_next(rs *RuntimeState, i int, delim int, n int) (int, int, error) {
+    rs.Sc.pos = i
+    more, err := rs.Sc.next(byte(delim), n)
+
+    /* booleans are returned as integers */
+    if !more {
+        return rs.Sc.pos, 0, err
+    } else {
+        return rs.Sc.pos, 1, err
+    }
+}
+
+/* read_field reads the key of the next field, and returns the index of the field, or -1
+ * if the field should be ignored, in which case the value is also consumed */
+func read_field(rs *RuntimeState, i int, vt *_Struct, st int) (int, int, error) {
+    return rs.field(i, vt.names, st)
+}
+
+/* read_sfield is like read_field, but with the fields keyed by their names in the IDL */
+func read_sfield(rs *RuntimeState, i int, vt *_Struct, st int) (int, int, error) {
+    return rs.field(i, vt.aliases, st)
+}
+
+func read_bool(rs *RuntimeState, i int) (int, error) {
+    rs.Sc.pos = i
+    v, err := rs.Sc.boolean()
+
+    /* write the value */
+    if err != nil {
+        return rs.Sc.pos, err
+    } else if v {
+        rs.Out = append(rs.Out, 1)
+        return rs.Sc.pos, nil
+    } else {
+        rs.Out = append(rs.Out, 0)
+        return rs.Sc.pos, nil
+    }
+}
+
+func read_number(rs *RuntimeState, i int, vt *_Type) (int, error) {
+    rs.Sc.pos = i
+    err := rs.number(vt)
+    return rs.Sc.pos, err
+}
+
+func read_string(rs *RuntimeState, i int, vt *_Type) (int, error) {
+    rs.Sc.pos = i
+    buf, err := rs.Sc.str()
+
+    /* convert the string */
+    if err != nil {
+        return rs.Sc.pos, err
+    } else {
+        return rs.Sc.pos, rs.binary(vt, buf)
+    }
+}
+
+/* read_key reads the key of a map, and the colon after it */
+func read_key(rs *RuntimeState, i int, vt *_Type) (int, error) {
+    var err error
+    var key []byte
+
+    /* read the key */
+    rs.Sc.pos = i
+    rs.Sc.peek()
+    pos := rs.Sc.pos
+
+    /* check for errors */
+    if key, err = rs.Sc.str(); err != nil {
+        return rs.Sc.pos, err
+    }
+
+    /* convert the key */
+    if vt.T == defs.T_string {
+        err = rs.binary(vt, key)
+    } else {
+        err = rs.scalar(vt, key, pos)
+    }
+
+    /* read the colon */
+    if err != nil {
+        return rs.Sc.pos, err
+    } else {
+        err = rs.Sc.expect(':')
+        return rs.Sc.pos, err
+    }
+}
+
+/* field reads the key of the next field, and looks it up in names, see read_field */
+func (self *RuntimeState) field(i int, names map[string]int, st int) (int, int, error) {
+    var null bool
+    var key []byte
+    var err error
+
+    /* read the key */
+    self.Sc.pos = i
+    key, err = self.Sc.str()
+
+    /* check for errors */
+    if err != nil {
+        return self.Sc.pos, -1, err
+    } else if err = self.Sc.expect(':'); err != nil {
+        return self.Sc.pos, -1, err
+    }
+
+    /* unknown keys are ignored */
+    j, ok := names[string(key)]
+    if !ok {
+        err = self.Sc.skip(int(int64(st) / StateSize))
+        return self.Sc.pos, -1, err
+    }
+
+    /* null values are treated as absent */
+    if null, err = self.Sc.null(); err != nil || null {
+        return self.Sc.pos, -1, err
+    } else {
+        return self.Sc.pos, j, nil
+    }
+}
+
+func (self *RuntimeState) u16(v uint16) {
+    self.Out = append(self.Out, byte(v >> 8), byte(v))
+}
+
+func (self *RuntimeState) u32(v uint32) {
+    self.Out = append(self.Out, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v))
+}
+
+func (self *RuntimeState) u64(v uint64) {
+    self.Out = append(self.Out, 0, 0, 0, 0, 0, 0, 0, 0)
+    binary.BigEndian.PutUint64(self.Out[len(self.Out) - 8:], v)
+}
+
+func (self *RuntimeState) number(vt *_Type) error {
+    var s []byte
+    var err error
+
+    /* special doubles are strings */
+    if vt.T == defs.T_double && self.Sp != 0 && self.Sc.peek() == '"' {
+        return self.quoted(vt)
+    }
+
+    /* parse the number */
+    if s, err = self.Sc.number(); err != nil {
+        return err
+    } else {
+        return self.scalar(vt, s, self.Sc.pos - len(s))
+    }
+}
+
+func (self *RuntimeState) quoted(vt *_Type) error {
+    var s []byte
+    var err error
+
+    /* read the string */
+    self.Sc.peek()
+    pos := self.Sc.pos
+
+    /* parse the value within */
+    if s, err = self.Sc.str(); err != nil {
+        return err
+    } else {
+        return self.scalar(vt, s, pos)
+    }
+}
+
+/* scalar converts the textual representation of numbers and booleans, pos is the
+ * offset of the text, which is used for reporting errors */
+func (self *RuntimeState) scalar(vt *_Type, s []byte, pos int) error {
+    var err error
+    var val uint64
+
+    /* parse the value, unsigned integers must be in the range of both the wire type and the Go type */
+    switch vt.T {
+        case defs.T_bool   : val, err = parseBool(str(s))
+        case defs.T_i8     : val, err = parseInt(vt, str(s), 8)
+        case defs.T_i16    : val, err = parseInt(vt, str(s), 16)
+        case defs.T_i32    : val, err = parseInt(vt, str(s), 32)
+        case defs.T_i64    : val, err = parseInt(vt, str(s), 64)
+        case defs.T_double : val, err = parseDouble(str(s), self.Sp != 0)
+        default            : panic("unreachable")
+    }
+
+    /* check for errors */
+    if err != nil {
+        self.Sc.pos = pos
+        return self.Sc.error(err.Error())
+    }
+
+    /* write the value */
+    switch vt.T {
+        case defs.T_bool   : self.Out = append(self.Out, byte(val))
+        case defs.T_i8     : self.Out = append(self.Out, byte(val))
+        case defs.T_i16    : self.u16(uint16(val))
+        case defs.T_i32    : self.u32(uint32(val))
+        case defs.T_i64    : self.u64(val)
+        case defs.T_double : self.u64(val)
+    }
+
+    /* all done */
+    return nil
+}
+
+func parseBool(s string) (uint64, error) {
+    switch s {
+        case "true"  : return 1, nil
+        case "false" : return 0, nil
+        default      : return 0, strconv.ErrSyntax
+    }
+}
+
+func parseInt(vt *_Type, s string, nb int) (uint64, error) {
+    if vt.F != K_unsigned {
+        val, err := strconv.ParseInt(s, 10, nb)
+        return uint64(val), unwrap(err)
+    } else if vt.N < nb {
+        val, err := strconv.ParseUint(s, 10, vt.N)
+        return val, unwrap(err)
+    } else {
+        val, err := strconv.ParseUint(s, 10, nb - 1)
+        return val, unwrap(err)
+    }
+}
+
+func parseDouble(s string, spd bool) (uint64, error) {
+    if val, err := strconv.ParseFloat(s, 64); err != nil {
+        return 0, unwrap(err)
+    } else if !spd && (math.IsNaN(val) || math.IsInf(val, 0)) {
+        return 0, strconv.ErrSyntax
+    } else {
+        return math.Float64bits(val), nil
+    }
+}
+
+func unwrap(err error) error {
+    if e, ok := err.(*strconv.NumError); ok {
+        return e.Err
+    } else {
+        return err
+    }
+}
+
+func (self *RuntimeState) binary(vt *_Type, buf []byte) error {
+    var n int
+    var p int
+    var err error
+
+    /* strings are written as-is */
+    if !vt.isBinary() {
+        self.u32(uint32(len(buf)))
+        self.Out = append(self.Out, buf...)
+        return nil
+    }
+
+    /* the padding is optional */
+    buf = trimPadding(buf)
+    self.u32(0)
+
+    /* reserve the space for the decoded bytes */
+    p = len(self.Out)
+    self.Out = append(self.Out, make([]byte, base64.RawStdEncoding.DecodedLen(len(buf)))...)
+
+    /* decode the binary */
+    if n, err = base64.RawStdEncoding.Decode(self.Out[p:], buf); err != nil {
+        return self.Sc.error("invalid base64 binary")
+    }
+
+    /* fixed-size binaries must have the exact size */
+    if vt.F == K_fixed && n != vt.N {
+        return error_length(vt, n)
+    }
+
+    /* update the length */
+    self.Out = self.Out[:p + n]
+    binary.BigEndian.PutUint32(self.Out[p - 4:], uint32(n))
+    return nil
+}
+
+func trimPadding(buf []byte) []byte {
+    for len(buf) != 0 && buf[len(buf) - 1] == '=' {
+        buf = buf[:len(buf) - 1]
+    }
+    return buf
+}
+
+func str(buf []byte) string {
+    if len(buf) == 0 {
+        return ""
+    } else {
+        return rt.StringFrom(unsafe.Pointer(&buf[0]), len(buf))
+    }
+}
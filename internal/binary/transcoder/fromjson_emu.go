@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+func emu_gcall_read_expect(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "i**") {
+        panic("invalid read_expect call")
+    } else {
+        ret, err := read_expect((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_read_next(ctx hir.CallContext) {
+    if !ctx.Verify("*iii", "ii**") {
+        panic("invalid read_next call")
+    } else {
+        ret, more, err := read_next((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(more))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_field(ctx hir.CallContext) {
+    if !ctx.Verify("*i*i", "ii**") {
+        panic("invalid read_field call")
+    } else {
+        ret, idx, err := read_field((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Struct)(ctx.Ap(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(idx))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_sfield(ctx hir.CallContext) {
+    if !ctx.Verify("*i*i", "ii**") {
+        panic("invalid read_sfield call")
+    } else {
+        ret, idx, err := read_sfield((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Struct)(ctx.Ap(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(idx))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_bool(ctx hir.CallContext) {
+    if !ctx.Verify("*i", "i**") {
+        panic("invalid read_bool call")
+    } else {
+        ret, err := read_bool((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_read_number(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "i**") {
+        panic("invalid read_number call")
+    } else {
+        ret, err := read_number((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_read_string(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "i**") {
+        panic("invalid read_string call")
+    } else {
+        ret, err := read_string((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_read_key(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "i**") {
+        panic("invalid read_key call")
+    } else {
+        ret, err := read_key((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/atm/pgen`
+    `github.com/cloudwego/frugal/internal/loader`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+type (
+    LinkerAMD64 struct{}
+)
+
+func init() {
+    SetLinker(new(LinkerAMD64))
+}
+
+func (LinkerAMD64) Link(vt *rt.GoType, p hir.Program) Transcoder {
+    fn := pgen.CreateCodeGen((Transcoder)(nil)).Generate(p, 0)
+    fp := loader.Loader(fn.Code).LoadCollectable("transcoder_" + vt.String(), fn.Frame)
+    return *(*Transcoder)(unsafe.Pointer(&fp))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/emu`
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+func link_emu(prog hir.Program) Transcoder {
+    return func(rs *RuntimeState, i int, st int) (pos int, err error) {
+        ctx := emu.LoadProgram(prog)
+        ret := (*rt.GoIface)(unsafe.Pointer(&err))
+        ctx.Ap(0, unsafe.Pointer(rs))
+        ctx.Au(1, uint64(i))
+        ctx.Au(2, uint64(st))
+        ctx.Run()
+        pos = int(ctx.Ru(0))
+        ret.Itab = (*rt.GoItab)(ctx.Rp(1))
+        ret.Value = ctx.Rp(2)
+        ctx.Free()
+        return
+    }
+}
+
+func emu_gcall_transcode(ctx hir.CallContext) {
+    if !ctx.Verify("i**ii", "i**") {
+        panic("invalid transcode call")
+    } else {
+        ret, err := transcode(_Mode(ctx.Au(0)), (*_Struct)(ctx.Ap(1)), (*RuntimeState)(ctx.Ap(2)), int(ctx.Au(3)), int(ctx.Au(4)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
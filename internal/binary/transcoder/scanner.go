@@ -0,0 +1,317 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `strconv`
+    `unicode/utf16`
+    `unicode/utf8`
+
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* A minimal JSON scanner that works on the input in-place, it only produces what the
+ * transcoder needs: delimiters, literals, raw numbers and unescaped strings. */
+
+type _Scanner struct {
+    src []byte
+    pos int
+    tmp []byte
+}
+
+func (self *_Scanner) error(msg string) error {
+    return error_json(self.src, self.pos, msg)
+}
+
+func (self *_Scanner) peek() byte {
+    for self.pos < len(self.src) {
+        switch self.src[self.pos] {
+            case ' ', '\t', '\r', '\n' : self.pos++
+            default                    : return self.src[self.pos]
+        }
+    }
+    return 0
+}
+
+func (self *_Scanner) eof() error {
+    if self.peek() != 0 {
+        return self.error("trailing characters after the value")
+    } else {
+        return nil
+    }
+}
+
+func (self *_Scanner) expect(c byte) error {
+    if self.peek() != c {
+        return self.error(strconv.QuoteRune(rune(c)) + " expected")
+    } else {
+        self.pos++
+        return nil
+    }
+}
+
+/* next checks if there are more elements in an object or array, the n-th elements
+ * (except the first one) are preceded by a comma */
+func (self *_Scanner) next(delim byte, n int) (bool, error) {
+    if c := self.peek(); c == delim {
+        self.pos++
+        return false, nil
+    } else if n == 0 {
+        return true, nil
+    } else if c != ',' {
+        return false, self.error("',' or " + strconv.QuoteRune(rune(delim)) + " expected")
+    } else {
+        self.pos++
+        return true, nil
+    }
+}
+
+func (self *_Scanner) literal(s string) error {
+    if p := self.pos; len(self.src) - p < len(s) || string(self.src[p:p + len(s)]) != s {
+        return self.error(s + " expected")
+    } else {
+        self.pos += len(s)
+        return nil
+    }
+}
+
+func (self *_Scanner) null() (bool, error) {
+    if self.peek() != 'n' {
+        return false, nil
+    } else {
+        return true, self.literal("null")
+    }
+}
+
+func (self *_Scanner) boolean() (bool, error) {
+    if self.peek() == 't' {
+        return true, self.literal("true")
+    } else {
+        return false, self.literal("false")
+    }
+}
+
+func (self *_Scanner) number() ([]byte, error) {
+    c := self.peek()
+    p := self.pos
+
+    /* check for the first character */
+    if c != '-' && (c < '0' || c > '9') {
+        return nil, self.error("number expected")
+    }
+
+    /* the number ends at the first non-numeric character, it's validated when parsing */
+    for self.pos < len(self.src) && isNumeric(self.src[self.pos]) {
+        self.pos++
+    }
+
+    /* slice the number */
+    return self.src[p:self.pos], nil
+}
+
+func isNumeric(c byte) bool {
+    return c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E' || c >= '0' && c <= '9'
+}
+
+/* str returns the unescaped string, which refers to the source directly if it has no
+ * escape sequences, and is only valid until the next call otherwise */
+func (self *_Scanner) str() ([]byte, error) {
+    if err := self.expect('"'); err != nil {
+        return nil, err
+    }
+
+    /* fast-path: no escape sequences */
+    for i := self.pos; i < len(self.src); i++ {
+        switch c := self.src[i]; {
+            case c == '"'  : p := self.pos; self.pos = i + 1; return self.src[p:i], nil
+            case c == '\\' : return self.unescape(i)
+            case c < 0x20  : self.pos = i; return nil, self.error("control character in string")
+        }
+    }
+
+    /* the string is not terminated */
+    self.pos = len(self.src)
+    return nil, self.error("unterminated string")
+}
+
+func (self *_Scanner) unescape(i int) ([]byte, error) {
+    var err error
+
+    /* copy the characters before the first escape sequence */
+    self.tmp = append(self.tmp[:0], self.src[self.pos:i]...)
+    self.pos = i
+
+    /* unescape until the closing quote */
+    for self.pos < len(self.src) {
+        c := self.src[self.pos]
+
+        /* check for the closing quote and control characters */
+        if c == '"' {
+            self.pos++
+            return self.tmp, nil
+        } else if c < 0x20 {
+            return nil, self.error("control character in string")
+        }
+
+        /* normal characters */
+        if c != '\\' {
+            self.tmp = append(self.tmp, c)
+            self.pos++
+            continue
+        }
+
+        /* must have at least 2 characters */
+        if self.pos + 1 >= len(self.src) {
+            break
+        }
+
+        /* check for the escape sequence */
+        switch self.pos += 2; self.src[self.pos - 1] {
+            case '"'  : self.tmp = append(self.tmp, '"')
+            case '\\' : self.tmp = append(self.tmp, '\\')
+            case '/'  : self.tmp = append(self.tmp, '/')
+            case 'b'  : self.tmp = append(self.tmp, '\b')
+            case 'f'  : self.tmp = append(self.tmp, '\f')
+            case 'n'  : self.tmp = append(self.tmp, '\n')
+            case 'r'  : self.tmp = append(self.tmp, '\r')
+            case 't'  : self.tmp = append(self.tmp, '\t')
+            case 'u'  : err = self.unicode()
+            default   : self.pos -= 2; return nil, self.error("invalid escape sequence")
+        }
+
+        /* check for errors */
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    /* the string is not terminated */
+    self.pos = len(self.src)
+    return nil, self.error("unterminated string")
+}
+
+func appendRune(buf []byte, r rune) []byte {
+    var mm [utf8.UTFMax]byte
+    return append(buf, mm[:utf8.EncodeRune(mm[:], r)]...)
+}
+
+func (self *_Scanner) hex4() (rune, bool) {
+    var r rune
+    var p int
+
+    /* must have 4 hex digits */
+    if p = self.pos; len(self.src) - p < 4 {
+        return 0, false
+    }
+
+    /* parse the hex digits */
+    for _, c := range self.src[p:p + 4] {
+        switch {
+            case c >= '0' && c <= '9' : r = r << 4 | rune(c - '0')
+            case c >= 'a' && c <= 'f' : r = r << 4 | rune(c - 'a' + 10)
+            case c >= 'A' && c <= 'F' : r = r << 4 | rune(c - 'A' + 10)
+            default                   : return 0, false
+        }
+    }
+
+    /* all done */
+    self.pos += 4
+    return r, true
+}
+
+func (self *_Scanner) unicode() error {
+    r, ok := self.hex4()
+    if !ok {
+        return self.error("invalid unicode escape sequence")
+    }
+
+    /* check for surrogate pairs */
+    if !utf16.IsSurrogate(r) {
+        self.tmp = appendRune(self.tmp, r)
+        return nil
+    }
+
+    /* must be followed by the low surrogate, otherwise it's invalid */
+    if p := self.pos; len(self.src) - p >= 2 && self.src[p] == '\\' && self.src[p + 1] == 'u' {
+        self.pos += 2
+        if r2, ok := self.hex4(); !ok {
+            return self.error("invalid unicode escape sequence")
+        } else if rv := utf16.DecodeRune(r, r2); rv != utf8.RuneError {
+            self.tmp = appendRune(self.tmp, rv)
+            return nil
+        } else {
+            self.pos = p
+        }
+    }
+
+    /* unpaired surrogates are replaced with U+FFFD, just like encoding/json */
+    self.tmp = appendRune(self.tmp, utf8.RuneError)
+    return nil
+}
+
+func (self *_Scanner) skip(depth int) error {
+    var err error
+
+    /* check for the nesting depth */
+    if depth >= defs.StackSize {
+        return _E_stack
+    }
+
+    /* check for the value type */
+    switch self.peek() {
+        case '"' : _, err = self.str()
+        case '{' : err = self.skipObject(depth)
+        case '[' : err = self.skipArray(depth)
+        case 't' : err = self.literal("true")
+        case 'f' : err = self.literal("false")
+        case 'n' : err = self.literal("null")
+        default  : _, err = self.number()
+    }
+
+    /* all done */
+    return err
+}
+
+func (self *_Scanner) skipObject(depth int) error {
+    self.pos++
+
+    /* skip every key-value pair */
+    for n := 0; ; n++ {
+        if more, err := self.next('}', n); err != nil || !more {
+            return err
+        } else if _, err = self.str(); err != nil {
+            return err
+        } else if err = self.expect(':'); err != nil {
+            return err
+        } else if err = self.skip(depth + 1); err != nil {
+            return err
+        }
+    }
+}
+
+func (self *_Scanner) skipArray(depth int) error {
+    self.pos++
+
+    /* skip every element */
+    for n := 0; ; n++ {
+        if more, err := self.next(']', n); err != nil || !more {
+            return err
+        } else if err = self.skip(depth + 1); err != nil {
+            return err
+        }
+    }
+}
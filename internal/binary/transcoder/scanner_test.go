@@ -0,0 +1,57 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `testing`
+
+    `github.com/stretchr/testify/require`
+)
+
+func TestScanner_String(t *testing.T) {
+    for src, exp := range map[string]string {
+        `"plain"`        : "plain",
+        `"a\"b\\c\/d"`   : `a"b\c/d`,
+        `"\b\f\n\r\t"`   : "\b\f\n\r\t",
+        `"\u00e9\u0000"` : "\u00e9\x00",
+        `"\ud83d\ude00"` : "\U0001F600",
+        `"\ud83dx"`      : "\ufffdx",
+        `"\ud83d\u0041"` : "\ufffdA",
+    } {
+        sc := _Scanner { src: []byte(src) }
+        buf, err := sc.str()
+        require.NoError(t, err, src)
+        require.Equal(t, exp, string(buf), src)
+        require.Equal(t, len(src), sc.pos, src)
+    }
+}
+
+func TestScanner_StringErrors(t *testing.T) {
+    for _, src := range []string { `"abc`, `"a\`, `"\x"`, `"\u12"`, "\"\n\"", `abc` } {
+        sc := _Scanner { src: []byte(src) }
+        _, err := sc.str()
+        require.Error(t, err, src)
+    }
+}
+
+func TestScanner_Skip(t *testing.T) {
+    sc := _Scanner { src: []byte(` {"a": [1, -2.5e3, true, false, null, {"b": "c"}], "d": {}} ,`) }
+    require.NoError(t, sc.skip(0))
+    require.Equal(t, byte(','), sc.peek())
+    sc = _Scanner { src: []byte(`[1, 2`) }
+    require.Error(t, sc.skip(0))
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package transcoder
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* TSimpleJSON is the simple JSON protocol of Apache Thrift, in the layout of its Go library:
+ *
+ *   - structs are objects keyed by the field names in the IDL, e.g. {"id":1,"name":"x"}
+ *   - bool is true or false, doubles are numbers, or "NaN", "Infinity" and "-Infinity"
+ *     strings, binaries are Base64-encoded strings
+ *   - lists and sets are arrays beginning with the element type ID and the count,
+ *     e.g. [8,3,1,2,3]
+ *   - maps are arrays of the key type ID, the value type ID, the count, and then the
+ *     keys and the values in turn, e.g. [11,8,1,"a",1]
+ */
+
+var (
+    F_read_smap  = hir.RegisterGCall(read_smap, emu_gcall_read_smap)
+    F_read_slist = hir.RegisterGCall(read_slist, emu_gcall_read_slist)
+)
+
+/* read_slist reads the element type ID and count of a list, set or array */
+func read_slist(rs *RuntimeState, i int, vt *_Type) (int, int, error) {
+    return rs.list(i, vt, true)
+}
+
+/* read_smap reads the key type ID, the value type ID and the count of a map */
+func read_smap(rs *RuntimeState, i int, vt *_Type) (int, int, error) {
+    return rs.dict(i, vt, true)
+}
+
+func (self *RuntimeState) tid() (defs.Tag, error) {
+    if s, err := self.Sc.number(); err != nil {
+        return 0, err
+    } else if v, err := strconv.ParseUint(str(s), 10, 8); err != nil || _TJSONNames[v] == "" {
+        self.Sc.pos -= len(s)
+        return 0, self.Sc.error("invalid type ID " + strconv.Quote(string(s)))
+    } else {
+        return defs.Tag(v), nil
+    }
+}
+
+func (self *RuntimeState) expectTID(vt *_Type) error {
+    if tag, err := self.tid(); err != nil {
+        return err
+    } else if tag != vt.T {
+        return error_type(vt.T, tag)
+    } else {
+        return nil
+    }
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+func emu_gcall_read_slist(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "ii**") {
+        panic("invalid read_slist call")
+    } else {
+        ret, nb, err := read_slist((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(nb))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_smap(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "ii**") {
+        panic("invalid read_smap call")
+    } else {
+        ret, nb, err := read_smap((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(nb))
+        emu_seterr(ctx, 2, err)
+    }
+}
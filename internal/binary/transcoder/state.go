@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `sync`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+const (
+    NbOffset = int64(unsafe.Offsetof(StateItem{}.Nb))
+    NxOffset = int64(unsafe.Offsetof(StateItem{}.Nx))
+    FmOffset = int64(unsafe.Offsetof(StateItem{}.Fm))
+)
+
+const (
+    BpOffset = int64(unsafe.Offsetof(RuntimeState{}.Buf)) + int64(unsafe.Offsetof(rt.GoSlice{}.Ptr))
+    BnOffset = int64(unsafe.Offsetof(RuntimeState{}.Buf)) + int64(unsafe.Offsetof(rt.GoSlice{}.Len))
+    OpOffset = int64(unsafe.Offsetof(RuntimeState{}.Out)) + int64(unsafe.Offsetof(rt.GoSlice{}.Ptr))
+    OnOffset = int64(unsafe.Offsetof(RuntimeState{}.Out)) + int64(unsafe.Offsetof(rt.GoSlice{}.Len))
+    OcOffset = int64(unsafe.Offsetof(RuntimeState{}.Out)) + int64(unsafe.Offsetof(rt.GoSlice{}.Cap))
+)
+
+const (
+    StateLen  = defs.StackSize * StateSize
+    StateSize = int64(unsafe.Sizeof(StateItem{}))
+)
+
+type StateItem struct {
+    Nb uint64       // Number of fields or elements transcoded.
+    Nx uint64       // Number of elements, or offset of the element count to be updated in the output.
+    Fm uint64       // Bitmap of the seen required fields.
+}
+
+type RuntimeState struct {
+    St  [defs.StackSize]StateItem   // Must be the first field.
+    Buf []byte                      // Input buffer of Thrift Binary Protocol.
+    Out []byte                      // Output buffer.
+    Sp  uint64                      // Non-zero if the special doubles are formatted as strings.
+    Sc  _Scanner                    // Input of JSON.
+}
+
+var (
+    F_grow           = hir.RegisterGCall(grow, emu_gcall_grow)
+    runtimeStatePool sync.Pool
+)
+
+func newRuntimeState() *RuntimeState {
+    if v := runtimeStatePool.Get(); v != nil {
+        return v.(*RuntimeState)
+    } else {
+        return new(RuntimeState)
+    }
+}
+
+func freeRuntimeState(p *RuntimeState) {
+    p.Buf = nil
+    p.Out = nil
+    p.Sc.src = nil
+    runtimeStatePool.Put(p)
+}
+
+/* grow makes room for the output buffer to have at least nb bytes */
+func grow(rs *RuntimeState, nb int) {
+    n := len(rs.Out)
+    m := cap(rs.Out) * 2
+
+    /* at least doubles the capacity */
+    if m < nb {
+        m = nb
+    }
+
+    /* move to the new buffer */
+    buf := make([]byte, n, m)
+    copy(buf, rs.Out)
+    rs.Out = buf
+}
@@ -0,0 +1,304 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* TJSON is the JSON protocol of Apache Thrift, every value is tagged with its type:
+ *
+ *   - structs are objects keyed by the field IDs, and every field value is wrapped
+ *     in an object keyed by the type name, e.g. {"1":{"i32":42},"2":{"str":"x"}}
+ *   - bool is a number (1 or 0), doubles are numbers, or "NaN", "Infinity" and
+ *     "-Infinity" strings, binaries are Base64-encoded strings
+ *   - lists and sets are arrays beginning with the element type and the count,
+ *     e.g. ["i32",3,1,2,3]
+ *   - maps are arrays of the key type, the value type, the count, and an object
+ *     with the keys formatted as JSON strings, e.g. ["str","i32",1,{"a":1}]
+ */
+
+var _TJSONNames = [256]string {
+    defs.T_bool   : "tf",
+    defs.T_i8     : "i8",
+    defs.T_i16    : "i16",
+    defs.T_i32    : "i32",
+    defs.T_i64    : "i64",
+    defs.T_double : "dbl",
+    defs.T_string : "str",
+    defs.T_struct : "rec",
+    defs.T_map    : "map",
+    defs.T_set    : "set",
+    defs.T_list   : "lst",
+}
+
+var (
+    _TJSONTags = make(map[string]defs.Tag, len(_TJSONNames))
+)
+
+var (
+    F_read_tf     = hir.RegisterGCall(read_tf, emu_gcall_read_tf)
+    F_read_tkey   = hir.RegisterGCall(read_tkey, emu_gcall_read_tkey)
+    F_read_tmap   = hir.RegisterGCall(read_tmap, emu_gcall_read_tmap)
+    F_read_tlist  = hir.RegisterGCall(read_tlist, emu_gcall_read_tlist)
+    F_read_tfield = hir.RegisterGCall(read_tfield, emu_gcall_read_tfield)
+    F_write_count = hir.RegisterGCall(write_count, emu_gcall_write_count)
+)
+
+func init() {
+    for i, v := range _TJSONNames {
+        if v != "" {
+            _TJSONTags[v] = defs.Tag(i)
+        }
+    }
+}
+
+/* keys must be formatted as strings */
+func isStringKey(vt *_Type) bool {
+    return vt.T != defs.T_struct && vt.T != defs.T_map && vt.T != defs.T_set && vt.T != defs.T_list
+}
+
+/** TJSON Encoder **/
+
+func write_count(rs *RuntimeState, n int) {
+    rs.Out = strconv.AppendInt(rs.Out, int64(n), 10)
+}
+
+/** TJSON Decoder **/
+
+func read_tf(rs *RuntimeState, i int) (int, error) {
+    rs.Sc.pos = i
+    s, err := rs.Sc.number()
+
+    /* booleans are numbers */
+    if err != nil {
+        return rs.Sc.pos, err
+    } else {
+        err = rs.tf(s, rs.Sc.pos - len(s))
+        return rs.Sc.pos, err
+    }
+}
+
+/* read_tfield reads the field ID and the type name of the next field, and returns the
+ * index of the field, or -1 if the field is unknown or has a mismatched type, in which
+ * case the whole field is consumed */
+func read_tfield(rs *RuntimeState, i int, vt *_Struct, st int) (int, int, error) {
+    var err error
+    var key []byte
+    var tag defs.Tag
+
+    /* read the field ID */
+    rs.Sc.pos = i
+    rs.Sc.peek()
+    pos := rs.Sc.pos
+
+    /* parse the field ID */
+    if key, err = rs.Sc.str(); err != nil {
+        return rs.Sc.pos, -1, err
+    }
+
+    /* the field ID must be a valid i16 */
+    id, ex := strconv.ParseInt(str(key), 10, 16)
+    if ex != nil {
+        rs.Sc.pos = pos
+        return rs.Sc.pos, -1, rs.Sc.error("invalid field ID")
+    }
+
+    /* open the field, and read the type name */
+    if err = rs.Sc.expect(':'); err != nil {
+        return rs.Sc.pos, -1, err
+    } else if err = rs.Sc.expect('{'); err != nil {
+        return rs.Sc.pos, -1, err
+    } else if tag, err = rs.tag(); err != nil {
+        return rs.Sc.pos, -1, err
+    } else if err = rs.Sc.expect(':'); err != nil {
+        return rs.Sc.pos, -1, err
+    }
+
+    /* find the field, fields that are not in the struct or with mismatched types are skipped */
+    j, fv := vt.fieldByID(uint16(id))
+    if fv != nil && fv.Type.T == tag {
+        return rs.Sc.pos, j, nil
+    }
+
+    /* skip the value, and close the field */
+    if err = rs.Sc.skip(int(int64(st) / StateSize)); err != nil {
+        return rs.Sc.pos, -1, err
+    } else {
+        err = rs.Sc.expect('}')
+        return rs.Sc.pos, -1, err
+    }
+}
+
+/* read_tlist reads the element type and count of a list, set or array */
+func read_tlist(rs *RuntimeState, i int, vt *_Type) (int, int, error) {
+    return rs.list(i, vt, false)
+}
+
+/* read_tmap reads the key type, the value type and the count of a map, until the opening brace of the pairs */
+func read_tmap(rs *RuntimeState, i int, vt *_Type) (int, int, error) {
+    var nb int
+    var err error
+
+    /* read the header */
+    if i, nb, err = rs.dict(i, vt, false); err != nil {
+        return i, 0, err
+    } else if err = rs.Sc.expect(','); err != nil {
+        return rs.Sc.pos, 0, err
+    } else if err = rs.Sc.expect('{'); err != nil {
+        return rs.Sc.pos, 0, err
+    } else {
+        return rs.Sc.pos, nb, nil
+    }
+}
+
+/* read_tkey reads the key of a map, and the colon after it */
+func read_tkey(rs *RuntimeState, i int, vt *_Type) (int, error) {
+    var err error
+    var key []byte
+
+    /* read the key */
+    rs.Sc.pos = i
+    rs.Sc.peek()
+    pos := rs.Sc.pos
+
+    /* check for errors */
+    if key, err = rs.Sc.str(); err != nil {
+        return rs.Sc.pos, err
+    }
+
+    /* convert the key */
+    switch vt.T {
+        case defs.T_bool   : err = rs.tf(key, pos)
+        case defs.T_string : err = rs.binary(vt, key)
+        default            : err = rs.scalar(vt, key, pos)
+    }
+
+    /* read the colon */
+    if err != nil {
+        return rs.Sc.pos, err
+    } else {
+        err = rs.Sc.expect(':')
+        return rs.Sc.pos, err
+    }
+}
+
+/* list reads the element type and count of a list, set or array, the type is a
+ * type name of TJSON, or a type ID of TSimpleJSON if numeric is set */
+func (self *RuntimeState) list(i int, vt *_Type, numeric bool) (int, int, error) {
+    var nb int
+    var err error
+
+    /* read the element type and count */
+    self.Sc.pos = i
+    err = self.Sc.expect('[')
+
+    /* check for errors */
+    if err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.elem(vt.V, numeric); err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.Sc.expect(','); err != nil {
+        return self.Sc.pos, 0, err
+    } else if nb, err = self.count(); err != nil {
+        return self.Sc.pos, 0, err
+    }
+
+    /* arrays must have the exact length */
+    if vt.F == K_array && nb != vt.N {
+        return self.Sc.pos, 0, error_length(vt, nb)
+    } else {
+        return self.Sc.pos, nb, nil
+    }
+}
+
+/* dict reads the key type, the value type and the count of a map, see list */
+func (self *RuntimeState) dict(i int, vt *_Type, numeric bool) (int, int, error) {
+    var nb int
+    var err error
+
+    /* read the key type, the value type and the count */
+    self.Sc.pos = i
+    err = self.Sc.expect('[')
+
+    /* check for errors */
+    if err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.elem(vt.K, numeric); err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.Sc.expect(','); err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.elem(vt.V, numeric); err != nil {
+        return self.Sc.pos, 0, err
+    } else if err = self.Sc.expect(','); err != nil {
+        return self.Sc.pos, 0, err
+    } else if nb, err = self.count(); err != nil {
+        return self.Sc.pos, 0, err
+    } else {
+        return self.Sc.pos, nb, nil
+    }
+}
+
+func (self *RuntimeState) elem(vt *_Type, numeric bool) error {
+    if numeric {
+        return self.expectTID(vt)
+    } else {
+        return self.expectTag(vt)
+    }
+}
+
+func (self *RuntimeState) tf(s []byte, pos int) error {
+    switch str(s) {
+        case "0" : self.Out = append(self.Out, 0); return nil
+        case "1" : self.Out = append(self.Out, 1); return nil
+        default  : self.Sc.pos = pos; return self.Sc.error("invalid bool value")
+    }
+}
+
+func (self *RuntimeState) tag() (defs.Tag, error) {
+    if s, err := self.Sc.str(); err != nil {
+        return 0, err
+    } else if tag, ok := _TJSONTags[string(s)]; !ok {
+        return 0, self.Sc.error("invalid type name " + strconv.Quote(string(s)))
+    } else {
+        return tag, nil
+    }
+}
+
+func (self *RuntimeState) expectTag(vt *_Type) error {
+    if tag, err := self.tag(); err != nil {
+        return err
+    } else if tag != vt.T {
+        return error_type(vt.T, tag)
+    } else {
+        return nil
+    }
+}
+
+func (self *RuntimeState) count() (int, error) {
+    if s, err := self.Sc.number(); err != nil {
+        return 0, err
+    } else if n, err := strconv.ParseUint(str(s), 10, 31); err != nil {
+        self.Sc.pos -= len(s)
+        return 0, self.Sc.error("invalid count: " + unwrap(err).Error())
+    } else {
+        return int(n), nil
+    }
+}
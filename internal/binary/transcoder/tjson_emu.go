@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+)
+
+func emu_gcall_write_count(ctx hir.CallContext) {
+    if !ctx.Verify("*i", "") {
+        panic("invalid write_count call")
+    } else {
+        write_count((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)))
+    }
+}
+
+func emu_gcall_read_tf(ctx hir.CallContext) {
+    if !ctx.Verify("*i", "i**") {
+        panic("invalid read_tf call")
+    } else {
+        ret, err := read_tf((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_read_tfield(ctx hir.CallContext) {
+    if !ctx.Verify("*i*i", "ii**") {
+        panic("invalid read_tfield call")
+    } else {
+        ret, idx, err := read_tfield((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Struct)(ctx.Ap(2)), int(ctx.Au(3)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(idx))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_tlist(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "ii**") {
+        panic("invalid read_tlist call")
+    } else {
+        ret, nb, err := read_tlist((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(nb))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_tmap(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "ii**") {
+        panic("invalid read_tmap call")
+    } else {
+        ret, nb, err := read_tmap((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        ctx.Ru(1, uint64(nb))
+        emu_seterr(ctx, 2, err)
+    }
+}
+
+func emu_gcall_read_tkey(ctx hir.CallContext) {
+    if !ctx.Verify("*i*", "i**") {
+        panic("invalid read_tkey call")
+    } else {
+        ret, err := read_tkey((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), (*_Type)(ctx.Ap(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `encoding/base64`
+    `math`
+    `strconv`
+    `unicode/utf8`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* JSON representation of the values:
+ *
+ *   - structs are objects, keyed by the Go field names
+ *   - bool, integers (including enums) and doubles are JSON booleans and numbers, NaN
+ *     and infinities are rejected, unless special doubles are enabled (TSimpleJSON), in
+ *     which case they are formatted as strings, "NaN", "Infinity" and "-Infinity"
+ *   - strings are JSON strings, binaries are Base64-encoded JSON strings
+ *   - lists and sets are arrays
+ *   - maps with struct keys are arrays of [key, value] pairs, other maps are objects,
+ *     with the keys formatted as JSON strings
+ */
+
+const (
+    _HexDigits = "0123456789abcdef"
+)
+
+var (
+    F_skip_field    = hir.RegisterGCall(skip_field, emu_gcall_skip_field)
+    F_write_double  = hir.RegisterGCall(write_double, emu_gcall_write_double)
+    F_write_string  = hir.RegisterGCall(write_string, emu_gcall_write_string)
+    F_write_binary  = hir.RegisterGCall(write_binary, emu_gcall_write_binary)
+    F_write_integer = hir.RegisterGCall(write_integer, emu_gcall_write_integer)
+)
+
+func skip_field(rs *RuntimeState, i int, tag defs.Tag) (int, error) {
+    if nb, err := decoder.Skip(rs.Buf[i:], tag); err != nil {
+        return i, err
+    } else {
+        return i + nb, nil
+    }
+}
+
+func write_integer(rs *RuntimeState, vt *_Type, v uint64) error {
+    var nb uint
+
+    /* the size of the wire type */
+    switch vt.T {
+        case defs.T_i8  : nb = 8
+        case defs.T_i16 : nb = 16
+        case defs.T_i32 : nb = 32
+        case defs.T_i64 : nb = 64
+        default         : panic("unreachable")
+    }
+
+    /* sign-extend the value */
+    x := int64(v << (64 - nb)) >> (64 - nb)
+    n := vt.N
+
+    /* unsigned integers must be in the range of both the wire type and the Go type */
+    if vt.F == K_unsigned && (x < 0 || n < 64 && x >> n != 0) {
+        return error_range(vt.R, x)
+    } else {
+        rs.Out = strconv.AppendInt(rs.Out, x, 10)
+        return nil
+    }
+}
+
+/* write_double formats the double, map keys are always quoted */
+func write_double(rs *RuntimeState, v uint64, key bool) error {
+    f := math.Float64frombits(v)
+
+    /* NaN and infinities cannot be represented in JSON, unless special doubles are enabled */
+    if math.IsNaN(f) || math.IsInf(f, 0) {
+        if rs.Sp == 0 {
+            return error_value(f)
+        } else {
+            rs.Out = append(append(append(rs.Out, '"'), specialDouble(f)...), '"')
+            return nil
+        }
+    }
+
+    /* normal values */
+    if !key {
+        rs.Out = strconv.AppendFloat(rs.Out, f, 'g', -1, 64)
+    } else {
+        rs.Out = append(strconv.AppendFloat(append(rs.Out, '"'), f, 'g', -1, 64), '"')
+    }
+
+    /* all done */
+    return nil
+}
+
+func specialDouble(v float64) string {
+    switch {
+        case math.IsNaN(v)    : return "NaN"
+        case math.IsInf(v, 1) : return "Infinity"
+        default               : return "-Infinity"
+    }
+}
+
+func write_string(rs *RuntimeState, i int, n int) {
+    rs.Out = appendString(rs.Out, rs.Buf[i:i + n])
+}
+
+/* binaries are Base64-encoded */
+func write_binary(rs *RuntimeState, i int, n int) {
+    rs.Out = appendBase64(rs.Out, rs.Buf[i:i + n])
+}
+
+func appendBase64(buf []byte, src []byte) []byte {
+    n := len(buf)
+    m := base64.StdEncoding.EncodedLen(len(src))
+
+    /* grow the buffer if needed */
+    if cap(buf) - n < m + 2 {
+        buf = append(make([]byte, 0, n + m + 2), buf...)
+    }
+
+    /* encode the value */
+    buf = buf[:n + m + 2]
+    buf[n], buf[n + m + 1] = '"', '"'
+    base64.StdEncoding.Encode(buf[n + 1:], src)
+    return buf
+}
+
+/* characters that are escaped, <, > and & are also escaped for HTML safety, just like encoding/json */
+var _EscapeTab = [utf8.RuneSelf]bool {
+    '"'  : true,
+    '\\' : true,
+    '<'  : true,
+    '>'  : true,
+    '&'  : true,
+}
+
+func appendString(buf []byte, src []byte) []byte {
+    i := 0
+    p := 0
+    buf = append(buf, '"')
+
+    /* escape the special characters */
+    for i < len(src) {
+        if c := src[i]; c < utf8.RuneSelf {
+            if c >= 0x20 && !_EscapeTab[c] {
+                i++
+                continue
+            }
+
+            /* flush the characters before */
+            buf = append(buf, src[p:i]...)
+            buf = append(buf, '\\')
+
+            /* check for the character */
+            switch c {
+                case '"'  : buf = append(buf, '"')
+                case '\\' : buf = append(buf, '\\')
+                case '\n' : buf = append(buf, 'n')
+                case '\r' : buf = append(buf, 'r')
+                case '\t' : buf = append(buf, 't')
+                default   : buf = append(buf, 'u', '0', '0', _HexDigits[c >> 4], _HexDigits[c & 0xf])
+            }
+
+            /* move to the next character */
+            i++
+            p = i
+            continue
+        }
+
+        /* decode the character */
+        r, n := utf8.DecodeRune(src[i:])
+
+        /* invalid UTF-8 sequences are replaced with U+FFFD, and U+2028 and U+2029 are
+         * escaped since they are not valid in JavaScript, just like encoding/json */
+        switch {
+            case r == utf8.RuneError && n == 1 : buf = append(append(buf, src[p:i]...), `\ufffd`...)
+            case r == '\u2028'                 : buf = append(append(buf, src[p:i]...), `\u2028`...)
+            case r == '\u2029'                 : buf = append(append(buf, src[p:i]...), `\u2029`...)
+            default                            : i += n; continue
+        }
+
+        /* move to the next character */
+        i += n
+        p = i
+    }
+
+    /* flush the remaining characters */
+    buf = append(buf, src[p:]...)
+    return append(buf, '"')
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+func emu_gcall_skip_field(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "i**") {
+        panic("invalid skip_field call")
+    } else {
+        ret, err := skip_field((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), defs.Tag(ctx.Au(2)))
+        ctx.Ru(0, uint64(ret))
+        emu_seterr(ctx, 1, err)
+    }
+}
+
+func emu_gcall_write_integer(ctx hir.CallContext) {
+    if !ctx.Verify("**i", "**") {
+        panic("invalid write_integer call")
+    } else {
+        emu_seterr(ctx, 0, write_integer((*RuntimeState)(ctx.Ap(0)), (*_Type)(ctx.Ap(1)), ctx.Au(2)))
+    }
+}
+
+func emu_gcall_write_double(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "**") {
+        panic("invalid write_double call")
+    } else {
+        emu_seterr(ctx, 0, write_double((*RuntimeState)(ctx.Ap(0)), ctx.Au(1), ctx.Au(2) != 0))
+    }
+}
+
+func emu_gcall_write_string(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "") {
+        panic("invalid write_string call")
+    } else {
+        write_string((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)))
+    }
+}
+
+func emu_gcall_write_binary(ctx hir.CallContext) {
+    if !ctx.Verify("*ii", "") {
+        panic("invalid write_binary call")
+    } else {
+        write_binary((*RuntimeState)(ctx.Ap(0)), int(ctx.Au(1)), int(ctx.Au(2)))
+    }
+}
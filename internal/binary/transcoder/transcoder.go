@@ -0,0 +1,223 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `reflect`
+    `runtime`
+
+    `github.com/cloudwego/frugal/internal/rt`
+    `github.com/cloudwego/frugal/internal/utils`
+)
+
+type _Mode uint8
+
+const (
+    M_ToJSON _Mode = iota
+    M_ToTJSON
+    M_ToSimpleJSON
+    M_FromJSON
+    M_FromTJSON
+    M_FromSimpleJSON
+    M_max
+)
+
+func (self _Mode) toJSON() bool {
+    return self < M_FromJSON
+}
+
+func (self _Mode) isTJSON() bool {
+    return self == M_ToTJSON || self == M_FromTJSON
+}
+
+/* isSimple checks for TSimpleJSON, see simplejson.go */
+func (self _Mode) isSimple() bool {
+    return self == M_ToSimpleJSON || self == M_FromSimpleJSON
+}
+
+/* isTyped checks if the lists and maps begin with the element types and the count */
+func (self _Mode) isTyped() bool {
+    return self.isTJSON() || self.isSimple()
+}
+
+/* boolNames returns the representations of false and true */
+func (self _Mode) boolNames(key bool) [2]string {
+    switch {
+        case self.isTJSON() && key : return [2]string { `"0"`, `"1"` }
+        case self.isTJSON()        : return [2]string { `0`, `1` }
+        case key                   : return [2]string { `"false"`, `"true"` }
+        default                    : return [2]string { `false`, `true` }
+    }
+}
+
+/* _Program is the transcoder of a struct, along with the descriptors referenced by the machine code */
+type _Program struct {
+    fn Transcoder
+    vt *_Struct
+}
+
+var (
+    programCache = utils.CreateProgramCache()
+    linkerCache  = [M_max]*utils.ProgramCache {
+        M_ToJSON         : utils.CreateProgramCache(),
+        M_ToTJSON        : utils.CreateProgramCache(),
+        M_ToSimpleJSON   : utils.CreateProgramCache(),
+        M_FromJSON       : utils.CreateProgramCache(),
+        M_FromTJSON      : utils.CreateProgramCache(),
+        M_FromSimpleJSON : utils.CreateProgramCache(),
+    }
+)
+
+func resolve(vt *rt.GoType) (*_Struct, error) {
+    if val := programCache.Get(vt); val != nil {
+        return val.(*_Struct), nil
+    } else if val, err := programCache.Compute(vt, compile); err != nil {
+        return nil, err
+    } else {
+        return val.(*_Struct), nil
+    }
+}
+
+func compile(vt *rt.GoType) (interface{}, error) {
+    if vt.Kind() != reflect.Struct {
+        return nil, utils.EType(vt.Pack(), "only structs can be transcoded")
+    } else {
+        return newCompiler().compile(vt.Pack())
+    }
+}
+
+func resolveProgram(md _Mode, vt *_Struct) *_Program {
+    pc := linkerCache[md]
+    et := rt.UnpackType(vt.vt)
+
+    /* check for the cached transcoder */
+    if val := pc.Get(et); val != nil {
+        return val.(*_Program)
+    }
+
+    /* translate and link the struct, it never fails */
+    val, _ := pc.Compute(et, func(et *rt.GoType) (interface{}, error) {
+        return &_Program { fn: Link(et, Translate(md, vt)), vt: vt }, nil
+    })
+
+    /* all done */
+    return val.(*_Program)
+}
+
+func transcode(md _Mode, vt *_Struct, rs *RuntimeState, i int, st int) (int, error) {
+    fp := resolveProgram(md, vt)
+    rv, ex := fp.fn(rs, i, st)
+
+    /* the machine code is unloaded once the transcoder becomes unreachable, keep it until it returns */
+    runtime.KeepAlive(fp)
+    return rv, ex
+}
+
+func Unload(vt *rt.GoType) bool {
+    ok := programCache.Remove(vt)
+
+    /* remove the transcoders of all modes */
+    for _, pc := range linkerCache {
+        ok = pc.Remove(vt) || ok
+    }
+
+    /* all done */
+    return ok
+}
+
+func encodeWith(md _Mode, dst []byte, vt *rt.GoType, buf []byte, spd bool) ([]byte, int, error) {
+    var st *_Struct
+    var err error
+
+    /* resolve the type */
+    if st, err = resolve(vt); err != nil {
+        return dst, 0, err
+    }
+
+    /* create a new runtime state */
+    rs := newRuntimeState()
+    rs.Buf = buf
+    rs.Out = dst
+    rs.Sp = uint64(bool2int(spd))
+
+    /* transcode the object, and return the runtime state into pool */
+    ret, err := transcode(md, st, rs, 0, 0)
+    out := rs.Out
+    freeRuntimeState(rs)
+    return out, ret, err
+}
+
+func decodeWith(md _Mode, dst []byte, vt *rt.GoType, src []byte, spd bool) ([]byte, error) {
+    var st *_Struct
+    var err error
+
+    /* resolve the type */
+    if st, err = resolve(vt); err != nil {
+        return dst, err
+    }
+
+    /* create a new runtime state */
+    rs := newRuntimeState()
+    rs.Out = dst
+    rs.Sp = uint64(bool2int(spd))
+    rs.Sc.src = src
+
+    /* transcode the object, which must be the only value in src */
+    if rs.Sc.pos, err = transcode(md, st, rs, 0, 0); err == nil {
+        err = rs.Sc.eof()
+    }
+
+    /* return the runtime state into pool */
+    out := rs.Out
+    freeRuntimeState(rs)
+    return out, err
+}
+
+// ToJSON transcodes a struct of type vt in buf from Thrift Binary Protocol into JSON, which is
+// appended to dst, and returns the extended buffer and the number of bytes consumed from buf.
+func ToJSON(dst []byte, vt *rt.GoType, buf []byte) ([]byte, int, error) {
+    return encodeWith(M_ToJSON, dst, vt, buf, false)
+}
+
+// FromJSON transcodes a struct of type vt in src from JSON into Thrift Binary Protocol, which is
+// appended to dst, and returns the extended buffer.
+func FromJSON(dst []byte, vt *rt.GoType, src []byte) ([]byte, error) {
+    return decodeWith(M_FromJSON, dst, vt, src, false)
+}
+
+// ToSimpleJSON is like ToJSON, but with the fields named after the "thrift" tags (or the Go
+// field names without them), and the special doubles (NaN and infinities) formatted as
+// strings, which is the same as TSimpleJSONProtocol of Apache Thrift.
+func ToSimpleJSON(dst []byte, vt *rt.GoType, buf []byte) ([]byte, int, error) {
+    return encodeWith(M_ToSimpleJSON, dst, vt, buf, true)
+}
+
+// FromSimpleJSON is the inverse of ToSimpleJSON.
+func FromSimpleJSON(dst []byte, vt *rt.GoType, src []byte) ([]byte, error) {
+    return decodeWith(M_FromSimpleJSON, dst, vt, src, true)
+}
+
+// ToTJSON transcodes a struct of type vt in buf from Thrift Binary Protocol into TJSONProtocol
+// of Apache Thrift, which is appended to dst.
+func ToTJSON(dst []byte, vt *rt.GoType, buf []byte) ([]byte, int, error) {
+    return encodeWith(M_ToTJSON, dst, vt, buf, true)
+}
+
+// FromTJSON is the inverse of ToTJSON.
+func FromTJSON(dst []byte, vt *rt.GoType, src []byte) ([]byte, error) {
+    return decodeWith(M_FromTJSON, dst, vt, src, true)
+}
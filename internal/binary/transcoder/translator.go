@@ -0,0 +1,345 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `encoding/binary`
+    `fmt`
+    `strconv`
+
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/** Function Prototype
+ *
+ *      func(rs *RuntimeState, i int, st int) (pos int, err error)
+ */
+
+const (
+    ARG_rs = 0
+    ARG_i  = 1
+    ARG_st = 2
+)
+
+/** Register Allocations
+ *
+ *      P2      Input Buffer Pointer, only when transcoding from Thrift Binary Protocol
+ *      P3      Runtime State Pointer
+ *      P4      Error Type Pointer
+ *      P5      Error Value Pointer
+ *
+ *      R2      Input Cursor, which is the offset of the JSON scanner when transcoding from JSON
+ *      R3      State Index
+ */
+
+const (
+    IP = hir.P2
+    RS = hir.P3
+    ET = hir.P4 // may also be used as a temporary pointer register
+    EP = hir.P5 // may also be used as a temporary pointer register
+)
+
+const (
+    IC = hir.R2
+    ST = hir.R3
+)
+
+const (
+    TP = hir.P0
+    TR = hir.R0
+    UR = hir.R1
+    TX = hir.R4
+)
+
+const (
+    LB_eof     = "_eof"
+    LB_halt    = "_halt"
+    LB_type    = "_type"
+    LB_error   = "_error"
+    LB_stack   = "_stack"
+    LB_missing = "_missing"
+)
+
+/* Every struct is translated into a function of its own, nested structs are transcoded
+ * by calling into the functions of their types, while containers are inlined. Each
+ * struct or container takes at least one state (StateItem) to track the number of
+ * fields or elements transcoded, structs with more than 64 required fields take one
+ * more state for every 64 fields for the bitmap. */
+
+type _Translator struct {
+    p  *hir.Builder
+    md _Mode
+    id int
+}
+
+func Translate(md _Mode, vt *_Struct) hir.Program {
+    p := hir.CreateBuilder()
+    t := &_Translator { p: p, md: md }
+
+    /* translate the struct */
+    t.prologue()
+    t.structValue(vt)
+    t.epilogue()
+    t.errors()
+    return p.Build()
+}
+
+func (self *_Translator) next() int {
+    self.id++
+    return self.id
+}
+
+func (self *_Translator) label(name string, id int) string {
+    return fmt.Sprintf("_%s_%d", name, id)
+}
+
+func (self *_Translator) prologue() {
+    self.p.LDAP  (ARG_rs, RS)
+    self.p.LDAQ  (ARG_i, IC)
+    self.p.LDAQ  (ARG_st, ST)
+
+    /* the input buffer of Thrift Binary Protocol */
+    if self.md.toJSON() {
+        self.p.LP(RS, BpOffset, IP)
+    }
+}
+
+func (self *_Translator) epilogue() {
+    self.p.Label (LB_halt)
+    self.p.MOVP  (hir.Pn, ET)
+    self.p.MOVP  (hir.Pn, EP)
+    self.p.Label (LB_error)
+    self.p.RET   ().
+           R0    (IC).
+           R1    (ET).
+           R2    (EP)
+}
+
+func (self *_Translator) errors() {
+    p := self.p
+    p.Label (LB_eof)
+    p.SUB   (UR, TX, UR)
+    p.GCALL (F_error_eof).
+      A0    (UR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label (LB_type)
+    p.GCALL (F_error_type).
+      A0    (UR).
+      A1    (TR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label (LB_missing)
+    p.GCALL (F_error_missing).
+      A0    (TP).
+      A1    (UR).
+      A2    (TR).
+      R0    (ET).
+      R1    (EP)
+    p.JMP   (LB_error)
+    p.Label (LB_stack)
+    p.IP    (&_E_stack, TP)
+    p.LP    (TP, 0, ET)
+    p.LP    (TP, 8, EP)
+    p.JMP   (LB_error)
+}
+
+func (self *_Translator) structValue(vt *_Struct) {
+    if self.md.toJSON() {
+        self.toStruct(vt)
+    } else {
+        self.fromStruct(vt)
+    }
+}
+
+/* call transcodes a nested struct by calling the function of its type */
+func (self *_Translator) call(vt *_Struct) {
+    self.p.IP    (vt, TP)
+    self.p.IB    (int8(self.md), UR)
+    self.p.GCALL (F_transcode).
+           A0    (UR).
+           A1    (TP).
+           A2    (RS).
+           A3    (IC).
+           A4    (ST).
+           R0    (IC).
+           R1    (ET).
+           R2    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+}
+
+/** State Management **/
+
+func frames(vt *_Struct) int64 {
+    return 1 + int64(len(vt.reqs) - 1) / 64
+}
+
+/* slot is the displacement of a field in the j-th of the nf states, relative to the state index */
+func slot(nf int64, j int64, offs int64) int64 {
+    return (j - nf) * StateSize + offs
+}
+
+/* push allocates nf zeroed states, the states are addressed with slot */
+func (self *_Translator) push(nf int64) {
+    self.p.ADDI  (ST, nf * StateSize, ST)
+    self.p.IQ    (StateLen, TX)
+    self.p.BLTU  (TX, ST, LB_stack)
+    self.p.ADDP  (RS, ST, TP)
+
+    /* clear the states */
+    for j := int64(0); j < nf; j++ {
+        self.p.SQ(hir.Rz, TP, slot(nf, j, NbOffset))
+        self.p.SQ(hir.Rz, TP, slot(nf, j, NxOffset))
+        self.p.SQ(hir.Rz, TP, slot(nf, j, FmOffset))
+    }
+}
+
+func (self *_Translator) pop(nf int64) {
+    self.p.SUBI  (ST, nf * StateSize, ST)
+}
+
+/* delim adds the delimiter before every field or element except the first one, by counting them in the first state */
+func (self *_Translator) delim(nf int64, s string) {
+    id := self.next()
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(nf, 0, NbOffset), UR)
+    self.p.ADDI  (UR, 1, TX)
+    self.p.SQ    (TX, TP, slot(nf, 0, NbOffset))
+    self.p.BEQ   (UR, hir.Rz, self.label("first", id))
+    self.emit    (s)
+    self.p.Label (self.label("first", id))
+}
+
+/* iterate counts the elements in the state, and jumps to end once all the elements are
+ * transcoded, UR holds the number of elements transcoded before this one */
+func (self *_Translator) iterate(end string) {
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(1, 0, NbOffset), UR)
+    self.p.LQ    (TP, slot(1, 0, NxOffset), TR)
+    self.p.BEQ   (UR, TR, end)
+    self.p.ADDI  (UR, 1, TR)
+    self.p.SQ    (TR, TP, slot(1, 0, NbOffset))
+}
+
+/* mark sets the bit of the i-th required field */
+func (self *_Translator) mark(nf int64, i int) {
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(nf, int64(i / 64), FmOffset), TR)
+    self.p.BSI   (TR, int64(i % 64), TR)
+    self.p.SQ    (TR, TP, slot(nf, int64(i / 64), FmOffset))
+}
+
+/* require checks for the bits of all the required fields */
+func (self *_Translator) require(vt *_Struct, nf int64) {
+    for w := 0; w * 64 < len(vt.reqs); w++ {
+        n := len(vt.reqs) - w * 64
+
+        /* the last word may not be full */
+        if n > 64 {
+            n = 64
+        }
+
+        /* the mask of the word */
+        m := ^uint64(0) >> (64 - n)
+
+        /* jump to the error if any bit is not set */
+        self.p.ADDP  (RS, ST, TP)
+        self.p.LQ    (TP, slot(nf, int64(w), FmOffset), TR)
+        self.p.XORI  (TR, int64(m), TR)
+        self.p.IQ    (int64(w), UR)
+        self.p.IP    (vt, TP)
+        self.p.BNE   (TR, hir.Rz, LB_missing)
+    }
+}
+
+/** Output Buffer **/
+
+/* reserve makes room for nb bytes in the output buffer, and points TP to the reserved
+ * bytes, with the offset of them in UR, it clobbers TR and TX */
+func (self *_Translator) reserve(nb int64) {
+    id := self.next()
+    self.p.LQ    (RS, OnOffset, UR)
+    self.p.ADDI  (UR, nb, TR)
+    self.p.LQ    (RS, OcOffset, TX)
+    self.p.BGEU  (TX, TR, self.label("room", id))
+    self.p.GCALL (F_grow).
+           A0    (RS).
+           A1    (TR)
+    self.p.Label (self.label("room", id))
+    self.p.LP    (RS, OpOffset, TP)
+    self.p.ADDP  (TP, UR, TP)
+    self.p.SQ    (TR, RS, OnOffset)
+}
+
+/* emit writes the constant bytes to the output buffer, see reserve for the clobbered registers */
+func (self *_Translator) emit(s string) {
+    i := 0
+    self.reserve(int64(len(s)))
+
+    /* store the bytes as immediate values, as wide as possible */
+    for i < len(s) {
+        switch n := len(s) - i; {
+            case n >= 8 : self.p.IQ(int64(binary.LittleEndian.Uint64([]byte(s[i:]))), TX); self.p.SQ(TX, TP, int64(i)); i += 8
+            case n >= 4 : self.p.IL(int32(binary.LittleEndian.Uint32([]byte(s[i:]))), TX); self.p.SL(TX, TP, int64(i)); i += 4
+            case n >= 2 : self.p.IW(int16(binary.LittleEndian.Uint16([]byte(s[i:]))), TX); self.p.SW(TX, TP, int64(i)); i += 2
+            default     : self.p.IB(int8(s[i]), TX); self.p.SB(TX, TP, int64(i)); i += 1
+        }
+    }
+}
+
+/* header writes the header of a field of Thrift Binary Protocol */
+func (self *_Translator) header(fv *_Field) {
+    self.emit(string([]byte { byte(fv.Type.T), byte(fv.ID >> 8), byte(fv.ID) }))
+}
+
+/* size writes the element count of Thrift Binary Protocol from TR at offset UR of the output */
+func (self *_Translator) size() {
+    self.p.LP    (RS, OpOffset, TP)
+    self.p.ADDP  (TP, UR, TP)
+    self.p.SWAPL (TR, TR)
+    self.p.SL    (TR, TP, 0)
+}
+
+/** Common Checks **/
+
+/* mapkey rejects the map keys that cannot be formatted as strings in TJSON, it returns false if rejected */
+func (self *_Translator) mapkey(vt *_Type) bool {
+    if self.md.isTJSON() && !isStringKey(vt.K) {
+        self.p.IP    (vt.K, TP)
+        self.p.GCALL (F_error_mapkey).
+               A0    (TP).
+               R0    (ET).
+               R1    (EP)
+        self.p.JMP   (LB_error)
+        return false
+    } else {
+        return true
+    }
+}
+
+/* typeName formats the element type of the containers, which is the type name in TJSON,
+ * or the type ID in TSimpleJSON */
+func (self *_Translator) typeName(t defs.Tag) string {
+    if self.md.isSimple() {
+        return strconv.Itoa(int(t))
+    } else {
+        return `"` + _TJSONNames[t] + `"`
+    }
+}
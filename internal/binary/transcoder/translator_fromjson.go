@@ -0,0 +1,405 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* read calls the scanning function, with the type descriptor if any */
+func (self *_Translator) read(fn *hir.CallHandle, vt *_Type) {
+    if vt == nil {
+        self.p.GCALL (fn).
+               A0    (RS).
+               A1    (IC).
+               R0    (IC).
+               R1    (ET).
+               R2    (EP)
+    } else {
+        self.p.IP    (vt, TP)
+        self.p.GCALL (fn).
+               A0    (RS).
+               A1    (IC).
+               A2    (TP).
+               R0    (IC).
+               R1    (ET).
+               R2    (EP)
+    }
+
+    /* check for errors */
+    self.p.BNEP(ET, hir.Pn, LB_error)
+}
+
+func (self *_Translator) expect(c byte) {
+    self.p.IB    (int8(c), UR)
+    self.p.GCALL (F_read_expect).
+           A0    (RS).
+           A1    (IC).
+           A2    (UR).
+           R0    (IC).
+           R1    (ET).
+           R2    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+}
+
+/* more checks for more fields or elements before the delimiter, and jumps to end if none,
+ * the fields or elements are counted in the first state */
+func (self *_Translator) more(nf int64, delim byte, end string) {
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(nf, 0, NbOffset), TR)
+    self.p.IB    (int8(delim), UR)
+    self.p.GCALL (F_read_next).
+           A0    (RS).
+           A1    (IC).
+           A2    (UR).
+           A3    (TR).
+           R0    (IC).
+           R1    (TR).
+           R2    (ET).
+           R3    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.p.BEQ   (TR, hir.Rz, end)
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(nf, 0, NbOffset), TR)
+    self.p.ADDI  (TR, 1, TR)
+    self.p.SQ    (TR, TP, slot(nf, 0, NbOffset))
+}
+
+/* begin writes the header of a list or a map, of which the element count is either
+ * updated later, or already in TR if known */
+func (self *_Translator) begin(known bool, tags ...*_Type) {
+    buf := make([]byte, 0, 6)
+
+    /* the element types, followed by the count */
+    for _, vt := range tags {
+        buf = append(buf, byte(vt.T))
+    }
+
+    /* the count is kept in the state if known, since emit clobbers TR */
+    if known {
+        self.p.ADDP (RS, ST, TP)
+        self.p.SQ   (TR, TP, slot(1, 0, NxOffset))
+    }
+
+    /* UR points to the count after the header is written */
+    self.emit   (string(append(buf, 0, 0, 0, 0)))
+    self.p.ADDI (UR, int64(len(tags)), UR)
+    self.p.ADDP (RS, ST, TP)
+
+    /* write the count if known, otherwise remember the offset of it */
+    if known {
+        self.p.LQ (TP, slot(1, 0, NxOffset), TR)
+        self.size ()
+    } else {
+        self.p.SQ (UR, TP, slot(1, 0, NxOffset))
+    }
+}
+
+/* end updates the element count of the list or the map */
+func (self *_Translator) end() {
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(1, 0, NxOffset), UR)
+    self.p.LQ    (TP, slot(1, 0, NbOffset), TR)
+    self.size    ()
+}
+
+func (self *_Translator) fromStruct(vt *_Struct) {
+    fn := F_read_field
+    id := self.next()
+    nf := frames(vt)
+    sw := make([]string, len(vt.fields))
+
+    /* every field has a label */
+    for i := range vt.fields {
+        sw[i] = self.label("field", self.next())
+    }
+
+    /* TJSON keys the fields by IDs, and TSimpleJSON by the names in the IDL */
+    if self.md.isTJSON() {
+        fn = F_read_tfield
+    } else if self.md.isSimple() {
+        fn = F_read_sfield
+    }
+
+    /* open the object */
+    self.push   (nf)
+    self.expect ('{')
+
+    /* read the key, and dispatch the field by index */
+    self.p.Label (self.label("next", id))
+    self.more    (nf, '}', self.label("stop", id))
+    self.p.IP    (vt, TP)
+    self.p.GCALL (fn).
+           A0    (RS).
+           A1    (IC).
+           A2    (TP).
+           A3    (ST).
+           R0    (IC).
+           R1    (TR).
+           R2    (ET).
+           R3    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.p.BSW   (TR, sw)
+
+    /* the field is ignored */
+    self.p.JMP(self.label("next", id))
+
+    /* transcode every field */
+    for i := range vt.fields {
+        fv := &vt.fields[i]
+        self.p.Label   (sw[i])
+        self.header    (fv)
+        self.fromValue (fv.Type)
+
+        /* close the object of the field value */
+        if self.md.isTJSON() {
+            self.expect('}')
+        }
+
+        /* mark the field as seen if required */
+        if fv.Required {
+            self.mark(nf, vt.required(i))
+        }
+
+        /* move to the next field */
+        self.p.JMP(self.label("next", id))
+    }
+
+    /* check for required fields, and add the STOP field */
+    self.p.Label (self.label("stop", id))
+    self.require (vt, nf)
+    self.pop     (nf)
+    self.emit    ("\x00")
+}
+
+func (self *_Translator) fromValue(vt *_Type) {
+    switch vt.T {
+        case defs.T_bool   : self.fromBool()
+        case defs.T_i8     : self.read(F_read_number, vt)
+        case defs.T_i16    : self.read(F_read_number, vt)
+        case defs.T_i32    : self.read(F_read_number, vt)
+        case defs.T_i64    : self.read(F_read_number, vt)
+        case defs.T_double : self.read(F_read_number, vt)
+        case defs.T_string : self.read(F_read_string, vt)
+        case defs.T_struct : self.call(vt.S)
+        case defs.T_map    : self.fromMap(vt)
+        case defs.T_set    : self.fromList(vt)
+        case defs.T_list   : self.fromList(vt)
+        default            : panic("unreachable")
+    }
+}
+
+/* booleans are numbers in TJSON */
+func (self *_Translator) fromBool() {
+    if self.md.isTJSON() {
+        self.read(F_read_tf, nil)
+    } else {
+        self.read(F_read_bool, nil)
+    }
+}
+
+func (self *_Translator) fromList(vt *_Type) {
+    if self.md.isTyped() {
+        self.fromTList(vt)
+    } else {
+        self.fromJList(vt)
+    }
+}
+
+func (self *_Translator) fromMap(vt *_Type) {
+    switch {
+        case self.md.isTJSON()  : self.fromTMap(vt)
+        case self.md.isSimple() : self.fromSMap(vt)
+        default                 : self.fromJMap(vt)
+    }
+}
+
+func (self *_Translator) fromJList(vt *_Type) {
+    id := self.next()
+    self.push   (1)
+    self.expect ('[')
+    self.begin  (false, vt.V)
+
+    /* transcode every element */
+    self.p.Label   (self.label("next", id))
+    self.more      (1, ']', self.label("end", id))
+    self.fromValue (vt.V)
+    self.p.JMP     (self.label("next", id))
+    self.p.Label   (self.label("end", id))
+
+    /* arrays must have the exact length */
+    if vt.F == K_array {
+        self.p.ADDP  (RS, ST, TP)
+        self.p.LQ    (TP, slot(1, 0, NbOffset), TR)
+        self.p.IQ    (int64(vt.N), UR)
+        self.p.BEQ   (TR, UR, self.label("size", id))
+        self.p.IP    (vt, TP)
+        self.p.GCALL (F_error_length).
+               A0    (TP).
+               A1    (TR).
+               R0    (ET).
+               R1    (EP)
+        self.p.JMP   (LB_error)
+        self.p.Label (self.label("size", id))
+    }
+
+    /* update the count */
+    self.end()
+    self.pop(1)
+}
+
+func (self *_Translator) fromJMap(vt *_Type) {
+    id := self.next()
+    self.push(1)
+
+    /* maps with struct keys are arrays of key-value pairs */
+    if vt.K.T == defs.T_struct {
+        self.expect('[')
+    } else {
+        self.expect('{')
+    }
+
+    /* transcode every key-value pair */
+    self.begin   (false, vt.K, vt.V)
+    self.p.Label (self.label("next", id))
+
+    /* every pair is an array of the key and the value, or a key-value pair of the object */
+    if vt.K.T == defs.T_struct {
+        self.more      (1, ']', self.label("end", id))
+        self.expect    ('[')
+        self.fromValue (vt.K)
+        self.expect    (',')
+        self.fromValue (vt.V)
+        self.expect    (']')
+    } else {
+        self.more      (1, '}', self.label("end", id))
+        self.read      (F_read_key, vt.K)
+        self.fromValue (vt.V)
+    }
+
+    /* update the count */
+    self.p.JMP   (self.label("next", id))
+    self.p.Label (self.label("end", id))
+    self.end     ()
+    self.pop     (1)
+}
+
+func (self *_Translator) fromTList(vt *_Type) {
+    fn := F_read_tlist
+    id := self.next()
+
+    /* TSimpleJSON has the type IDs instead of the type names */
+    if self.md.isSimple() {
+        fn = F_read_slist
+    }
+
+    /* read the header */
+    self.push    (1)
+    self.p.IP    (vt, TP)
+    self.p.GCALL (fn).
+           A0    (RS).
+           A1    (IC).
+           A2    (TP).
+           R0    (IC).
+           R1    (TR).
+           R2    (ET).
+           R3    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.begin   (true, vt.V)
+
+    /* transcode every element, which is preceded by a comma */
+    self.p.Label   (self.label("next", id))
+    self.iterate   (self.label("end", id))
+    self.expect    (',')
+    self.fromValue (vt.V)
+    self.p.JMP     (self.label("next", id))
+
+    /* close the array */
+    self.p.Label (self.label("end", id))
+    self.expect  (']')
+    self.pop     (1)
+}
+
+func (self *_Translator) fromTMap(vt *_Type) {
+    id := self.next()
+    self.push    (1)
+    self.p.IP    (vt, TP)
+    self.p.GCALL (F_read_tmap).
+           A0    (RS).
+           A1    (IC).
+           A2    (TP).
+           R0    (IC).
+           R1    (TR).
+           R2    (ET).
+           R3    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+
+    /* TJSON does not support keys that cannot be formatted as strings */
+    if !self.mapkey(vt) {
+        return
+    }
+
+    /* transcode every key-value pair */
+    self.begin   (true, vt.K, vt.V)
+    self.p.Label (self.label("next", id))
+    self.iterate (self.label("end", id))
+    self.p.BEQ   (UR, hir.Rz, self.label("first", id))
+    self.expect  (',')
+    self.p.Label (self.label("first", id))
+
+    /* transcode the pair */
+    self.read      (F_read_tkey, vt.K)
+    self.fromValue (vt.V)
+    self.p.JMP     (self.label("next", id))
+
+    /* close the object and the array */
+    self.p.Label (self.label("end", id))
+    self.expect  ('}')
+    self.expect  (']')
+    self.pop     (1)
+}
+
+func (self *_Translator) fromSMap(vt *_Type) {
+    id := self.next()
+    self.push    (1)
+    self.p.IP    (vt, TP)
+    self.p.GCALL (F_read_smap).
+           A0    (RS).
+           A1    (IC).
+           A2    (TP).
+           R0    (IC).
+           R1    (TR).
+           R2    (ET).
+           R3    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+
+    /* transcode every key-value pair, the keys and the values are preceded by commas */
+    self.begin     (true, vt.K, vt.V)
+    self.p.Label   (self.label("next", id))
+    self.iterate   (self.label("end", id))
+    self.expect    (',')
+    self.fromValue (vt.K)
+    self.expect    (',')
+    self.fromValue (vt.V)
+    self.p.JMP     (self.label("next", id))
+
+    /* close the array */
+    self.p.Label (self.label("end", id))
+    self.expect  (']')
+    self.pop     (1)
+}
@@ -0,0 +1,374 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcoder
+
+import (
+    `github.com/cloudwego/frugal/internal/atm/hir`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+)
+
+/* check jumps to the EOF error if the input has less than nb bytes, it clobbers UR and TX */
+func (self *_Translator) check(nb int64) {
+    self.p.ADDI  (IC, nb, UR)
+    self.p.LQ    (RS, BnOffset, TX)
+    self.p.BLTU  (TX, UR, LB_eof)
+}
+
+func (self *_Translator) toStruct(vt *_Struct) {
+    id := self.next()
+    nf := frames(vt)
+    ns := 0
+
+    /* the switch table of field IDs */
+    for _, fv := range vt.fields {
+        if int(fv.ID) >= ns {
+            ns = int(fv.ID) + 1
+        }
+    }
+
+    /* every field has a label */
+    sw := make([]string, ns)
+    for _, fv := range vt.fields {
+        sw[fv.ID] = self.label("field", self.next())
+    }
+
+    /* open the object */
+    self.push(nf)
+    self.emit("{")
+
+    /* read the field type, and check for the STOP field */
+    self.p.Label (self.label("next", id))
+    self.check   (1)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LB    (EP, 0, TR)
+    self.p.ADDI  (IC, 1, IC)
+    self.p.BEQ   (TR, hir.Rz, self.label("stop", id))
+
+    /* read and dispatch the field ID */
+    self.check   (2)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LW    (EP, 0, UR)
+    self.p.SWAPW (UR, UR)
+    self.p.ADDI  (IC, 2, IC)
+    self.p.BSW   (UR, sw)
+
+    /* fields that are not in the struct or with mismatched types are skipped */
+    self.p.Label (self.label("skip", id))
+    self.p.GCALL (F_skip_field).
+           A0    (RS).
+           A1    (IC).
+           A2    (TR).
+           R0    (IC).
+           R1    (ET).
+           R2    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.p.JMP   (self.label("next", id))
+
+    /* transcode every field */
+    for i, fv := range vt.fields {
+        self.p.Label (sw[fv.ID])
+        self.p.IB    (int8(fv.Type.T), UR)
+        self.p.BNE   (TR, UR, self.label("skip", id))
+        self.delim   (nf, ",")
+
+        /* TJSON keys the fields by IDs, and wraps the values in objects keyed by the type names */
+        if self.md.isSimple() {
+            self.emit(string(fv.Alias))
+            self.toValue(fv.Type)
+        } else if !self.md.isTJSON() {
+            self.emit(string(fv.Key))
+            self.toValue(fv.Type)
+        } else {
+            self.emit(string(fv.Tag))
+            self.toValue(fv.Type)
+            self.emit("}")
+        }
+
+        /* mark the field as seen if required */
+        if fv.Required {
+            self.mark(nf, vt.required(i))
+        }
+
+        /* move to the next field */
+        self.p.JMP(self.label("next", id))
+    }
+
+    /* check for the required fields, and close the object */
+    self.p.Label (self.label("stop", id))
+    self.require (vt, nf)
+    self.pop     (nf)
+    self.emit    ("}")
+}
+
+func (self *_Translator) toValue(vt *_Type) {
+    switch vt.T {
+        case defs.T_bool   : self.toBool(self.md.boolNames(false))
+        case defs.T_i8     : self.toInt(vt, 1)
+        case defs.T_i16    : self.toInt(vt, 2)
+        case defs.T_i32    : self.toInt(vt, 4)
+        case defs.T_i64    : self.toInt(vt, 8)
+        case defs.T_double : self.toDouble(false)
+        case defs.T_string : self.toString(vt)
+        case defs.T_struct : self.call(vt.S)
+        case defs.T_map    : self.toMap(vt)
+        case defs.T_set    : self.toList(vt)
+        case defs.T_list   : self.toList(vt)
+        default            : panic("unreachable")
+    }
+}
+
+func (self *_Translator) toBool(names [2]string) {
+    id := self.next()
+    self.check   (1)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LB    (EP, 0, TR)
+    self.p.ADDI  (IC, 1, IC)
+    self.p.BEQ   (TR, hir.Rz, self.label("false", id))
+    self.emit    (names[1])
+    self.p.JMP   (self.label("bool", id))
+    self.p.Label (self.label("false", id))
+    self.emit    (names[0])
+    self.p.Label (self.label("bool", id))
+}
+
+func (self *_Translator) toInt(vt *_Type, nb int64) {
+    self.check  (nb)
+    self.p.ADDP (IP, IC, EP)
+
+    /* integers are big-endian */
+    switch nb {
+        case 1  : self.p.LB(EP, 0, TR)
+        case 2  : self.p.LW(EP, 0, TR); self.p.SWAPW(TR, TR)
+        case 4  : self.p.LL(EP, 0, TR); self.p.SWAPL(TR, TR)
+        case 8  : self.p.LQ(EP, 0, TR); self.p.SWAPQ(TR, TR)
+        default : panic("unreachable")
+    }
+
+    /* format the value */
+    self.p.IP    (vt, TP)
+    self.p.GCALL (F_write_integer).
+           A0    (RS).
+           A1    (TP).
+           A2    (TR).
+           R0    (ET).
+           R1    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.p.ADDI  (IC, nb, IC)
+}
+
+func (self *_Translator) toDouble(key bool) {
+    self.check   (8)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LQ    (EP, 0, TR)
+    self.p.SWAPQ (TR, TR)
+    self.p.IB    (int8(bool2int(key)), UR)
+    self.p.GCALL (F_write_double).
+           A0    (RS).
+           A1    (TR).
+           A2    (UR).
+           R0    (ET).
+           R1    (EP)
+    self.p.BNEP  (ET, hir.Pn, LB_error)
+    self.p.ADDI  (IC, 8, IC)
+}
+
+func (self *_Translator) toString(vt *_Type) {
+    fn := F_write_string
+    self.check   (4)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LL    (EP, 0, TR)
+    self.p.SWAPL (TR, TR)
+    self.p.ADDI  (IC, 4, IC)
+
+    /* check for the length */
+    self.p.ADD   (IC, TR, UR)
+    self.p.LQ    (RS, BnOffset, TX)
+    self.p.BLTU  (TX, UR, LB_eof)
+
+    /* binaries are Base64-encoded */
+    if vt.isBinary() {
+        fn = F_write_binary
+    }
+
+    /* format the string */
+    self.p.GCALL (fn).
+           A0    (RS).
+           A1    (IC).
+           A2    (TR)
+    self.p.ADD   (IC, TR, IC)
+}
+
+/* toKey formats the map keys as JSON strings */
+func (self *_Translator) toKey(vt *_Type) {
+    switch vt.T {
+        case defs.T_bool   : self.toBool(self.md.boolNames(true))
+        case defs.T_double : self.toDouble(true)
+        case defs.T_string : self.toString(vt)
+        default            : self.emit(`"`); self.toValue(vt); self.emit(`"`)
+    }
+}
+
+/* toHeader reads the header of a list or a map, and stores the element count in the state */
+func (self *_Translator) toHeader(nb int64, tags ...*_Type) {
+    id := self.next()
+    self.check   (nb)
+    self.push    (1)
+    self.p.ADDP  (IP, IC, EP)
+    self.p.LL    (EP, nb - 4, UR)
+    self.p.SWAPL (UR, UR)
+    self.p.ADDP  (RS, ST, TP)
+    self.p.SQ    (UR, TP, slot(1, 0, NxOffset))
+    self.p.BEQ   (UR, hir.Rz, self.label("empty", id))
+
+    /* the element types must match, unless the container is empty */
+    for i, vt := range tags {
+        self.p.LB  (EP, int64(i), TR)
+        self.p.IB  (int8(vt.T), UR)
+        self.p.BNE (TR, UR, LB_type)
+    }
+
+    /* skip the header */
+    self.p.Label (self.label("empty", id))
+    self.p.ADDI  (IC, nb, IC)
+}
+
+/* toCount writes the element count of TJSON */
+func (self *_Translator) toCount() {
+    self.p.ADDP  (RS, ST, TP)
+    self.p.LQ    (TP, slot(1, 0, NxOffset), TR)
+    self.p.GCALL (F_write_count).
+           A0    (RS).
+           A1    (TR)
+}
+
+func (self *_Translator) toList(vt *_Type) {
+    id := self.next()
+    self.toHeader(5, vt.V)
+
+    /* TJSON and TSimpleJSON arrays begin with the element type and the count */
+    if !self.md.isTyped() {
+        self.emit("[")
+    } else {
+        self.emit("[" + self.typeName(vt.V.T) + ",")
+        self.toCount()
+    }
+
+    /* transcode every element */
+    self.p.Label (self.label("next", id))
+    self.iterate (self.label("end", id))
+
+    /* add the delimiter, there is always one after the count */
+    if !self.md.isTyped() {
+        self.p.BEQ   (UR, hir.Rz, self.label("first", id))
+        self.emit    (",")
+        self.p.Label (self.label("first", id))
+    } else {
+        self.emit(",")
+    }
+
+    /* transcode the element */
+    self.toValue (vt.V)
+    self.p.JMP   (self.label("next", id))
+    self.p.Label (self.label("end", id))
+    self.pop     (1)
+    self.emit    ("]")
+}
+
+func (self *_Translator) toMap(vt *_Type) {
+    id := self.next()
+    self.toHeader(6, vt.K, vt.V)
+
+    /* TJSON does not support keys that cannot be formatted as strings */
+    if !self.mapkey(vt) {
+        return
+    }
+
+    /* TSimpleJSON maps are arrays of the key type, the value type, the count, and the keys and
+     * the values in turn, each of them after a comma */
+    if self.md.isSimple() {
+        self.toSMap(vt)
+        return
+    }
+
+    /* maps with struct keys are arrays of key-value pairs in JSON, TJSON maps are arrays
+     * of the key type, the value type, the count and an object of the pairs */
+    switch {
+        case self.md.isTJSON()       : self.emit("[" + self.typeName(vt.K.T) + "," + self.typeName(vt.V.T) + ","); self.toCount(); self.emit(",{")
+        case vt.K.T == defs.T_struct : self.emit("[")
+        default                      : self.emit("{")
+    }
+
+    /* transcode every key-value pair */
+    self.p.Label (self.label("next", id))
+    self.iterate (self.label("end", id))
+    self.p.BEQ   (UR, hir.Rz, self.label("first", id))
+    self.emit    (",")
+    self.p.Label (self.label("first", id))
+
+    /* transcode the pair */
+    if vt.K.T == defs.T_struct {
+        self.emit    ("[")
+        self.toValue (vt.K)
+        self.emit    (",")
+        self.toValue (vt.V)
+        self.emit    ("]")
+    } else {
+        self.toKey   (vt.K)
+        self.emit    (":")
+        self.toValue (vt.V)
+    }
+
+    /* close the map */
+    self.p.JMP   (self.label("next", id))
+    self.p.Label (self.label("end", id))
+    self.pop     (1)
+
+    /* close the object or the array */
+    switch {
+        case self.md.isTJSON()       : self.emit("}]")
+        case vt.K.T == defs.T_struct : self.emit("]")
+        default                      : self.emit("}")
+    }
+}
+
+func (self *_Translator) toSMap(vt *_Type) {
+    id := self.next()
+    self.emit    ("[" + self.typeName(vt.K.T) + "," + self.typeName(vt.V.T) + ",")
+    self.toCount ()
+
+    /* transcode every key-value pair, the keys are plain values */
+    self.p.Label (self.label("next", id))
+    self.iterate (self.label("end", id))
+    self.emit    (",")
+    self.toValue (vt.K)
+    self.emit    (",")
+    self.toValue (vt.V)
+    self.p.JMP   (self.label("next", id))
+
+    /* close the array */
+    self.p.Label (self.label("end", id))
+    self.pop     (1)
+    self.emit    ("]")
+}
+
+func bool2int(v bool) int {
+    if v {
+        return 1
+    } else {
+        return 0
+    }
+}
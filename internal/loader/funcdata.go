@@ -27,6 +27,10 @@ const (
     _PCDATA_UnsafePointUnsafe = -2
 )
 
+//go:linkname firstmoduledata runtime.firstmoduledata
+//goland:noinspection GoUnusedGlobalVariable
+var firstmoduledata _ModuleData
+
 //go:linkname lastmoduledatap runtime.lastmoduledatap
 //goland:noinspection GoUnusedGlobalVariable
 var lastmoduledatap *_ModuleData
@@ -36,7 +40,7 @@ func moduledataverify1(_ *_ModuleData)
 
 var (
     modLock sync.Mutex
-    modList []*_ModuleData
+    modList = make(map[*_ModuleData]*_FindFuncBucket)
 )
 
 func toZigzag(v int) int {
@@ -72,10 +76,35 @@ func encodeVariant(v int) []byte {
     return r
 }
 
-func registerModule(mod *_ModuleData) {
+func registerModule(mod *_ModuleData, ftab *_FindFuncBucket) {
     modLock.Lock()
-    modList = append(modList, mod)
+    modList[mod] = ftab
     lastmoduledatap.next = mod
     lastmoduledatap = mod
     modLock.Unlock()
 }
+
+func unregisterModule(mod *_ModuleData) {
+    modLock.Lock()
+    defer modLock.Unlock()
+
+    /* find the previous module, the runtime only ever walks the list forward, so
+     * unlinking the module is safe even when someone else is walking through it */
+    for p := &firstmoduledata; p != nil; p = p.next {
+        if p.next == mod {
+            p.next = mod.next
+            delete(modList, mod)
+
+            /* update the last module if needed */
+            if lastmoduledatap == mod {
+                lastmoduledatap = p
+            }
+
+            /* all done */
+            return
+        }
+    }
+
+    /* should never happen */
+    panic("loader: unregistering a module that does not exist")
+}
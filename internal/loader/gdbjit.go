@@ -0,0 +1,297 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loader
+
+import (
+    `bytes`
+    `debug/elf`
+    `encoding/binary`
+    `sync`
+    `unsafe`
+
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/** GDB JIT Interface
+ *
+ *  GDB puts a breakpoint on __jit_debug_register_code, and reads the in-memory object file
+ *  referenced by __jit_debug_descriptor every time it is called. Each function is described
+ *  by a minimal ELF object file with a symbol for the name, and the call frame information
+ *  derived from the PC-SP table for unwinding.
+ */
+
+const (
+    _JIT_NOACTION      = 0
+    _JIT_REGISTER_FN   = 1
+    _JIT_UNREGISTER_FN = 2
+)
+
+type _JITCodeEntry struct {
+    next *_JITCodeEntry
+    prev *_JITCodeEntry
+    addr unsafe.Pointer
+    size uint64
+}
+
+type _JITDescriptor struct {
+    version  uint32
+    action   uint32
+    relevant *_JITCodeEntry
+    first    *_JITCodeEntry
+}
+
+var (
+    jitLock sync.Mutex
+)
+
+//go:linkname jitDebugDescriptor __jit_debug_descriptor
+var jitDebugDescriptor = _JITDescriptor { version: 1 }
+
+//go:noinline
+//go:linkname jitDebugRegisterCode __jit_debug_register_code
+func jitDebugRegisterCode() {}
+
+func registerGDBJIT(name string, pc uintptr, size uintptr, frame rt.Frame) *_JITCodeEntry {
+    buf := mkobject(name, pc, size, frame)
+    ret := &_JITCodeEntry { addr: unsafe.Pointer(&buf[0]), size: uint64(len(buf)) }
+
+    /* link to the head of the list */
+    jitLock.Lock()
+    ret.next = jitDebugDescriptor.first
+    jitDebugDescriptor.first = ret
+
+    /* update the back link */
+    if ret.next != nil {
+        ret.next.prev = ret
+    }
+
+    /* notify the debugger */
+    jitDebugDescriptor.relevant = ret
+    jitDebugDescriptor.action = _JIT_REGISTER_FN
+    jitDebugRegisterCode()
+    jitDebugDescriptor.action = _JIT_NOACTION
+    jitLock.Unlock()
+    return ret
+}
+
+func unregisterGDBJIT(entry *_JITCodeEntry) {
+    if entry == nil {
+        return
+    }
+
+    /* unlink from the list */
+    jitLock.Lock()
+    defer jitLock.Unlock()
+
+    /* update the forward link */
+    if entry.prev != nil {
+        entry.prev.next = entry.next
+    } else {
+        jitDebugDescriptor.first = entry.next
+    }
+
+    /* update the back link */
+    if entry.next != nil {
+        entry.next.prev = entry.prev
+    }
+
+    /* notify the debugger */
+    jitDebugDescriptor.relevant = entry
+    jitDebugDescriptor.action = _JIT_UNREGISTER_FN
+    jitDebugRegisterCode()
+    jitDebugDescriptor.action = _JIT_NOACTION
+    jitDebugDescriptor.relevant = nil
+}
+
+/** ELF Object Files **/
+
+const (
+    _DW_CFA_nop            = 0x00
+    _DW_CFA_advance_loc1   = 0x02
+    _DW_CFA_advance_loc2   = 0x03
+    _DW_CFA_advance_loc4   = 0x04
+    _DW_CFA_def_cfa        = 0x0c
+    _DW_CFA_def_cfa_offset = 0x0e
+    _DW_CFA_advance_loc    = 0x40
+    _DW_CFA_offset         = 0x80
+)
+
+const (
+    _DW_REG_RSP = 7
+    _DW_REG_RA  = 16
+)
+
+const (
+    _ELF_text = iota + 1
+    _ELF_debug_frame
+    _ELF_symtab
+    _ELF_strtab
+    _ELF_shstrtab
+    _ELF_nsections
+)
+
+func mkobject(name string, pc uintptr, size uintptr, frame rt.Frame) []byte {
+    var ret bytes.Buffer
+    var shs [_ELF_nsections]elf.Section64
+
+    /* section names */
+    shstr := []byte("\x00.text\x00.debug_frame\x00.symtab\x00.strtab\x00.shstrtab\x00")
+    shs[_ELF_text].Name = 1
+    shs[_ELF_debug_frame].Name = 7
+    shs[_ELF_symtab].Name = 20
+    shs[_ELF_strtab].Name = 28
+    shs[_ELF_shstrtab].Name = 36
+
+    /* the code itself lives outside of the object file */
+    shs[_ELF_text].Type = uint32(elf.SHT_NOBITS)
+    shs[_ELF_text].Flags = uint64(elf.SHF_ALLOC | elf.SHF_EXECINSTR)
+    shs[_ELF_text].Addr = uint64(pc)
+    shs[_ELF_text].Size = uint64(size)
+    shs[_ELF_text].Addralign = 16
+
+    /* the function symbol, relative to the text section */
+    syms := []elf.Sym64 {
+        {},
+        {
+            Name  : 1,
+            Info  : elf.ST_INFO(elf.STB_GLOBAL, elf.STT_FUNC),
+            Shndx : _ELF_text,
+            Size  : uint64(size),
+        },
+    }
+
+    /* write the section contents after the ELF header */
+    ret.Write(make([]byte, unsafe.Sizeof(elf.Header64{})))
+    mksection(&ret, &shs[_ELF_debug_frame], elf.SHT_PROGBITS, mkframe(pc, size, frame))
+    mksection(&ret, &shs[_ELF_symtab], elf.SHT_SYMTAB, syms)
+    mksection(&ret, &shs[_ELF_strtab], elf.SHT_STRTAB, []byte("\x00" + name + "\x00"))
+    mksection(&ret, &shs[_ELF_shstrtab], elf.SHT_STRTAB, shstr)
+
+    /* symbol table references the string table, and starts the global symbols at 1 */
+    shs[_ELF_symtab].Link = _ELF_strtab
+    shs[_ELF_symtab].Info = 1
+    shs[_ELF_symtab].Entsize = uint64(unsafe.Sizeof(elf.Sym64{}))
+
+    /* section headers come last */
+    align(&ret, 8)
+    shoff := ret.Len()
+    _ = binary.Write(&ret, binary.LittleEndian, shs)
+
+    /* ELF header */
+    hdr := elf.Header64 {
+        Type      : uint16(elf.ET_REL),
+        Machine   : uint16(elf.EM_X86_64),
+        Version   : uint32(elf.EV_CURRENT),
+        Shoff     : uint64(shoff),
+        Ehsize    : uint16(unsafe.Sizeof(elf.Header64{})),
+        Shentsize : uint16(unsafe.Sizeof(elf.Section64{})),
+        Shnum     : _ELF_nsections,
+        Shstrndx  : _ELF_shstrtab,
+    }
+
+    /* ELF identification */
+    copy(hdr.Ident[:], elf.ELFMAG)
+    hdr.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+    hdr.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+    hdr.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+    /* write the header at the beginning */
+    buf := ret.Bytes()
+    ehs := bytes.NewBuffer(nil)
+    _ = binary.Write(ehs, binary.LittleEndian, hdr)
+    copy(buf, ehs.Bytes())
+    return buf
+}
+
+func mksection(buf *bytes.Buffer, sh *elf.Section64, ty elf.SectionType, data interface{}) {
+    align(buf, 8)
+    sh.Type = uint32(ty)
+    sh.Off = uint64(buf.Len())
+    sh.Addralign = 8
+    _ = binary.Write(buf, binary.LittleEndian, data)
+    sh.Size = uint64(buf.Len()) - sh.Off
+}
+
+func mkframe(pc uintptr, size uintptr, frame rt.Frame) []byte {
+    var ret []byte
+    var fde []byte
+
+    /* CIE, the CFA is right above the return address on function entry */
+    ret = appendEntry(ret, []byte {
+        0xff, 0xff, 0xff, 0xff,                 // CIE_id
+        1,                                      // version
+        0,                                      // augmentation
+        1,                                      // code_alignment_factor
+        0x78,                                   // data_alignment_factor, -8 in SLEB128
+        _DW_REG_RA,                             // return_address_register
+        _DW_CFA_def_cfa, _DW_REG_RSP, 8,        // CFA = RSP + 8
+        _DW_CFA_offset | _DW_REG_RA, 1,         // RA = [CFA - 8]
+    })
+
+    /* FDE header, references the CIE at offset 0 */
+    fde = make([]byte, 20)
+    binary.LittleEndian.PutUint64(fde[4:], uint64(pc))
+    binary.LittleEndian.PutUint64(fde[12:], uint64(size))
+
+    /* the CFA moves with the stack pointer according to the PC-SP table */
+    for i, v := range frame.SpTab {
+        fde = append(fde, _DW_CFA_def_cfa_offset)
+        fde = appendULEB128(fde, uint64(v.Sp) + 8)
+
+        /* move to the next range */
+        if i != len(frame.SpTab) - 1 {
+            fde = appendAdvance(fde, v.Nb)
+        }
+    }
+
+    /* FDE */
+    return appendEntry(ret, fde)
+}
+
+func appendEntry(buf []byte, data []byte) []byte {
+    for (len(data) + 4) % 8 != 0 {
+        data = append(data, _DW_CFA_nop)
+    }
+
+    /* length, then the entry */
+    buf = append(buf, 0, 0, 0, 0)
+    binary.LittleEndian.PutUint32(buf[len(buf) - 4:], uint32(len(data)))
+    return append(buf, data...)
+}
+
+func appendAdvance(buf []byte, delta uintptr) []byte {
+    switch {
+        case delta < 0x40       : return append(buf, _DW_CFA_advance_loc | byte(delta))
+        case delta < 0x100      : return append(buf, _DW_CFA_advance_loc1, byte(delta))
+        case delta < 0x10000    : return append(buf, _DW_CFA_advance_loc2, byte(delta), byte(delta >> 8))
+        default                 : return append(buf, _DW_CFA_advance_loc4, byte(delta), byte(delta >> 8), byte(delta >> 16), byte(delta >> 24))
+    }
+}
+
+func appendULEB128(buf []byte, v uint64) []byte {
+    for v >= 0x80 {
+        buf = append(buf, byte(v) | 0x80)
+        v >>= 7
+    }
+    return append(buf, byte(v))
+}
+
+func align(buf *bytes.Buffer, n int) {
+    for buf.Len() % n != 0 {
+        buf.WriteByte(0)
+    }
+}
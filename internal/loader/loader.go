@@ -19,10 +19,12 @@ package loader
 import (
     `fmt`
     `os`
+    `runtime`
     `sync/atomic`
     `syscall`
     `unsafe`
 
+    `github.com/cloudwego/frugal/internal/opts`
     `github.com/cloudwego/frugal/internal/rt`
 )
 
@@ -47,6 +49,15 @@ var (
     LoadBase uintptr = MAP_BASE
 )
 
+type _Code struct {
+    pc    uintptr
+    nb    uintptr
+    nf    uintptr
+    mod   *_ModuleData
+    jit   *_JITCodeEntry
+    frame rt.Frame
+}
+
 func mkptr(m uintptr) unsafe.Pointer {
     return *(*unsafe.Pointer)(unsafe.Pointer(&m))
 }
@@ -56,6 +67,28 @@ func alignUp(n uintptr, a int) uintptr {
 }
 
 func (self Loader) Load(fn string, frame rt.Frame) (f Function) {
+    return Function(self.load(fn, frame))
+}
+
+// LoadCollectable loads the code like Load does, but the code is unloaded and its memory
+// is reclaimed once the returned Function is no longer reachable.
+//
+// The caller must keep the Function reachable as long as the code might be running,
+// the code address must never be extracted out of it.
+func (self Loader) LoadCollectable(fn string, frame rt.Frame) Function {
+    cc := self.load(fn, frame)
+    runtime.SetFinalizer(cc, (*_Code).unload)
+    return Function(cc)
+}
+
+// CodeOf returns the machine code of a loaded Function, the returned slice aliases the loaded code,
+// so the Function must be kept reachable as long as the slice is in use.
+func CodeOf(fn Function) []byte {
+    cc := (*_Code)(fn)
+    return rt.BytesFrom(mkptr(cc.pc), int(cc.nf), int(cc.nf))
+}
+
+func (self Loader) load(fn string, frame rt.Frame) *_Code {
     var mm uintptr
     var er syscall.Errno
 
@@ -70,16 +103,38 @@ func (self Loader) Load(fn string, frame rt.Frame) (f Function) {
     }
 
     /* copy code into the memory, and register the function */
+    cc := &_Code { pc: mm, nb: nb, nf: nf, frame: frame }
     copy(rt.BytesFrom(mkptr(mm), len(self), int(nb)), self)
-    registerFunction(fmt.Sprintf("(frugal).%s_%x", fn, mm), mm, nf, frame)
+    name := fmt.Sprintf("(frugal).%s_%x", fn, mm)
+    cc.mod = registerFunction(name, mm, nf, frame)
 
     /* make it executable */
     if _, _, err := syscall.Syscall(syscall.SYS_MPROTECT, mm, nb, _RX); err != 0 {
         panic(err)
     }
 
+    /* let perf and gdb know about the function if needed */
+    if opts.PerfMap { registerPerfMap(name, mm, nf) }
+    if opts.GDBJIT  { cc.jit = registerGDBJIT(name, mm, nf, frame) }
+
     /* record statistics */
     atomic.AddUint32(&FnCount, 1)
     atomic.AddUintptr(&LoadSize, nb)
-    return Function(&mm)
+    return cc
+}
+
+func (self *_Code) unload() {
+    unregisterModule(self.mod)
+    unregisterGDBJIT(self.jit)
+    self.frame.ArgPtrs.Unpin()
+    self.frame.LocalPtrs.Unpin()
+
+    /* nothing can be running the code now, release the memory */
+    if _, _, err := syscall.Syscall(syscall.SYS_MUNMAP, self.pc, self.nb, 0); err != 0 {
+        panic(err)
+    }
+
+    /* update statistics */
+    atomic.AddUint32(&FnCount, ^uint32(0))
+    atomic.AddUintptr(&LoadSize, -self.nb)
 }
@@ -17,13 +17,20 @@
 package loader
 
 import (
+    `bytes`
+    `debug/elf`
     `fmt`
+    `io/ioutil`
+    `os`
     `reflect`
     `runtime`
+    `sync/atomic`
     `testing`
+    `time`
     `unsafe`
 
     `github.com/chenzhuoyu/iasm/x86_64`
+    `github.com/cloudwego/frugal/internal/opts`
     `github.com/cloudwego/frugal/internal/rt`
     `github.com/stretchr/testify/assert`
     `github.com/stretchr/testify/require`
@@ -68,6 +75,7 @@ func TestLoader_Load(t *testing.T) {
     (*(*func(*int))(unsafe.Pointer(&fp)))(&v0)
     pc := *(*uintptr)(fp)
     assert.Equal(t, 1234, v0)
+    assert.Equal(t, cc, CodeOf(fp))
     assert.Equal(t, fmt.Sprintf("(frugal).test_%x", pc), runtime.FuncForPC(pc).Name())
     file, line := runtime.FuncForPC(pc).FileLine(pc + 1)
     assert.Equal(t, "(jit-generated)", file)
@@ -80,6 +88,102 @@ func TestLoader_Load(t *testing.T) {
     assert.Equal(t, pc, startpc2)
 }
 
+func TestLoader_LoadCollectable(t *testing.T) {
+    var asm x86_64.Assembler
+    var src string
+    if runtime.Version() < "go1.17" { src += `
+        movq 8(%rsp), %rax`
+    }
+    src += `
+        movq $5678, (%rax)
+        ret`
+    require.NoError(t, asm.Assemble(src))
+    v0, v1 := 0, 0
+    nc := atomic.LoadUint32(&FnCount)
+    fa := Loader(asm.Code()).LoadCollectable("test_collectable", rt.Frame{})
+    fb := Loader(asm.Code()).LoadCollectable("test_collectable", rt.Frame{})
+    pa, pb := *(*uintptr)(fa), *(*uintptr)(fb)
+    (*(*func(*int))(unsafe.Pointer(&fa)))(&v0)
+    assert.Equal(t, 5678, v0)
+    assert.Equal(t, nc + 2, atomic.LoadUint32(&FnCount))
+    fa = nil
+    for i := 0; i < 100 && atomic.LoadUint32(&FnCount) != nc + 1; i++ {
+        runtime.GC()
+        time.Sleep(time.Millisecond)
+    }
+    assert.Equal(t, nc + 1, atomic.LoadUint32(&FnCount))
+    assert.Nil(t, runtime.FuncForPC(pa))
+    assert.Equal(t, fmt.Sprintf("(frugal).test_collectable_%x", pb), runtime.FuncForPC(pb).Name())
+    (*(*func(*int))(unsafe.Pointer(&fb)))(&v1)
+    assert.Equal(t, 5678, v1)
+    runtime.KeepAlive(fb)
+}
+
+func TestLoader_PerfMap(t *testing.T) {
+    var asm x86_64.Assembler
+    require.NoError(t, asm.Assemble("ret"))
+    defer func(v bool) { opts.PerfMap = v }(opts.PerfMap)
+    opts.PerfMap = true
+    cc := asm.Code()
+    fp := Loader(cc).Load("test_perf_map", rt.Frame{})
+    pc := *(*uintptr)(fp)
+    fn := fmt.Sprintf("/tmp/perf-%d.map", os.Getpid())
+    defer os.Remove(fn)
+    buf, err := ioutil.ReadFile(fn)
+    require.NoError(t, err)
+    require.Contains(t, string(buf), fmt.Sprintf("%x %x (frugal).test_perf_map_%x\n", pc, len(cc), pc))
+}
+
+func TestLoader_GDBJIT(t *testing.T) {
+    var asm x86_64.Assembler
+    require.NoError(t, asm.Assemble("ret"))
+    defer func(v bool) { opts.GDBJIT = v }(opts.GDBJIT)
+    opts.GDBJIT = true
+    cc := asm.Code()
+    fp := Loader(cc).Load("test_gdb_jit", rt.Frame{})
+    pc := *(*uintptr)(fp)
+    jit := (*_Code)(fp).jit
+    require.NotNil(t, jit)
+    require.Equal(t, jit, jitDebugDescriptor.first)
+    require.Equal(t, jit, jitDebugDescriptor.relevant)
+    require.Equal(t, uint32(_JIT_NOACTION), jitDebugDescriptor.action)
+    obj, err := elf.NewFile(bytes.NewReader(rt.BytesFrom(jit.addr, int(jit.size), int(jit.size))))
+    require.NoError(t, err)
+    require.Equal(t, elf.EM_X86_64, obj.Machine)
+    require.Equal(t, uint64(pc), obj.Section(".text").Addr)
+    require.Equal(t, uint64(len(cc)), obj.Section(".text").Size)
+    syms, err := obj.Symbols()
+    require.NoError(t, err)
+    require.Len(t, syms, 1)
+    require.Equal(t, fmt.Sprintf("(frugal).test_gdb_jit_%x", pc), syms[0].Name)
+    require.Equal(t, elf.STT_FUNC, elf.ST_TYPE(syms[0].Info))
+    unregisterGDBJIT(jit)
+    require.NotEqual(t, jit, jitDebugDescriptor.first)
+}
+
+func TestLoader_DebugFrame(t *testing.T) {
+    buf := mkframe(0x1000, 0x200, rt.Frame {
+        SpTab: []rt.Stack {
+            { Sp:   0, Nb: 4 },
+            { Sp:  24, Nb: 0x100 },
+            { Sp:   0, Nb: 0 },
+        },
+    })
+    require.Equal(t, 0, len(buf) % 8)
+    require.Equal(t, []byte {
+        0x24, 0x00, 0x00, 0x00,                             // FDE length
+        0x00, 0x00, 0x00, 0x00,                             // CIE pointer
+        0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,     // initial location
+        0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,     // address range
+        _DW_CFA_def_cfa_offset, 8,
+        _DW_CFA_advance_loc | 4,
+        _DW_CFA_def_cfa_offset, 32,
+        _DW_CFA_advance_loc2, 0x00, 0x01,
+        _DW_CFA_def_cfa_offset, 8,
+        _DW_CFA_nop, _DW_CFA_nop, _DW_CFA_nop, _DW_CFA_nop, _DW_CFA_nop, _DW_CFA_nop,
+    }, buf[24:])
+}
+
 func mkpointer() *int {
     ret := new(int)
     *ret = 1234
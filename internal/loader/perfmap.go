@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loader
+
+import (
+    `fmt`
+    `os`
+    `sync`
+)
+
+/** Linux perf Map
+ *
+ *  perf looks for /tmp/perf-<pid>.map to symbolize addresses that do not belong to any mapped
+ *  file, each line of which is "START SIZE symbolname" with START and SIZE in hex.
+ */
+
+var (
+    perfLock sync.Mutex
+    perfFile *os.File
+)
+
+func registerPerfMap(name string, pc uintptr, size uintptr) {
+    perfLock.Lock()
+    defer perfLock.Unlock()
+
+    /* open the map file on first use, profiling is best-effort */
+    if perfFile == nil {
+        if fp, err := os.OpenFile(fmt.Sprintf("/tmp/perf-%d.map", os.Getpid()), os.O_WRONLY | os.O_CREATE | os.O_APPEND, 0644); err != nil {
+            return
+        } else {
+            perfFile = fp
+        }
+    }
+
+    /* add the function */
+    _, _ = fmt.Fprintf(perfFile, "%x %x %s\n", pc, size, name)
+}
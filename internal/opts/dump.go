@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package opts
+
+import (
+    `os`
+    `regexp`
+)
+
+var (
+    DumpDir    = os.Getenv("FRUGAL_DUMP_DIR")
+    DumpDot    = parseBool("FRUGAL_DUMP_DOT")
+    DumpFilter = parseRegexp("FRUGAL_DUMP_FILTER")
+)
+
+var (
+    VerifySSA = parseBool("FRUGAL_VERIFY_SSA")
+)
+
+func parseRegexp(key string) *regexp.Regexp {
+    if env := os.Getenv(key); env == "" {
+        return nil
+    } else if re, err := regexp.Compile(env); err != nil {
+        panic("frugal: invalid value for " + key)
+    } else {
+        return re
+    }
+}
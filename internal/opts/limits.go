@@ -31,6 +31,13 @@ var (
     MaxInlineILSize = parseOrDefault("FRUGAL_MAX_INLINE_IL_SIZE", _DefaultMaxInlineILSize, 256)
 )
 
+var (
+    MaxAllocSize     = parseOrDefault("FRUGAL_MAX_ALLOC_SIZE", 0, 0)
+    MaxContainerSize = parseOrDefault("FRUGAL_MAX_CONTAINER_SIZE", 0, 0)
+    MaxStringSize    = parseOrDefault("FRUGAL_MAX_STRING_SIZE", 0, 0)
+    MaxNestingDepth  = parseOrDefault("FRUGAL_MAX_NESTING_DEPTH", 0, 0)
+)
+
 func parseOrDefault(key string, def int, min int) int {
     if env := os.Getenv(key); env == "" {
         return def
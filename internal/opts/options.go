@@ -20,6 +20,10 @@ type Options struct {
     MaxInlineDepth   int
     MaxInlineILSize  int
     MaxPretouchDepth int
+    MaxAllocSize     int
+    MaxContainerSize int
+    MaxStringSize    int
+    MaxNestingDepth  int
 }
 
 func (self *Options) CanInline(sp int, pc int) bool {
@@ -35,5 +39,9 @@ func GetDefaultOptions() Options {
         MaxInlineDepth   : MaxInlineDepth,
         MaxInlineILSize  : MaxInlineILSize,
         MaxPretouchDepth : 0,
+        MaxAllocSize     : MaxAllocSize,
+        MaxContainerSize : MaxContainerSize,
+        MaxStringSize    : MaxStringSize,
+        MaxNestingDepth  : MaxNestingDepth,
     }
 }
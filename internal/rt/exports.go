@@ -45,6 +45,10 @@ func resolveTypeOff(p unsafe.Pointer, off GoTypeOffset) *GoType
 //goland:noinspection GoUnusedParameter
 func resolveTextOff(p unsafe.Pointer, off GoTextOffset) unsafe.Pointer
 
+//go:nosplit
+//go:linkname Fastrand runtime.fastrand
+func Fastrand() uint32
+
 //go:nosplit
 func MapClear(m interface{}) {
     v := UnpackEface(m)
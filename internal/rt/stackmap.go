@@ -109,6 +109,14 @@ func (self *StackMap) Pin() uintptr {
     return uintptr(unsafe.Pointer(self))
 }
 
+func (self *StackMap) Unpin() {
+    if self != nil {
+        _stackMapLock.Lock()
+        delete(_stackMapCache, self)
+        _stackMapLock.Unlock()
+    }
+}
+
 func (self *StackMap) Get(i int32) BitVec {
     return BitVec {
         N: uintptr(self.L),
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package utils
+
+import (
+    `sync`
+
+    `github.com/cloudwego/frugal/internal/opts`
+)
+
+/** Asynchronous Compilation
+ *
+ *  Native code of new types is compiled by a bounded number of background workers, the workers are
+ *  started on demand and exit when there are no more pending jobs.
+ */
+
+var (
+    asyncLock  sync.Mutex
+    asyncJobs  []func()
+    asyncCount int
+)
+
+func AsyncEnabled() bool {
+    return opts.AsyncCompileWorkers > 0 && !ForceEmulator
+}
+
+func Async(fn func()) {
+    asyncLock.Lock()
+    asyncJobs = append(asyncJobs, fn)
+
+    /* start a new worker if not at the limit yet */
+    if asyncCount < opts.AsyncCompileWorkers {
+        asyncCount++
+        go asyncWorker()
+    }
+
+    /* release the lock */
+    asyncLock.Unlock()
+}
+
+func asyncWorker() {
+    for {
+        var fn func()
+        asyncLock.Lock()
+
+        /* no more jobs, stop the worker */
+        if len(asyncJobs) == 0 {
+            asyncCount--
+            asyncLock.Unlock()
+            return
+        }
+
+        /* take the first job */
+        fn, asyncJobs[0] = asyncJobs[0], nil
+        asyncJobs = asyncJobs[1:]
+
+        /* run the job without holding the lock */
+        asyncLock.Unlock()
+        fn()
+    }
+}
@@ -59,9 +59,9 @@ func ESetList(pos int, src string, vt reflect.Type) SyntaxError {
     return ESyntax(pos, src, fmt.Sprintf(`ambiguous type between set<%s> and list<%s>, please specify in the "frugal" tag`, vt, vt))
 }
 
-func EUseOther(vt reflect.Type, alt string) TypeError {
+func EExplicit(vt reflect.Type, alt string) TypeError {
     return TypeError {
         Type: vt,
-        Note: fmt.Sprintf("Thrift does not support %s, use %s instead", vt, alt),
+        Note: fmt.Sprintf(`Thrift does not support %s, please specify the wire type in the "frugal" tag: %s`, vt, alt),
     }
 }
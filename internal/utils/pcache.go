@@ -85,6 +85,48 @@ func (self *ProgramMap) add(vt *rt.GoType, fn interface{}) *ProgramMap {
     return p
 }
 
+func (self *ProgramMap) replace(vt *rt.GoType, fn interface{}) *ProgramMap {
+    p := self.copy()
+    i := p.m + 1
+    h := vt.Hash & p.m
+
+    /* linear probing */
+    for ; i > 0; i-- {
+        if b := &p.b[h]; b.vt == vt {
+            b.fn = fn
+            return p
+        } else if b.vt == nil {
+            break
+        } else {
+            h = (h + 1) & p.m
+        }
+    }
+
+    /* not found, the type has been removed */
+    return self
+}
+
+func (self *ProgramMap) remove(vt *rt.GoType) *ProgramMap {
+    n := atomic.LoadUint64(&self.n)
+    c := self.m + 1
+
+    /* shrink the map if it's mostly empty */
+    for c > InitCapacity && float64(n) / float64(c) < LoadFactor / 4 {
+        c >>= 1
+    }
+
+    /* rebuild the map without the type */
+    r := &ProgramMap{m: c - 1, b: make([]ProgramEntry, int(c))}
+    for i := uint32(0); i <= self.m; i++ {
+        if b := self.b[i]; b.vt != nil && b.vt != vt {
+            r.insert(b.vt, b.fn)
+        }
+    }
+
+    /* rebuild successful */
+    return r
+}
+
 func (self *ProgramMap) copy() *ProgramMap {
     p := new(ProgramMap)
     p.n = self.n
@@ -170,3 +212,23 @@ func (self *ProgramCache) Compute(vt *rt.GoType, compute func(*rt.GoType) (inter
     atomic.StorePointer(&self.p, unsafe.Pointer((*ProgramMap)(atomic.LoadPointer(&self.p)).add(vt, val)))
     return val, nil
 }
+
+func (self *ProgramCache) Update(vt *rt.GoType, val interface{}) {
+    self.m.Lock()
+    atomic.StorePointer(&self.p, unsafe.Pointer((*ProgramMap)(atomic.LoadPointer(&self.p)).replace(vt, val)))
+    self.m.Unlock()
+}
+
+func (self *ProgramCache) Remove(vt *rt.GoType) bool {
+    self.m.Lock()
+    defer self.m.Unlock()
+
+    /* check if the type exists */
+    if self.Get(vt) == nil {
+        return false
+    }
+
+    /* update the RCU cache */
+    atomic.StorePointer(&self.p, unsafe.Pointer((*ProgramMap)(atomic.LoadPointer(&self.p)).remove(vt)))
+    return true
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+    `sync`
+    `sync/atomic`
+    `time`
+
+    `github.com/cloudwego/frugal/internal/opts`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/** Per-type Statistics
+ *
+ *  Compilation statistics are recorded for every type compiled, pointers share the same record as the
+ *  types they point to. Calls are only recorded when sampling is enabled, each sampled call stands for
+ *  opts.StatsSampleRate calls, so the numbers are estimations.
+ */
+
+type TypeStats struct {
+    Frontend  int64
+    Translate int64
+    SSA       int64
+    Codegen   int64
+    CodeSize  int64
+    Inlined   int64
+    Deferred  int64
+    Calls     int64
+    Bytes     int64
+}
+
+type StatsTable struct {
+    m sync.Map
+}
+
+func (self *StatsTable) Of(vt *rt.GoType) *TypeStats {
+    vt = rt.Dereference(vt)
+    st, ok := self.m.Load(vt)
+
+    /* create a new record if not found */
+    if !ok {
+        st, _ = self.m.LoadOrStore(vt, new(TypeStats))
+    }
+
+    /* all done */
+    return st.(*TypeStats)
+}
+
+func (self *StatsTable) Remove(vt *rt.GoType) {
+    self.m.Delete(rt.Dereference(vt))
+}
+
+func (self *StatsTable) Range(fn func(vt *rt.GoType, st TypeStats)) {
+    self.m.Range(func(k interface{}, v interface{}) bool {
+        fn(k.(*rt.GoType), v.(*TypeStats).Load())
+        return true
+    })
+}
+
+func (self *StatsTable) Sample(vt *rt.GoType, nb int) {
+    if n := opts.StatsSampleRate; n > 0 && rt.Fastrand() % uint32(n) == 0 {
+        st := self.Of(vt)
+        atomic.AddInt64(&st.Calls, int64(n))
+        atomic.AddInt64(&st.Bytes, int64(n) * int64(nb))
+    }
+}
+
+func (self *TypeStats) Load() TypeStats {
+    return TypeStats {
+        Frontend  : atomic.LoadInt64(&self.Frontend),
+        Translate : atomic.LoadInt64(&self.Translate),
+        SSA       : atomic.LoadInt64(&self.SSA),
+        Codegen   : atomic.LoadInt64(&self.Codegen),
+        CodeSize  : atomic.LoadInt64(&self.CodeSize),
+        Inlined   : atomic.LoadInt64(&self.Inlined),
+        Deferred  : atomic.LoadInt64(&self.Deferred),
+        Calls     : atomic.LoadInt64(&self.Calls),
+        Bytes     : atomic.LoadInt64(&self.Bytes),
+    }
+}
+
+func (self *TypeStats) Store(p *int64, v int) {
+    atomic.StoreInt64(p, int64(v))
+}
+
+func (self *TypeStats) Add(p *int64, d time.Duration) {
+    atomic.AddInt64(p, int64(d))
+}
+
+func (self *TypeStats) Since(p *int64, t time.Time) {
+    atomic.AddInt64(p, int64(time.Since(t)))
+}
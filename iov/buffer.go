@@ -22,3 +22,24 @@ type BufferWriter interface {
     // without copy. It splits the original buffer at remainingCap.
     WriteDirect(buf []byte, remainingCap int) error
 }
+
+// BufferReader implements zero-copy buffer reading from a chain of segments.
+type BufferReader interface {
+    // Len returns the total number of readable bytes.
+    Len() int
+
+    // Segment returns the unread bytes of the current segment without
+    // advancing the reader, it returns an empty slice if nothing to read.
+    Segment() []byte
+
+    // Peek returns the next n bytes without advancing the reader. The returned
+    // slice refers to the underlying segment if the n bytes do not straddle
+    // segment boundaries, otherwise it is a copy.
+    Peek(n int) ([]byte, error)
+
+    // Next is like Peek, but also advances the reader by n bytes.
+    Next(n int) ([]byte, error)
+
+    // Skip advances the reader by n bytes.
+    Skip(n int) error
+}
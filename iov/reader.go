@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iov
+
+import (
+    `fmt`
+)
+
+type segmentReader struct {
+    nb  int
+    buf [][]byte
+}
+
+// NewBufferReader creates a BufferReader over the segments, the segments are
+// referenced directly without copying, and must not be modified while reading.
+func NewBufferReader(segs ...[]byte) BufferReader {
+    ret := &segmentReader { buf: make([][]byte, 0, len(segs)) }
+
+    /* skip all the empty segments */
+    for _, v := range segs {
+        if len(v) != 0 {
+            ret.nb += len(v)
+            ret.buf = append(ret.buf, v)
+        }
+    }
+
+    /* all done */
+    return ret
+}
+
+func (self *segmentReader) Len() int {
+    return self.nb
+}
+
+func (self *segmentReader) Segment() []byte {
+    if len(self.buf) == 0 {
+        return nil
+    } else {
+        return self.buf[0]
+    }
+}
+
+func (self *segmentReader) Peek(n int) ([]byte, error) {
+    if n < 0 || n > self.nb {
+        return nil, errorShortBuffer(n, self.nb)
+    } else if len(self.buf) == 0 || n <= len(self.buf[0]) {
+        return self.Segment()[:n], nil
+    }
+
+    /* the bytes straddle segment boundaries, make a copy */
+    i := 0
+    ret := make([]byte, n)
+
+    /* copy from every segment */
+    for _, v := range self.buf {
+        if i += copy(ret[i:], v); i == n {
+            break
+        }
+    }
+
+    /* all done */
+    return ret, nil
+}
+
+func (self *segmentReader) Next(n int) ([]byte, error) {
+    if ret, err := self.Peek(n); err != nil {
+        return nil, err
+    } else {
+        self.advance(n)
+        return ret, nil
+    }
+}
+
+func (self *segmentReader) Skip(n int) error {
+    if n < 0 || n > self.nb {
+        return errorShortBuffer(n, self.nb)
+    } else {
+        self.advance(n)
+        return nil
+    }
+}
+
+func (self *segmentReader) advance(n int) {
+    for self.nb -= n; n != 0; {
+        if n < len(self.buf[0]) {
+            self.buf[0] = self.buf[0][n:]
+            break
+        } else {
+            n -= len(self.buf[0])
+            self.buf[0] = nil
+            self.buf = self.buf[1:]
+        }
+    }
+}
+
+func errorShortBuffer(n int, nb int) error {
+    return fmt.Errorf("frugal: cannot read %d bytes from the buffer: only %d bytes available", n, nb)
+}
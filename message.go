@@ -0,0 +1,201 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `encoding/binary`
+    `fmt`
+
+    `github.com/cloudwego/frugal/iov`
+)
+
+// MessageType is the type of a Thrift message.
+type MessageType int32
+
+const (
+    MessageCall      MessageType = 1
+    MessageReply     MessageType = 2
+    MessageException MessageType = 3
+    MessageOneway    MessageType = 4
+)
+
+const (
+    _VersionMask = 0xffff0000
+    _Version1    = 0x80010000
+)
+
+func (self MessageType) String() string {
+    switch self {
+        case MessageCall      : return "CALL"
+        case MessageReply     : return "REPLY"
+        case MessageException : return "EXCEPTION"
+        case MessageOneway    : return "ONEWAY"
+        default               : return fmt.Sprintf("MessageType(%d)", int32(self))
+    }
+}
+
+// ApplicationException types, same as the ones defined by Apache Thrift.
+const (
+    UnknownApplicationException int32 = 0
+    UnknownMethod               int32 = 1
+    InvalidMessageType          int32 = 2
+    WrongMethodName             int32 = 3
+    BadSequenceID               int32 = 4
+    MissingResult               int32 = 5
+    InternalError               int32 = 6
+    ProtocolError               int32 = 7
+)
+
+// ApplicationException is the payload of a message with type MessageException,
+// it is wire-compatible with TApplicationException.
+type ApplicationException struct {
+    Message string `frugal:"1,default,string"`
+    Type    int32  `frugal:"2,default,i32"`
+}
+
+// NewApplicationException creates a new ApplicationException.
+func NewApplicationException(typeID int32, message string) *ApplicationException {
+    return &ApplicationException { Message: message, Type: typeID }
+}
+
+// TypeId returns the exception type, which has the same meaning as TApplicationException.TypeId.
+func (self *ApplicationException) TypeId() int32 {
+    return self.Type
+}
+
+func (self *ApplicationException) Error() string {
+    if self.Message != "" {
+        return self.Message
+    } else {
+        return fmt.Sprintf("frugal: application exception: type %d", self.Type)
+    }
+}
+
+// MessageEncodedSize measures the encoded size of a message with name and args, including the message header.
+func MessageEncodedSize(name string, args interface{}) int {
+    return 12 + len(name) + EncodedSize(args)
+}
+
+// EncodeMessage serializes a message into buf with Thrift Binary Protocol and the strict message header,
+// with optional Zero-Copy iov.BufferWriter. buf must be large enough to contain the entire serialization result.
+func EncodeMessage(buf []byte, mem iov.BufferWriter, name string, msgType MessageType, seqID int32, args interface{}) (int, error) {
+    nb := 12 + len(name)
+
+    /* check for buffer size */
+    if len(buf) < nb {
+        return 0, fmt.Errorf("frugal: buffer is too small")
+    }
+
+    /* encode the message header */
+    binary.BigEndian.PutUint32(buf, _Version1 | uint32(msgType))
+    binary.BigEndian.PutUint32(buf[4:], uint32(len(name)))
+    binary.BigEndian.PutUint32(buf[8 + copy(buf[8:], name):], uint32(seqID))
+
+    /* encode the message body */
+    if ret, err := EncodeObject(buf[nb:], mem, args); err != nil {
+        return 0, err
+    } else {
+        return nb + ret, nil
+    }
+}
+
+// DecodeMessageHeader parses the message header from buf with Thrift Binary Protocol, both the strict and
+// the non-strict headers are accepted. It returns the number of bytes consumed by the header.
+func DecodeMessageHeader(buf []byte) (name string, msgType MessageType, seqID int32, n int, err error) {
+    var nb int
+    var vv uint32
+
+    /* read the first word */
+    if len(buf) < 4 {
+        return "", 0, 0, 0, errorMessageEOF()
+    }
+
+    /* strict header: version | type, name, seqid */
+    if vv = binary.BigEndian.Uint32(buf); vv & 0x80000000 != 0 {
+        if vv & _VersionMask != _Version1 {
+            return "", 0, 0, 0, fmt.Errorf("frugal: bad message version: %#08x", vv & _VersionMask)
+        } else if name, n, err = decodeMessageName(buf, 4); err != nil {
+            return "", 0, 0, 0, err
+        } else if len(buf) - n < 4 {
+            return "", 0, 0, 0, errorMessageEOF()
+        } else {
+            msgType, seqID, n = MessageType(vv & 0xff), int32(binary.BigEndian.Uint32(buf[n:])), n + 4
+        }
+    } else {
+        if nb = int(vv); len(buf) - 4 < nb {
+            return "", 0, 0, 0, errorMessageEOF()
+        } else if name, n = string(buf[4:4 + nb]), 4 + nb; len(buf) - n < 5 {
+            return "", 0, 0, 0, errorMessageEOF()
+        } else {
+            msgType, seqID, n = MessageType(buf[n]), int32(binary.BigEndian.Uint32(buf[n + 1:])), n + 5
+        }
+    }
+
+    /* check for message types */
+    if msgType < MessageCall || msgType > MessageOneway {
+        return "", 0, 0, 0, fmt.Errorf("frugal: invalid message type: %d", msgType)
+    } else {
+        return name, msgType, seqID, n, nil
+    }
+}
+
+// DecodeMessage deserializes a message from buf with Thrift Binary Protocol, the payload is decoded into args.
+//
+// If the message type is MessageException, the payload is decoded as an *ApplicationException and returned
+// as err, args is left untouched in this case.
+func DecodeMessage(buf []byte, args interface{}) (name string, msgType MessageType, seqID int32, n int, err error) {
+    return DecodeMessageWithOptions(buf, args)
+}
+
+// DecodeMessageWithOptions is like DecodeMessage, but with the decoding limits overridden by options.
+func DecodeMessageWithOptions(buf []byte, args interface{}, options ...Option) (name string, msgType MessageType, seqID int32, n int, err error) {
+    var ret int
+    var exc ApplicationException
+
+    /* decode the message header */
+    if name, msgType, seqID, n, err = DecodeMessageHeader(buf); err != nil {
+        return
+    }
+
+    /* exceptions are decoded as ApplicationException */
+    if msgType == MessageException {
+        if ret, err = DecodeObjectWithOptions(buf[n:], &exc, options...); err != nil {
+            return name, msgType, seqID, n + ret, err
+        } else {
+            return name, msgType, seqID, n + ret, &exc
+        }
+    }
+
+    /* decode the message body */
+    ret, err = DecodeObjectWithOptions(buf[n:], args, options...)
+    n += ret
+    return
+}
+
+func decodeMessageName(buf []byte, i int) (string, int, error) {
+    if len(buf) - i < 4 {
+        return "", 0, errorMessageEOF()
+    } else if nb := int(binary.BigEndian.Uint32(buf[i:])); nb < 0 || len(buf) - i - 4 < nb {
+        return "", 0, errorMessageEOF()
+    } else {
+        return string(buf[i + 4:i + 4 + nb]), i + 4 + nb, nil
+    }
+}
+
+func errorMessageEOF() error {
+    return fmt.Errorf("frugal: unexpected EOF when decoding message header")
+}
@@ -18,6 +18,7 @@ package frugal
 
 import (
     `fmt`
+    `regexp`
 
     `github.com/cloudwego/frugal/internal/opts`
 )
@@ -85,6 +86,65 @@ func WithMaxPretouchDepth(depth int) Option {
     }
 }
 
+// WithMaxAllocSize limits the total number of bytes allocated when decoding
+// a single object, including strings, binaries, containers and structs.
+//
+// Decoding fails with a *LimitError if this limit is exceeded.
+//
+// The default value "0" means unlimited.
+func WithMaxAllocSize(size int) Option {
+    if size < 0 {
+        panic(fmt.Sprintf("frugal: invalid allocation size limit: %d", size))
+    } else {
+        return func(o *opts.Options) { o.MaxAllocSize = size }
+    }
+}
+
+// WithMaxContainerSize limits the number of elements of every list, set or map
+// when decoding. The length prefix is checked before any allocation happens.
+//
+// Decoding fails with a *LimitError if this limit is exceeded.
+//
+// The default value "0" means unlimited, although containers that claim more
+// elements than the remaining input could possibly hold are always rejected
+// before allocating, regardless of this limit.
+func WithMaxContainerSize(size int) Option {
+    if size < 0 {
+        panic(fmt.Sprintf("frugal: invalid container size limit: %d", size))
+    } else {
+        return func(o *opts.Options) { o.MaxContainerSize = size }
+    }
+}
+
+// WithMaxStringSize limits the length of every string or binary when decoding.
+// The length prefix is checked before any allocation happens.
+//
+// Decoding fails with a *LimitError if this limit is exceeded.
+//
+// The default value "0" means unlimited.
+func WithMaxStringSize(size int) Option {
+    if size < 0 {
+        panic(fmt.Sprintf("frugal: invalid string size limit: %d", size))
+    } else {
+        return func(o *opts.Options) { o.MaxStringSize = size }
+    }
+}
+
+// WithMaxNestingDepth limits the nesting depth of structs and containers when
+// decoding. The depth is also bounded by the size of the internal decoder stack
+// regardless of this option.
+//
+// Decoding fails with a *LimitError if this limit is exceeded.
+//
+// The default value "0" means unlimited.
+func WithMaxNestingDepth(depth int) Option {
+    if depth < 0 {
+        panic(fmt.Sprintf("frugal: invalid nesting depth limit: %d", depth))
+    } else {
+        return func(o *opts.Options) { o.MaxNestingDepth = depth }
+    }
+}
+
 // SetMaxInlineDepth sets the default maximum inlining depth for all types from
 // now on.
 //
@@ -112,3 +172,215 @@ func SetMaxInlineILSize(size int) int {
     size, opts.MaxInlineILSize = opts.MaxInlineILSize, size
     return size
 }
+
+// SetMaxAllocSize sets the default allocation size limit for decoding from now on.
+//
+// This value can also be configured with the `FRUGAL_MAX_ALLOC_SIZE`
+// environment variable.
+//
+// The default value "0" means unlimited.
+//
+// Returns the old opts.MaxAllocSize value.
+func SetMaxAllocSize(size int) int {
+    size, opts.MaxAllocSize = opts.MaxAllocSize, size
+    return size
+}
+
+// SetMaxContainerSize sets the default container size limit for decoding from now on.
+//
+// This value can also be configured with the `FRUGAL_MAX_CONTAINER_SIZE`
+// environment variable.
+//
+// The default value "0" means unlimited.
+//
+// Returns the old opts.MaxContainerSize value.
+func SetMaxContainerSize(size int) int {
+    size, opts.MaxContainerSize = opts.MaxContainerSize, size
+    return size
+}
+
+// SetMaxStringSize sets the default string size limit for decoding from now on.
+//
+// This value can also be configured with the `FRUGAL_MAX_STRING_SIZE`
+// environment variable.
+//
+// The default value "0" means unlimited.
+//
+// Returns the old opts.MaxStringSize value.
+func SetMaxStringSize(size int) int {
+    size, opts.MaxStringSize = opts.MaxStringSize, size
+    return size
+}
+
+// SetMaxNestingDepth sets the default nesting depth limit for decoding from now on.
+//
+// This value can also be configured with the `FRUGAL_MAX_NESTING_DEPTH`
+// environment variable.
+//
+// The default value "0" means unlimited.
+//
+// Returns the old opts.MaxNestingDepth value.
+func SetMaxNestingDepth(depth int) int {
+    depth, opts.MaxNestingDepth = opts.MaxNestingDepth, depth
+    return depth
+}
+
+// SetCacheDir sets the directory of the on-disk machine code cache. Compiled
+// types are saved into this directory, and loaded from it instead of being
+// compiled again by the next process, which makes restarting services with
+// lots of types (especially with Pretouch) much faster.
+//
+// The cache is keyed by the layout of each type, the version of Frugal and
+// the version of Go, mismatched or corrupted entries are simply ignored and
+// compiled again. It only takes effect with the native amd64 backend.
+//
+// This value can also be configured with the `FRUGAL_CACHE_DIR` environment
+// variable.
+//
+// The default value "" disables the cache.
+//
+// Returns the old opts.CacheDir value.
+func SetCacheDir(dir string) string {
+    dir, opts.CacheDir = opts.CacheDir, dir
+    return dir
+}
+
+// SetAsyncCompileWorkers enables asynchronous compilation with at most `n`
+// background workers.
+//
+// When enabled, the first calls to encode or decode a new type run in the
+// emulator right away, instead of waiting for the type to be compiled, while
+// the native code is compiled in background and replaces the emulated one
+// once it is ready. This trades a slower start for lower latency spikes on
+// cold paths. Pretouch is not affected and always compiles synchronously.
+//
+// This value can also be configured with the `FRUGAL_ASYNC_COMPILE_WORKERS`
+// environment variable.
+//
+// The default value "0" disables asynchronous compilation.
+//
+// Returns the old opts.AsyncCompileWorkers value.
+func SetAsyncCompileWorkers(n int) int {
+    if n < 0 {
+        panic(fmt.Sprintf("frugal: invalid async compile workers: %d", n))
+    } else {
+        n, opts.AsyncCompileWorkers = opts.AsyncCompileWorkers, n
+        return n
+    }
+}
+
+// SetPerfMap enables writing the symbols of JIT-compiled functions into
+// `/tmp/perf-<pid>.map`, so that Linux perf can symbolize them in profiles
+// and flame graphs, each encoder or decoder is named after the type it's
+// compiled for, e.g. "(frugal).encoder_main.MyStruct_7ff00001000".
+//
+// Only functions compiled after enabling are written, so this should be
+// enabled before any type is compiled. This value can also be configured with
+// the `FRUGAL_PERF_MAP` environment variable.
+//
+// Returns the old opts.PerfMap value.
+func SetPerfMap(enable bool) bool {
+    enable, opts.PerfMap = opts.PerfMap, enable
+    return enable
+}
+
+// SetGDBJITInterface enables registering JIT-compiled functions with GDB
+// through the GDB JIT interface, so that GDB can symbolize and unwind through
+// them.
+//
+// Only functions compiled after enabling are registered, so this should be
+// enabled before any type is compiled. This value can also be configured with
+// the `FRUGAL_GDB_JIT` environment variable.
+//
+// Returns the old opts.GDBJIT value.
+func SetGDBJITInterface(enable bool) bool {
+    enable, opts.GDBJIT = opts.GDBJIT, enable
+    return enable
+}
+
+// SetDumpDir enables dumping the compilation pipeline of every encoder and
+// decoder compiled from now on into `dir`, which is useful when debugging the
+// compiler. Each of them gets its own sub-directory, named after its kind,
+// type and program fingerprint, containing the opcode program, the HIR, the
+// SSA form after each optimization pass, and the disassembly of the final
+// machine code. Some of them are only available with the native amd64 backend.
+//
+// This value can also be configured with the `FRUGAL_DUMP_DIR` environment
+// variable.
+//
+// The default value "" disables dumping.
+//
+// Returns the old opts.DumpDir value.
+func SetDumpDir(dir string) string {
+    dir, opts.DumpDir = opts.DumpDir, dir
+    return dir
+}
+
+// SetDumpFilter limits the dumping to the types whose name matches the
+// regular expression `pattern`, e.g. "^main\\.MyStruct$".
+//
+// This value can also be configured with the `FRUGAL_DUMP_FILTER` environment
+// variable.
+//
+// The default value "" dumps all the types.
+//
+// Returns the old filter pattern.
+func SetDumpFilter(pattern string) string {
+    var re *regexp.Regexp
+    var old = ""
+
+    /* compile the pattern if any */
+    if pattern != "" {
+        re = regexp.MustCompile(pattern)
+    }
+
+    /* get the old pattern */
+    if opts.DumpFilter != nil {
+        old = opts.DumpFilter.String()
+    }
+
+    /* replace the filter */
+    opts.DumpFilter = re
+    return old
+}
+
+// SetDumpGraphviz enables writing the SSA form as Graphviz DOT files along
+// with the text ones when dumping the compilation pipeline.
+//
+// This value can also be configured with the `FRUGAL_DUMP_DOT` environment
+// variable.
+//
+// Returns the old opts.DumpDot value.
+func SetDumpGraphviz(enable bool) bool {
+    enable, opts.DumpDot = opts.DumpDot, enable
+    return enable
+}
+
+// SetStatsSampleRate enables sampling the encode and decode calls for the
+// per-type statistics, one out of every `n` calls is recorded, see
+// debug.GetTypeStats. The call counts and byte counts are estimated from the
+// samples, so they are only accurate over a large number of calls.
+//
+// This value can also be configured with the `FRUGAL_STATS_SAMPLE_RATE`
+// environment variable.
+//
+// The default value "0" disables sampling, compilation statistics are always
+// recorded.
+//
+// Returns the old opts.StatsSampleRate value.
+func SetStatsSampleRate(n int) int {
+    if n < 0 {
+        panic(fmt.Sprintf("frugal: invalid stats sample rate: %d", n))
+    } else {
+        n, opts.StatsSampleRate = opts.StatsSampleRate, n
+        return n
+    }
+}
+
+func applyOptions(options []Option) opts.Options {
+    o := opts.GetDefaultOptions()
+    for _, fn := range options {
+        fn(&o)
+    }
+    return o
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package frugal
+
+import (
+    `io`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+)
+
+// Encoder writes objects to an io.Writer with Thrift Binary Protocol.
+//
+// The objects are encoded into an internal chunk, which is written into the
+// writer whenever it's full, so the encoded size is not required in advance.
+type Encoder struct {
+    s *encoder.Stream
+}
+
+// NewEncoder creates a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+    return &Encoder { s: encoder.NewStream(w) }
+}
+
+// Encode writes the encoded val to the underlying writer. All the encoded bytes have been
+// written into the writer when it returns without error.
+func (self *Encoder) Encode(val interface{}) error {
+    _, err := self.s.Encode(val)
+    return err
+}
+
+// Decoder reads objects from an io.Reader with Thrift Binary Protocol.
+//
+// The Decoder reads from the reader in chunks only when more bytes are required, and it may
+// read beyond the decoded object, the remaining bytes are kept for the next Decode call.
+//
+// Fields marked as "nocopy" refer to the internal chunks, which are never modified after read.
+type Decoder struct {
+    s *decoder.Stream
+}
+
+// NewDecoder creates a new Decoder that reads from r, with optional decoding limits.
+func NewDecoder(r io.Reader, options ...Option) *Decoder {
+    return &Decoder { s: decoder.NewStream(r, applyOptions(options)) }
+}
+
+// Decode reads the next object from the underlying reader, and stores it into val.
+func (self *Decoder) Decode(val interface{}) error {
+    _, err := self.s.Decode(val)
+    return err
+}
+
+// Buffered returns the number of bytes that have been read from the reader but not yet decoded.
+func (self *Decoder) Buffered() int {
+    return self.s.Buffered()
+}
@@ -0,0 +1,392 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package aotgen_test
+
+import (
+    `bytes`
+    `io/ioutil`
+    `math`
+    `reflect`
+    `strconv`
+    `strings`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/aot`
+    `github.com/cloudwego/frugal/debug`
+    `github.com/cloudwego/frugal/iov`
+    `github.com/cloudwego/frugal/testdata/aotgen`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+/* twins of the generated types with the same names and layouts, these are compiled by the JIT */
+
+type Scalars aotgen.Scalars
+type Custom aotgen.Custom
+type OptionalDefaultValues baseline.OptionalDefaultValues
+
+func (p *Scalars) InitDefault() {
+    (*aotgen.Scalars)(p).InitDefault()
+}
+
+func (p *OptionalDefaultValues) InitDefault() {
+    (*baseline.OptionalDefaultValues)(p).InitDefault()
+}
+
+type Containers struct {
+    L8   []int8               `frugal:"1,default,list<byte>"`
+    L16  []int16              `frugal:"2,default,list<i16>"`
+    L64  []int64              `frugal:"3,default,list<i64>"`
+    LS   []string             `frugal:"4,default,list<string>"`
+    LB   [][]byte             `frugal:"5,default,list<binary>"`
+    LP   []*Scalars        `frugal:"6,default,list<Scalars>"`
+    S8   []int8               `frugal:"7,default,set<byte>"`
+    S16  []int16              `frugal:"8,default,set<i16>"`
+    S32  []int32              `frugal:"9,default,set<i32>"`
+    SS   []string             `frugal:"10,default,set<string>"`
+    M8   map[int8]int8        `frugal:"11,default,map<byte:byte>"`
+    M16  map[int16]string     `frugal:"12,default,map<i16:string>"`
+    M32  map[int32][]int64    `frugal:"13,default,map<i32:list<i64>>"`
+    M64  map[int64]*Scalars `frugal:"14,default,map<i64:Scalars>"`
+    MS   map[string]float64   `frugal:"15,default,map<string:double>"`
+    ME   map[aotgen.Kind]bool `frugal:"16,default,map<Kind:bool>"`
+    MP   map[*Scalars]int32 `frugal:"17,default,map<Scalars:i32>"`
+    MM   map[string]map[string]string `frugal:"18,default,map<string:map<string:string>>"`
+    AI   [3]int32             `frugal:"19,default,list<i32>"`
+    AU   [2]uint64            `frugal:"20,default,list<i64>"`
+    AF   [2]float32           `frugal:"21,default,list<double>"`
+    LF   []float32            `frugal:"22,default,list<double>"`
+    LU   []uint32             `frugal:"23,default,list<i32>"`
+    MU   map[string]uint16    `frugal:"24,default,map<string:i16>"`
+}
+
+type Union struct {
+    _ struct{}          `frugal:"_union"`
+    I *int64            `frugal:"1,optional,i64"`
+    S *string           `frugal:"2,optional,string"`
+    L []int32           `frugal:"3,optional,list<i32>"`
+    M map[string]int32  `frugal:"4,optional,map<string:i32>"`
+    T *Tree          `frugal:"5,optional,Tree"`
+}
+
+type Tree struct {
+    Value    int64      `frugal:"1,default,i64"`
+    Left     *Tree   `frugal:"2,optional,Tree"`
+    Right    *Tree   `frugal:"3,optional,Tree"`
+    Children []*Tree `frugal:"4,default,list<Tree>"`
+    Union    *Union  `frugal:"5,optional,Union"`
+}
+
+type Required struct {
+    A int32       `frugal:"1,required,i32"`
+    B string      `frugal:"2,required,string"`
+    C *Scalars `frugal:"3,required,Scalars"`
+    D int64       `frugal:"300,required,i64"`
+}
+
+func newScalars(i int) *Scalars {
+    v := new(Scalars)
+    v.InitDefault()
+    v.B = i % 2 == 0
+    v.I8 = int8(-i)
+    v.I16 = int16(i * 100)
+    v.I32 = int32(-i * 10000)
+    v.I64 = int64(i) << 40
+    v.I = -i
+    v.F64 = float64(i) / 3
+    v.F32 = float32(i) / 7
+    v.U8 = uint8(100 + i)
+    v.U16 = uint16(60000 + i)
+    v.U32 = uint32(4000000000 + i)
+    v.U64 = uint64(1) << 62 + uint64(i)
+    v.S = "string " + strconv.Itoa(i)
+    v.Bin = []byte("binary " + strconv.Itoa(i))
+    v.E = aotgen.Kind(i)
+    v.ID = [4]byte { 'a', 'b', 'c', byte(i) }
+    if i % 3 == 0 {
+        pi, ps, pu := int32(i), "p" + strconv.Itoa(i), uint16(i)
+        v.PI, v.PS, v.PU = &pi, &ps, &pu
+        v.OI, v.OS = int16(i), "optional"
+    }
+    return v
+}
+
+func newContainers() *Containers {
+    return &Containers {
+        L8  : []int8 { -1, 0, 1 },
+        L16 : []int16 { -300, 300 },
+        L64 : []int64 { math.MinInt64, math.MaxInt64 },
+        LS  : []string { "a", "", "c" },
+        LB  : [][]byte { []byte("x"), {} },
+        LP  : []*Scalars { newScalars(1), newScalars(2), newScalars(3) },
+        S8  : []int8 { 1, 2, 3 },
+        S16 : []int16 { 1000, 2000 },
+        S32 : []int32 { 1, -1 },
+        SS  : []string { "x", "y" },
+        M8  : map[int8]int8 { -8: 8 },
+        M16 : map[int16]string { 16: "sixteen" },
+        M32 : map[int32][]int64 { 32: { 1, 2, 3 } },
+        M64 : map[int64]*Scalars { 64: newScalars(4) },
+        MS  : map[string]float64 { "pi": math.Pi },
+        ME  : map[aotgen.Kind]bool { 3: true },
+        MM  : map[string]map[string]string { "a": { "b": "c" } },
+        AI  : [3]int32 { 1, 2, 3 },
+        AU  : [2]uint64 { 0, math.MaxInt64 },
+        AF  : [2]float32 { 0.5, -0.25 },
+        LF  : []float32 { 1.5 },
+        LU  : []uint32 { math.MaxInt32 },
+        MU  : map[string]uint16 { "u": 32767 },
+    }
+}
+
+func newTree(depth int) *Tree {
+    v := &Tree { Value: int64(depth) }
+    if depth > 0 {
+        v.Left = newTree(depth - 1)
+        v.Children = []*Tree { newTree(depth - 1), newTree(depth - 2) }
+        v.Union = &Union { T: newTree(depth - 2) }
+    } else if depth == 0 {
+        s := "leaf"
+        v.Union = &Union { S: &s }
+    } else {
+        v.Union = &Union { L: []int32 { 1, 2 } }
+    }
+    return v
+}
+
+func newCustom() *Custom {
+    c := aotgen.MakeCents(42)
+    return &Custom {
+        C  : aotgen.MakeCents(1234),
+        PC : &c,
+        LC : []aotgen.Cents { aotgen.MakeCents(1), aotgen.MakeCents(-1) },
+        MC : map[string]aotgen.Cents { "c": aotgen.MakeCents(99) },
+    }
+}
+
+func newOptionalDefaultValues() *OptionalDefaultValues {
+    v := new(OptionalDefaultValues)
+    v.InitDefault()
+    v.I32FieldWithDefault = 100
+    v.StringFieldWithDefault = "changed"
+
+    /* maps with a single entry are encoded deterministically */
+    v.MapI32I64WithDefault = map[int32]int64 { 1: 2 }
+    v.MapI64StringWithDefault = map[int64]string { 3: "4" }
+    v.MapStringStringWithDefault = map[string]string { "5": "6" }
+    v.MapStringSimpleWithDefault = map[string]*baseline.Simple { "7": v.SimpleStructWithDefault }
+    return v
+}
+
+func encode(t *testing.T, v interface{}) []byte {
+    buf := make([]byte, frugal.EncodedSize(v))
+    ret, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    return buf
+}
+
+func decode(t *testing.T, buf []byte, v interface{}) {
+    ret, err := frugal.DecodeObject(buf, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+}
+
+/* cast reinterprets a JIT twin as the generated type, they share the same memory layout */
+func cast(v interface{}, t reflect.Type) interface{} {
+    return reflect.NewAt(t, unsafe.Pointer(reflect.ValueOf(v).Pointer())).Interface()
+}
+
+func testRoundTrip(t *testing.T, jv interface{}, gt reflect.Type) {
+    gv := cast(jv, gt)
+    buf := encode(t, jv)
+    require.Equal(t, buf, encode(t, gv))
+    require.Equal(t, buf, encode(t, reflect.ValueOf(gv).Elem().Interface()))
+
+    /* decode with both the JIT and the generated code */
+    jx := reflect.New(reflect.TypeOf(jv).Elem())
+    gx := reflect.New(gt)
+    decode(t, buf, jx.Interface())
+    decode(t, buf, gx.Interface())
+    require.Equal(t, jx.Interface(), cast(gx.Interface(), reflect.TypeOf(jv).Elem()))
+}
+
+func TestGeneratedMatchesJIT(t *testing.T) {
+    testRoundTrip(t, newScalars(3), reflect.TypeOf(aotgen.Scalars{}))
+    testRoundTrip(t, newScalars(4), reflect.TypeOf(aotgen.Scalars{}))
+    testRoundTrip(t, newContainers(), reflect.TypeOf(aotgen.Containers{}))
+    testRoundTrip(t, newTree(4), reflect.TypeOf(aotgen.Tree{}))
+    testRoundTrip(t, newCustom(), reflect.TypeOf(aotgen.Custom{}))
+    testRoundTrip(t, &Required { A: 1, B: "b", C: newScalars(0), D: 300 }, reflect.TypeOf(aotgen.Required{}))
+    testRoundTrip(t, newOptionalDefaultValues(), reflect.TypeOf(baseline.OptionalDefaultValues{}))
+    testPointerKeys(t)
+    stats := debug.GetStats()
+    require.NotZero(t, stats.Encoder.Generated)
+    require.NotZero(t, stats.Decoder.Generated)
+}
+
+func testPointerKeys(t *testing.T) {
+    var v aotgen.Containers
+    buf := encode(t, &Containers { MP: map[*Scalars]int32 { newScalars(5): 5 } })
+    require.Equal(t, buf, encode(t, &aotgen.Containers { MP: map[*aotgen.Scalars]int32 { (*aotgen.Scalars)(newScalars(5)): 5 } }))
+
+    /* pointer keys can only be compared by the values */
+    decode(t, buf, &v)
+    require.Len(t, v.MP, 1)
+    for k, x := range v.MP {
+        require.Equal(t, newScalars(5), (*Scalars)(k))
+        require.Equal(t, int32(5), x)
+    }
+}
+
+func TestGeneratedEmpty(t *testing.T) {
+    testRoundTrip(t, new(Containers), reflect.TypeOf(aotgen.Containers{}))
+    testRoundTrip(t, new(Tree), reflect.TypeOf(aotgen.Tree{}))
+    testRoundTrip(t, new(Custom), reflect.TypeOf(aotgen.Custom{}))
+}
+
+func TestGeneratedUnknownFields(t *testing.T) {
+    var jv Scalars
+    var gv aotgen.Scalars
+    buf := encode(t, &Required { A: 1, B: "b", C: newScalars(0), D: 300 })
+
+    /* the fields of Required are unknown to Scalars */
+    decode(t, buf, &jv)
+    decode(t, buf, &gv)
+    require.NotEmpty(t, gv.Unknown)
+    require.Equal(t, &jv, cast(&gv, reflect.TypeOf(jv)))
+    require.Equal(t, encode(t, &jv), encode(t, &gv))
+}
+
+func sameError(t *testing.T, je error, ge error) {
+    require.Error(t, je)
+    require.Error(t, ge)
+    require.Equal(t, strings.ReplaceAll(je.Error(), "aotgen_test.", "aotgen."), ge.Error())
+}
+
+func testErrors(t *testing.T, buf []byte, jv interface{}, gv interface{}) {
+    _, je := frugal.DecodeObject(buf, jv)
+    _, ge := frugal.DecodeObject(buf, gv)
+    sameError(t, je, ge)
+}
+
+func TestGeneratedErrors(t *testing.T) {
+    buf := encode(t, newScalars(1))
+    testErrors(t, buf[:len(buf) / 2], new(Scalars), new(aotgen.Scalars))
+    testErrors(t, encode(t, &Scalars { S: "no required fields" }), new(Required), new(aotgen.Required))
+    testErrors(t, encode(t, &struct { U16 int32 `frugal:"10,default,i32"` } { 70000 }), new(Scalars), new(aotgen.Scalars))
+    testErrors(t, encode(t, &struct { L16 []int32 `frugal:"2,default,list<i32>"` } { []int32 { 1 } }), new(Containers), new(aotgen.Containers))
+    testErrors(t, encode(t, &struct { AI []int32 `frugal:"19,default,list<i32>"` } { []int32 { 1 } }), new(Containers), new(aotgen.Containers))
+    testErrors(t, []byte { 0x0f, 0, 3, 0x0a, 0x7f, 0xff, 0xff, 0xff }, new(Containers), new(aotgen.Containers))
+    testErrors(t, []byte { 0x0d, 0, 15, 0x0b, 0x04, 0, 0, 0, 2, 0, 0, 0, 0 }, new(Containers), new(aotgen.Containers))
+
+    /* encoding errors */
+    i, s := int64(1), "s"
+    _, je := frugal.EncodeObject(make([]byte, 1024), nil, &Union { I: &i, S: &s })
+    _, ge := frugal.EncodeObject(make([]byte, 1024), nil, &aotgen.Union { I: &i, S: &s })
+    sameError(t, je, ge)
+    _, je = frugal.EncodeObject(make([]byte, 1024), nil, &Containers { S16: []int16 { 1, 1 } })
+    _, ge = frugal.EncodeObject(make([]byte, 1024), nil, &aotgen.Containers { S16: []int16 { 1, 1 } })
+    sameError(t, je, ge)
+}
+
+func TestGeneratedReader(t *testing.T) {
+    var v, x aotgen.Containers
+    buf := encode(t, newContainers())
+    segs := make([][]byte, 0, len(buf))
+
+    /* one byte per segment */
+    for i := range buf {
+        segs = append(segs, buf[i:i + 1])
+    }
+
+    /* decode from the segments */
+    ret, err := frugal.DecodeReader(iov.NewBufferReader(segs...), &v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    decode(t, buf, &x)
+    require.Equal(t, x, v)
+}
+
+func TestGeneratedStream(t *testing.T) {
+    var out bytes.Buffer
+    var vals []*aotgen.Tree
+
+    /* encode a few trees, large enough to be flushed in the middle */
+    enc := frugal.NewEncoder(&out)
+    for i := 0; i < 4; i++ {
+        vals = append(vals, cast(newTree(8), reflect.TypeOf(aotgen.Tree{})).(*aotgen.Tree))
+        require.NoError(t, enc.Encode(vals[i]))
+    }
+
+    /* decode them back */
+    dec := frugal.NewDecoder(&out)
+    for _, v := range vals {
+        var x aotgen.Tree
+        require.NoError(t, dec.Decode(&x))
+        require.Equal(t, encode(t, v), encode(t, &x))
+    }
+}
+
+type staleTest struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+func TestGeneratedStale(t *testing.T) {
+    vt := reflect.TypeOf(staleTest{})
+    aot.Register(func() []aot.Entry { panic("broken generated code") })
+    aot.Register(func() []aot.Entry {
+        return []aot.Entry {
+            { Type: aot.TypeOf(vt), Hash: 1, Decode: func(unsafe.Pointer, int, int, unsafe.Pointer, *aot.DecodeState, int) (int, error) { panic("stale") } },
+            { Type: aot.TypeOf(reflect.PtrTo(vt)), Hash: 1, Encode: func(unsafe.Pointer, int, iov.BufferWriter, unsafe.Pointer, *aot.EncodeState, int) (int, error) { panic("stale") } },
+        }
+    })
+
+    /* mismatched fingerprints fall back to the JIT */
+    var v staleTest
+    decode(t, encode(t, &staleTest { A: 1 }), &v)
+    require.Equal(t, staleTest { A: 1 }, v)
+}
+
+func TestGeneratedUpToDate(t *testing.T) {
+    var buf bytes.Buffer
+    if strconv.IntSize != 64 {
+        t.Skip("frugal_gen.go is generated on 64-bit platforms")
+    }
+
+    /* regenerate the file */
+    require.NoError(t, aot.Generate(
+        &buf,
+        "github.com/cloudwego/frugal/testdata/aotgen",
+        "aotgen",
+        reflect.TypeOf(aotgen.Scalars{}),
+        reflect.TypeOf(aotgen.Containers{}),
+        reflect.TypeOf(aotgen.Union{}),
+        reflect.TypeOf(aotgen.Tree{}),
+        reflect.TypeOf(aotgen.Custom{}),
+        reflect.TypeOf(aotgen.Required{}),
+        reflect.TypeOf(baseline.OptionalDefaultValues{}),
+    ))
+
+    /* must match the file */
+    src, err := ioutil.ReadFile("frugal_gen.go")
+    require.NoError(t, err)
+    require.Equal(t, string(src), buf.String(), "frugal_gen.go is outdated, run go generate")
+}
@@ -0,0 +1,15548 @@
+// Code generated by frugal gen. DO NOT EDIT.
+
+package aotgen
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/cloudwego/frugal/aot"
+	"github.com/cloudwego/frugal/iov"
+
+	baseline "github.com/cloudwego/frugal/testdata/kitex_gen/baseline"
+)
+
+var (
+	_frugal_t0  *aot.Type
+	_frugal_t1  *aot.Type
+	_frugal_t2  *aot.Type
+	_frugal_t3  *aot.Type
+	_frugal_t4  *aot.Type
+	_frugal_t5  *aot.Type
+	_frugal_t6  *aot.Type
+	_frugal_t7  *aot.Type
+	_frugal_t8  *aot.Type
+	_frugal_t9  *aot.Type
+	_frugal_t10 *aot.Type
+	_frugal_t11 *aot.Type
+	_frugal_t12 *aot.Type
+	_frugal_t13 *aot.Type
+	_frugal_t14 *aot.Type
+	_frugal_t15 *aot.Type
+	_frugal_t16 *aot.Type
+	_frugal_t17 *aot.Type
+	_frugal_t18 *aot.Type
+	_frugal_t19 *aot.Type
+	_frugal_t20 *aot.Type
+	_frugal_t21 *aot.Type
+	_frugal_t22 *aot.Type
+	_frugal_t23 *aot.Type
+	_frugal_t24 *aot.Type
+	_frugal_t25 *aot.Type
+	_frugal_t26 *aot.Type
+	_frugal_t27 *aot.Type
+	_frugal_t28 *aot.Type
+	_frugal_t29 *aot.Type
+	_frugal_t30 *aot.Type
+	_frugal_t31 *aot.Type
+	_frugal_t32 *aot.Type
+	_frugal_t33 *aot.Type
+	_frugal_t34 *aot.Type
+	_frugal_t35 *aot.Type
+	_frugal_t36 *aot.Type
+	_frugal_t37 *aot.Type
+	_frugal_t38 *aot.Type
+	_frugal_t39 *aot.Type
+	_frugal_t40 *aot.Type
+	_frugal_t41 *aot.Type
+	_frugal_t42 *aot.Type
+	_frugal_t43 *aot.Type
+	_frugal_t44 *aot.Type
+	_frugal_t45 *aot.Type
+	_frugal_i0  func(unsafe.Pointer)
+	_frugal_i1  func(unsafe.Pointer)
+	_frugal_i2  func(unsafe.Pointer)
+)
+
+func init() {
+	aot.Register(_frugal_load)
+}
+
+func _frugal_load() []aot.Entry {
+	r0 := reflect.TypeOf((*Scalars)(nil))
+	r1 := r0.Elem()
+	r2 := reflect.TypeOf((*Containers)(nil))
+	r3 := r2.Elem()
+	r4 := reflect.TypeOf((*Union)(nil))
+	r5 := r4.Elem()
+	r6 := reflect.TypeOf((*Tree)(nil))
+	r7 := r6.Elem()
+	r8 := reflect.TypeOf((*Custom)(nil))
+	r9 := r8.Elem()
+	r10 := reflect.TypeOf((*Required)(nil))
+	r11 := r10.Elem()
+	r12 := reflect.TypeOf((*baseline.OptionalDefaultValues)(nil))
+	r13 := r12.Elem()
+	r15 := r1.Field(1).Type
+	r16 := r1.Field(2).Type
+	r17 := r1.Field(3).Type
+	r18 := r1.Field(4).Type
+	r21 := r1.Field(7).Type
+	r22 := r1.Field(8).Type
+	r23 := r1.Field(9).Type
+	r24 := r1.Field(10).Type
+	r25 := r1.Field(11).Type
+	r26 := r1.Field(12).Type
+	r27 := r1.Field(13).Type
+	r29 := r1.Field(15).Type
+	r40 := r3.Field(10).Type
+	r41 := r3.Field(11).Type
+	r42 := r3.Field(12).Type
+	r43 := r3.Field(13).Type
+	r44 := r3.Field(14).Type
+	r45 := r3.Field(15).Type
+	r46 := r3.Field(16).Type
+	r47 := r3.Field(17).Type
+	r48 := r3.Field(18).Type
+	r49 := r3.Field(19).Type
+	r50 := r3.Field(20).Type
+	r53 := r3.Field(23).Type
+	r56 := r5.Field(4).Type
+	r58 := r9.Field(0).Type
+	r61 := r9.Field(3).Type
+	r63 := r13.Field(7).Type
+	r64 := r13.Field(10).Type
+	r65 := r13.Field(11).Type
+	r66 := r13.Field(12).Type
+	r67 := r13.Field(13).Type
+	r68 := r63.Elem()
+	_frugal_t0 = aot.TypeOf(r22)
+	_frugal_t1 = aot.TypeOf(r23)
+	_frugal_t2 = aot.TypeOf(r24)
+	_frugal_t3 = aot.TypeOf(r25)
+	_frugal_t4 = aot.TypeOf(r29)
+	_frugal_t5 = aot.TypeOf(r17)
+	_frugal_t6 = aot.TypeOf(r26)
+	_frugal_t7 = aot.TypeOf(r15)
+	_frugal_t8 = aot.TypeOf(r16)
+	_frugal_t9 = aot.TypeOf(r18)
+	_frugal_t10 = aot.TypeOf(r27)
+	_frugal_t11 = aot.TypeOf(r0)
+	_frugal_t12 = aot.TypeOf(r1)
+	_frugal_t13 = aot.TypeOf(r40)
+	_frugal_t14 = aot.TypeOf(r41)
+	_frugal_t15 = aot.TypeOf(r42)
+	_frugal_t16 = aot.TypeOf(r43)
+	_frugal_t17 = aot.TypeOf(r44)
+	_frugal_t18 = aot.TypeOf(r45)
+	_frugal_t19 = aot.TypeOf(r46)
+	_frugal_t20 = aot.TypeOf(r47)
+	_frugal_t21 = aot.TypeOf(r66)
+	_frugal_t22 = aot.TypeOf(r48)
+	_frugal_t23 = aot.TypeOf(r49)
+	_frugal_t24 = aot.TypeOf(r50)
+	_frugal_t25 = aot.TypeOf(r21)
+	_frugal_t26 = aot.TypeOf(r53)
+	_frugal_t27 = aot.TypeOf(r5)
+	_frugal_t28 = aot.TypeOf(r56)
+	_frugal_t29 = aot.TypeOf(r7)
+	_frugal_t30 = aot.TypeOf(r6)
+	_frugal_t31 = aot.TypeOf(r58)
+	_frugal_t32 = aot.TypeOf(r61)
+	_frugal_t33 = aot.TypeOf(r11)
+	_frugal_t34 = aot.TypeOf(r68)
+	_frugal_t35 = aot.TypeOf(r64)
+	_frugal_t36 = aot.TypeOf(r65)
+	_frugal_t37 = aot.TypeOf(r67)
+	_frugal_t38 = aot.TypeOf(r3)
+	_frugal_t39 = aot.TypeOf(r9)
+	_frugal_t40 = aot.TypeOf(r13)
+	_frugal_t41 = aot.TypeOf(r2)
+	_frugal_t42 = aot.TypeOf(r4)
+	_frugal_t43 = aot.TypeOf(r8)
+	_frugal_t44 = aot.TypeOf(r10)
+	_frugal_t45 = aot.TypeOf(r12)
+	_frugal_i0 = aot.InitializerOf(r1)
+	_frugal_i1 = aot.InitializerOf(r13)
+	_frugal_i2 = aot.InitializerOf(r68)
+	return []aot.Entry{
+		{Type: _frugal_t12, Hash: 0xe5246d2a9a5b1208, Decode: _frugal_dec_0},
+		{Type: _frugal_t38, Hash: 0x9f62dd2e2155430b, Decode: _frugal_dec_1},
+		{Type: _frugal_t27, Hash: 0xddc636f238f1ae40, Decode: _frugal_dec_2},
+		{Type: _frugal_t29, Hash: 0x327254c098808bf4, Decode: _frugal_dec_3},
+		{Type: _frugal_t39, Hash: 0x5f1be9e60e3b7781, Decode: _frugal_dec_4},
+		{Type: _frugal_t33, Hash: 0x033a13e543898dd1, Decode: _frugal_dec_5},
+		{Type: _frugal_t40, Hash: 0x312f5154077129d6, Decode: _frugal_dec_6},
+		{Type: _frugal_t12, Hash: 0xfcf75b8e1c7bbda9, Encode: _frugal_enc_0},
+		{Type: _frugal_t11, Hash: 0x895fb0fa4c5f7638, Encode: _frugal_enc_1},
+		{Type: _frugal_t38, Hash: 0x8e976c6b380c8005, Encode: _frugal_enc_2},
+		{Type: _frugal_t41, Hash: 0x2bf0343e39ec4739, Encode: _frugal_enc_3},
+		{Type: _frugal_t27, Hash: 0x783d52e38cd75682, Encode: _frugal_enc_4},
+		{Type: _frugal_t42, Hash: 0xbd074e6760b23042, Encode: _frugal_enc_5},
+		{Type: _frugal_t29, Hash: 0xa76d7664b8308d00, Encode: _frugal_enc_6},
+		{Type: _frugal_t30, Hash: 0x03f863036bf32e43, Encode: _frugal_enc_7},
+		{Type: _frugal_t39, Hash: 0xf5f51c80c6ab5a32, Encode: _frugal_enc_8},
+		{Type: _frugal_t43, Hash: 0x35448f941bf44024, Encode: _frugal_enc_9},
+		{Type: _frugal_t33, Hash: 0x2a92f5bb67d8e31d, Encode: _frugal_enc_10},
+		{Type: _frugal_t44, Hash: 0xf952216cc62775f1, Encode: _frugal_enc_11},
+		{Type: _frugal_t40, Hash: 0xbca84e67b6949d78, Encode: _frugal_enc_12},
+		{Type: _frugal_t45, Hash: 0x082b86803d9c2dfb, Encode: _frugal_enc_13},
+	}
+}
+
+func _frugal_dec_0(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	var tr uint64
+	rs.Reset(buf, nb)
+	_frugal_i0(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)) = (*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)))[:0]
+L_3:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_145
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_12
+	case 2:
+		goto L_16
+	case 3:
+		goto L_22
+	case 4:
+		goto L_28
+	case 5:
+		goto L_34
+	case 6:
+		goto L_40
+	case 7:
+		goto L_46
+	case 8:
+		goto L_52
+	case 9:
+		goto L_58
+	case 10:
+		goto L_64
+	case 11:
+		goto L_70
+	case 12:
+		goto L_76
+	case 13:
+		goto L_82
+	case 14:
+		goto L_88
+	case 15:
+		goto L_94
+	case 16:
+		goto L_100
+	case 17:
+		goto L_106
+	case 18:
+		goto L_115
+	case 19:
+		goto L_124
+	case 20:
+		goto L_133
+	case 21:
+		goto L_139
+	}
+	if ic, err = rs.Unknown(ic, tg, id, unsafe.Pointer(uintptr(wp)+160)); err != nil {
+		return ic, err
+	}
+	goto L_3
+L_10:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_3
+L_12:
+	if tg != 2 {
+		goto L_10
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_3
+L_16:
+	if tg != 3 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 1)
+	goto L_3
+L_22:
+	if tg != 6 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 2)
+	goto L_3
+L_28:
+	if tg != 8 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 4)
+	goto L_3
+L_34:
+	if tg != 10 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_3
+L_40:
+	if tg != 10 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_3
+L_46:
+	if tg != 4 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_3
+L_52:
+	if tg != 4 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_3
+L_58:
+	if tg != 3 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 36)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U8(ic))
+	if tr > 127 {
+		return ic, rs.RangeError(_frugal_t0, tr, 1)
+	}
+	*(*uint8)(wp) = uint8(tr)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 36)
+	goto L_3
+L_64:
+	if tg != 8 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 38)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 65535 {
+		return ic, rs.RangeError(_frugal_t1, tr, 4)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 38)
+	goto L_3
+L_70:
+	if tg != 10 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 4294967295 {
+		return ic, rs.RangeError(_frugal_t2, tr, 8)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_3
+L_76:
+	if tg != 10 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_3
+L_82:
+	if tg != 11 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 56)
+	goto L_3
+L_88:
+	if tg != 11 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_3
+L_94:
+	if tg != 8 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_3
+L_100:
+	if tg != 11 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 104)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Fixed(ic, wp, _frugal_t4); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 104)
+	goto L_3
+L_106:
+	if tg != 8 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_3
+L_115:
+	if tg != 11 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_3
+L_124:
+	if tg != 6 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 128)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t1); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 128)
+	goto L_3
+L_133:
+	if tg != 6 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_3
+L_139:
+	if tg != 11 {
+		goto L_10
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_3
+L_145:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_1(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	var tr uint64
+	rs.Reset(buf, nb)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_1:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_896
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_8
+	case 2:
+		goto L_23
+	case 3:
+		goto L_40
+	case 4:
+		goto L_57
+	case 5:
+		goto L_74
+	case 6:
+		goto L_91
+	case 7:
+		goto L_255
+	case 8:
+		goto L_272
+	case 9:
+		goto L_289
+	case 10:
+		goto L_306
+	case 11:
+		goto L_323
+	case 12:
+		goto L_342
+	case 13:
+		goto L_361
+	case 14:
+		goto L_391
+	case 15:
+		goto L_557
+	case 16:
+		goto L_576
+	case 17:
+		goto L_595
+	case 18:
+		goto L_760
+	case 19:
+		goto L_792
+	case 20:
+		goto L_809
+	case 21:
+		goto L_826
+	case 22:
+		goto L_843
+	case 23:
+		goto L_860
+	case 24:
+		goto L_877
+	}
+L_6:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_1
+L_8:
+	if tg != 15 {
+		goto L_6
+	}
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 3 {
+		return ic, rs.TypeError(3, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 1); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t7); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_21
+	}
+L_15:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_21
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	goto L_15
+L_21:
+	st, wp = rs.Pop(st)
+	goto L_1
+L_23:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 6 {
+		return ic, rs.TypeError(6, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 2); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t8); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_37
+	}
+L_31:
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_37
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	goto L_31
+L_37:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_1
+L_40:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t9); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_54
+	}
+L_48:
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_54
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_48
+L_54:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_1
+L_57:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_71
+	}
+L_65:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_71
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	goto L_65
+L_71:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_1
+L_74:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t10); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_88
+	}
+L_82:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_88
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	goto L_82
+L_88:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_1
+L_91:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 1); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t11); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_252
+	}
+L_99:
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t12); err != nil {
+		return ic, err
+	}
+	_frugal_i0(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)) = (*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)))[:0]
+L_104:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_246
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_113
+	case 2:
+		goto L_117
+	case 3:
+		goto L_123
+	case 4:
+		goto L_129
+	case 5:
+		goto L_135
+	case 6:
+		goto L_141
+	case 7:
+		goto L_147
+	case 8:
+		goto L_153
+	case 9:
+		goto L_159
+	case 10:
+		goto L_165
+	case 11:
+		goto L_171
+	case 12:
+		goto L_177
+	case 13:
+		goto L_183
+	case 14:
+		goto L_189
+	case 15:
+		goto L_195
+	case 16:
+		goto L_201
+	case 17:
+		goto L_207
+	case 18:
+		goto L_216
+	case 19:
+		goto L_225
+	case 20:
+		goto L_234
+	case 21:
+		goto L_240
+	}
+	if ic, err = rs.Unknown(ic, tg, id, unsafe.Pointer(uintptr(wp)+160)); err != nil {
+		return ic, err
+	}
+	goto L_104
+L_111:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_104
+L_113:
+	if tg != 2 {
+		goto L_111
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_104
+L_117:
+	if tg != 3 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 1)
+	goto L_104
+L_123:
+	if tg != 6 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 2)
+	goto L_104
+L_129:
+	if tg != 8 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 4)
+	goto L_104
+L_135:
+	if tg != 10 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_104
+L_141:
+	if tg != 10 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_104
+L_147:
+	if tg != 4 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_104
+L_153:
+	if tg != 4 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_104
+L_159:
+	if tg != 3 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 36)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U8(ic))
+	if tr > 127 {
+		return ic, rs.RangeError(_frugal_t0, tr, 1)
+	}
+	*(*uint8)(wp) = uint8(tr)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 36)
+	goto L_104
+L_165:
+	if tg != 8 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 38)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 65535 {
+		return ic, rs.RangeError(_frugal_t1, tr, 4)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 38)
+	goto L_104
+L_171:
+	if tg != 10 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 4294967295 {
+		return ic, rs.RangeError(_frugal_t2, tr, 8)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_104
+L_177:
+	if tg != 10 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_104
+L_183:
+	if tg != 11 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 56)
+	goto L_104
+L_189:
+	if tg != 11 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_104
+L_195:
+	if tg != 8 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_104
+L_201:
+	if tg != 11 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 104)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Fixed(ic, wp, _frugal_t4); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 104)
+	goto L_104
+L_207:
+	if tg != 8 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_104
+L_216:
+	if tg != 11 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_104
+L_225:
+	if tg != 6 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 128)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t1); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 128)
+	goto L_104
+L_234:
+	if tg != 6 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_104
+L_240:
+	if tg != 11 {
+		goto L_111
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_104
+L_246:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_252
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_99
+L_252:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_1
+L_255:
+	if tg != 14 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 3 {
+		return ic, rs.TypeError(3, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 1); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t7); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_269
+	}
+L_263:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_269
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	goto L_263
+L_269:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_1
+L_272:
+	if tg != 14 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 168)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 6 {
+		return ic, rs.TypeError(6, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 2); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t8); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_286
+	}
+L_280:
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_286
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	goto L_280
+L_286:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 168)
+	goto L_1
+L_289:
+	if tg != 14 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 192)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_303
+	}
+L_297:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_303
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_297
+L_303:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 192)
+	goto L_1
+L_306:
+	if tg != 14 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 216)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_320
+	}
+L_314:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_320
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	goto L_314
+L_320:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 216)
+	goto L_1
+L_323:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 240)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 3 {
+		return ic, rs.TypeError(3, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 3 {
+		return ic, rs.TypeError(3, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 2); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t13); err != nil {
+		return ic, err
+	}
+L_331:
+	if rs.State(st).Nb == 0 {
+		goto L_338
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t13, 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	rs.State(st).Nb--
+	goto L_331
+L_338:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 240)
+	goto L_1
+L_342:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 248)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 6 {
+		return ic, rs.TypeError(6, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 6); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t14); err != nil {
+		return ic, err
+	}
+L_350:
+	if rs.State(st).Nb == 0 {
+		goto L_357
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t14, 2)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	goto L_350
+L_357:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 248)
+	goto L_1
+L_361:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 256)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 15 {
+		return ic, rs.TypeError(15, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 9); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t15); err != nil {
+		return ic, err
+	}
+L_369:
+	if rs.State(st).Nb == 0 {
+		goto L_387
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t15, 4)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t9); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_384
+	}
+L_378:
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_384
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_378
+L_384:
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	goto L_369
+L_387:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 256)
+	goto L_1
+L_391:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 264)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 9); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t16); err != nil {
+		return ic, err
+	}
+L_399:
+	if rs.State(st).Nb == 0 {
+		goto L_553
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t16, 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t12); err != nil {
+		return ic, err
+	}
+	_frugal_i0(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)) = (*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)))[:0]
+L_407:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_549
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_416
+	case 2:
+		goto L_420
+	case 3:
+		goto L_426
+	case 4:
+		goto L_432
+	case 5:
+		goto L_438
+	case 6:
+		goto L_444
+	case 7:
+		goto L_450
+	case 8:
+		goto L_456
+	case 9:
+		goto L_462
+	case 10:
+		goto L_468
+	case 11:
+		goto L_474
+	case 12:
+		goto L_480
+	case 13:
+		goto L_486
+	case 14:
+		goto L_492
+	case 15:
+		goto L_498
+	case 16:
+		goto L_504
+	case 17:
+		goto L_510
+	case 18:
+		goto L_519
+	case 19:
+		goto L_528
+	case 20:
+		goto L_537
+	case 21:
+		goto L_543
+	}
+	if ic, err = rs.Unknown(ic, tg, id, unsafe.Pointer(uintptr(wp)+160)); err != nil {
+		return ic, err
+	}
+	goto L_407
+L_414:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_407
+L_416:
+	if tg != 2 {
+		goto L_414
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_407
+L_420:
+	if tg != 3 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 1)
+	goto L_407
+L_426:
+	if tg != 6 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 2)
+	goto L_407
+L_432:
+	if tg != 8 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 4)
+	goto L_407
+L_438:
+	if tg != 10 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_407
+L_444:
+	if tg != 10 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_407
+L_450:
+	if tg != 4 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_407
+L_456:
+	if tg != 4 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_407
+L_462:
+	if tg != 3 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 36)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U8(ic))
+	if tr > 127 {
+		return ic, rs.RangeError(_frugal_t0, tr, 1)
+	}
+	*(*uint8)(wp) = uint8(tr)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 36)
+	goto L_407
+L_468:
+	if tg != 8 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 38)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 65535 {
+		return ic, rs.RangeError(_frugal_t1, tr, 4)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 38)
+	goto L_407
+L_474:
+	if tg != 10 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 4294967295 {
+		return ic, rs.RangeError(_frugal_t2, tr, 8)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_407
+L_480:
+	if tg != 10 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_407
+L_486:
+	if tg != 11 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 56)
+	goto L_407
+L_492:
+	if tg != 11 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_407
+L_498:
+	if tg != 8 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_407
+L_504:
+	if tg != 11 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 104)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Fixed(ic, wp, _frugal_t4); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 104)
+	goto L_407
+L_510:
+	if tg != 8 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_407
+L_519:
+	if tg != 11 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_407
+L_528:
+	if tg != 6 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 128)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t1); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 128)
+	goto L_407
+L_537:
+	if tg != 6 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_407
+L_543:
+	if tg != 11 {
+		goto L_414
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_407
+L_549:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	goto L_399
+L_553:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 264)
+	goto L_1
+L_557:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 272)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 4 {
+		return ic, rs.TypeError(4, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 12); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t17); err != nil {
+		return ic, err
+	}
+L_565:
+	if rs.State(st).Nb == 0 {
+		goto L_572
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t17); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	rs.State(st).Nb--
+	goto L_565
+L_572:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 272)
+	goto L_1
+L_576:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 280)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 2 {
+		return ic, rs.TypeError(2, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 5); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t18); err != nil {
+		return ic, err
+	}
+L_584:
+	if rs.State(st).Nb == 0 {
+		goto L_591
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetEnum(ic, st, _frugal_t18)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	rs.State(st).Nb--
+	goto L_584
+L_591:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 280)
+	goto L_1
+L_595:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 288)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 5); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t19); err != nil {
+		return ic, err
+	}
+L_603:
+	if rs.State(st).Nb == 0 {
+		goto L_756
+	}
+	if wp, err = rs.New(_frugal_t12); err != nil {
+		return ic, err
+	}
+	_frugal_i0(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)) = (*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)))[:0]
+L_608:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_750
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_617
+	case 2:
+		goto L_621
+	case 3:
+		goto L_627
+	case 4:
+		goto L_633
+	case 5:
+		goto L_639
+	case 6:
+		goto L_645
+	case 7:
+		goto L_651
+	case 8:
+		goto L_657
+	case 9:
+		goto L_663
+	case 10:
+		goto L_669
+	case 11:
+		goto L_675
+	case 12:
+		goto L_681
+	case 13:
+		goto L_687
+	case 14:
+		goto L_693
+	case 15:
+		goto L_699
+	case 16:
+		goto L_705
+	case 17:
+		goto L_711
+	case 18:
+		goto L_720
+	case 19:
+		goto L_729
+	case 20:
+		goto L_738
+	case 21:
+		goto L_744
+	}
+	if ic, err = rs.Unknown(ic, tg, id, unsafe.Pointer(uintptr(wp)+160)); err != nil {
+		return ic, err
+	}
+	goto L_608
+L_615:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_608
+L_617:
+	if tg != 2 {
+		goto L_615
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_608
+L_621:
+	if tg != 3 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 1)
+	goto L_608
+L_627:
+	if tg != 6 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 2)
+	goto L_608
+L_633:
+	if tg != 8 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 4)
+	goto L_608
+L_639:
+	if tg != 10 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_608
+L_645:
+	if tg != 10 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_608
+L_651:
+	if tg != 4 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_608
+L_657:
+	if tg != 4 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_608
+L_663:
+	if tg != 3 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 36)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U8(ic))
+	if tr > 127 {
+		return ic, rs.RangeError(_frugal_t0, tr, 1)
+	}
+	*(*uint8)(wp) = uint8(tr)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 36)
+	goto L_608
+L_669:
+	if tg != 8 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 38)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 65535 {
+		return ic, rs.RangeError(_frugal_t1, tr, 4)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 38)
+	goto L_608
+L_675:
+	if tg != 10 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 4294967295 {
+		return ic, rs.RangeError(_frugal_t2, tr, 8)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_608
+L_681:
+	if tg != 10 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_608
+L_687:
+	if tg != 11 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 56)
+	goto L_608
+L_693:
+	if tg != 11 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_608
+L_699:
+	if tg != 8 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_608
+L_705:
+	if tg != 11 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 104)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Fixed(ic, wp, _frugal_t4); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 104)
+	goto L_608
+L_711:
+	if tg != 8 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_608
+L_720:
+	if tg != 11 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_608
+L_729:
+	if tg != 6 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 128)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t1); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 128)
+	goto L_608
+L_738:
+	if tg != 6 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_608
+L_744:
+	if tg != 11 {
+		goto L_615
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_608
+L_750:
+	st, wp = rs.Pop(st)
+	wp = rs.MapSetPointer(wp, st, _frugal_t19)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	goto L_603
+L_756:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 288)
+	goto L_1
+L_760:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 296)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 13 {
+		return ic, rs.TypeError(13, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 10); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t20); err != nil {
+		return ic, err
+	}
+L_768:
+	if rs.State(st).Nb == 0 {
+		goto L_788
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t20); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t21); err != nil {
+		return ic, err
+	}
+L_777:
+	if rs.State(st).Nb == 0 {
+		goto L_784
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t21); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	goto L_777
+L_784:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	goto L_768
+L_788:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 296)
+	goto L_1
+L_792:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 304)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if err = rs.ArrayCheck(st, _frugal_t22); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_806
+	}
+L_800:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_806
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_800
+L_806:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 304)
+	goto L_1
+L_809:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 320)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.ArrayCheck(st, _frugal_t23); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_823
+	}
+L_817:
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_823
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_817
+L_823:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 320)
+	goto L_1
+L_826:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 336)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 4 {
+		return ic, rs.TypeError(4, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.ArrayCheck(st, _frugal_t24); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_840
+	}
+L_834:
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_840
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_834
+L_840:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 336)
+	goto L_1
+L_843:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 344)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 4 {
+		return ic, rs.TypeError(4, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t25); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_857
+	}
+L_851:
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_857
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_851
+L_857:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 344)
+	goto L_1
+L_860:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 368)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t2); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_874
+	}
+L_868:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 2147483647 {
+		return ic, rs.RangeError(_frugal_t2, tr, 4)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_874
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_868
+L_874:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 368)
+	goto L_1
+L_877:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 392)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 6 {
+		return ic, rs.TypeError(6, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 6); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t26); err != nil {
+		return ic, err
+	}
+L_885:
+	if rs.State(st).Nb == 0 {
+		goto L_892
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t26); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	rs.State(st).Nb--
+	goto L_885
+L_892:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 392)
+	goto L_1
+L_896:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_2(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	rs.Reset(buf, nb)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb = 0
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	*(*uintptr)(unsafe.Pointer(uintptr(wp) + 8)) = 0
+	*(*uintptr)(unsafe.Pointer(uintptr(wp) + 16)) = 0
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+L_12:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_139
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_19
+	case 2:
+		goto L_27
+	case 3:
+		goto L_37
+	case 4:
+		goto L_55
+	case 5:
+		goto L_75
+	}
+L_17:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_12
+L_19:
+	if tg != 10 {
+		goto L_17
+	}
+	if err = rs.UnionMark(st, 1, _frugal_t27); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	st, wp = rs.Pop(st)
+	goto L_12
+L_27:
+	if tg != 11 {
+		goto L_17
+	}
+	if err = rs.UnionMark(st, 2, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_12
+L_37:
+	if tg != 15 {
+		goto L_17
+	}
+	if err = rs.UnionMark(st, 3, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_52
+	}
+L_46:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_52
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_46
+L_52:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_12
+L_55:
+	if tg != 13 {
+		goto L_17
+	}
+	if err = rs.UnionMark(st, 4, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t28); err != nil {
+		return ic, err
+	}
+L_64:
+	if rs.State(st).Nb == 0 {
+		goto L_71
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t28); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	goto L_64
+L_71:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_12
+L_75:
+	if tg != 12 {
+		goto L_17
+	}
+	if err = rs.UnionMark(st, 5, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_81:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_135
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_88
+	case 2:
+		goto L_92
+	case 3:
+		goto L_100
+	case 4:
+		goto L_108
+	case 5:
+		goto L_127
+	}
+L_86:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_81
+L_88:
+	if tg != 10 {
+		goto L_86
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	goto L_81
+L_92:
+	if tg != 12 {
+		goto L_86
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_81
+L_100:
+	if tg != 12 {
+		goto L_86
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_81
+L_108:
+	if tg != 15 {
+		goto L_86
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 1); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t30); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_124
+	}
+L_116:
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_124
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_116
+L_124:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_81
+L_127:
+	if tg != 12 {
+		goto L_86
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t27); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t27, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_81
+L_135:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_12
+L_139:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_3(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	rs.Reset(buf, nb)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_1:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_139
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_8
+	case 2:
+		goto L_12
+	case 3:
+		goto L_20
+	case 4:
+		goto L_28
+	case 5:
+		goto L_47
+	}
+L_6:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_1
+L_8:
+	if tg != 10 {
+		goto L_6
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	goto L_1
+L_12:
+	if tg != 12 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_1
+L_20:
+	if tg != 12 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_1
+L_28:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 1); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t30); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_44
+	}
+L_36:
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_44
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_36
+L_44:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_1
+L_47:
+	if tg != 12 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t27); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb = 0
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	*(*uintptr)(unsafe.Pointer(uintptr(wp) + 8)) = 0
+	*(*uintptr)(unsafe.Pointer(uintptr(wp) + 16)) = 0
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	*(*unsafe.Pointer)(wp) = nil
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+L_63:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_135
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_70
+	case 2:
+		goto L_78
+	case 3:
+		goto L_88
+	case 4:
+		goto L_106
+	case 5:
+		goto L_126
+	}
+L_68:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_63
+L_70:
+	if tg != 10 {
+		goto L_68
+	}
+	if err = rs.UnionMark(st, 1, _frugal_t27); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	st, wp = rs.Pop(st)
+	goto L_63
+L_78:
+	if tg != 11 {
+		goto L_68
+	}
+	if err = rs.UnionMark(st, 2, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_63
+L_88:
+	if tg != 15 {
+		goto L_68
+	}
+	if err = rs.UnionMark(st, 3, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_103
+	}
+L_97:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_103
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_97
+L_103:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_63
+L_106:
+	if tg != 13 {
+		goto L_68
+	}
+	if err = rs.UnionMark(st, 4, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t28); err != nil {
+		return ic, err
+	}
+L_115:
+	if rs.State(st).Nb == 0 {
+		goto L_122
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t28); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	goto L_115
+L_122:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_63
+L_126:
+	if tg != 12 {
+		goto L_68
+	}
+	if err = rs.UnionMark(st, 5, _frugal_t27); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t29); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Decode(_frugal_t29, ic, wp, st); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_63
+L_135:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_1
+L_139:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_4(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	rs.Reset(buf, nb)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_1:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_73
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_8
+	case 2:
+		goto L_16
+	case 3:
+		goto L_29
+	case 4:
+		goto L_50
+	}
+L_6:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_1
+L_8:
+	if tg != 10 {
+		goto L_6
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.New(_frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	if err = rs.Unmarshal(st, _frugal_t31, wp); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	goto L_1
+L_16:
+	if tg != 10 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t31); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.New(_frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	if err = rs.Unmarshal(st, _frugal_t31, wp); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_1
+L_29:
+	if tg != 15 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t31); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_47
+	}
+L_37:
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.New(_frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	if err = rs.Unmarshal(st, _frugal_t31, wp); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_47
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	goto L_37
+L_47:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_1
+L_50:
+	if tg != 13 {
+		goto L_6
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 12); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t32); err != nil {
+		return ic, err
+	}
+L_58:
+	if rs.State(st).Nb == 0 {
+		goto L_69
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t32); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.New(_frugal_t9); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	if err = rs.Unmarshal(st, _frugal_t31, wp); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	goto L_58
+L_69:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_1
+L_73:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_5(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	var tr uint64
+	rs.Reset(buf, nb)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	rs.Bitmap(st, 0, 4)
+L_2:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_182
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_9
+	case 2:
+		goto L_14
+	case 3:
+		goto L_21
+	case 300:
+		goto L_175
+	}
+L_7:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_2
+L_9:
+	if tg != 8 {
+		goto L_7
+	}
+	rs.State(st).Fm[0] |= 2
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	goto L_2
+L_14:
+	if tg != 11 {
+		goto L_7
+	}
+	rs.State(st).Fm[0] |= 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_2
+L_21:
+	if tg != 12 {
+		goto L_7
+	}
+	rs.State(st).Fm[0] |= 8
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t12); err != nil {
+		return ic, err
+	}
+	_frugal_i0(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)) = (*(*[]byte)(unsafe.Pointer(uintptr(wp) + 160)))[:0]
+L_29:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_171
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_38
+	case 2:
+		goto L_42
+	case 3:
+		goto L_48
+	case 4:
+		goto L_54
+	case 5:
+		goto L_60
+	case 6:
+		goto L_66
+	case 7:
+		goto L_72
+	case 8:
+		goto L_78
+	case 9:
+		goto L_84
+	case 10:
+		goto L_90
+	case 11:
+		goto L_96
+	case 12:
+		goto L_102
+	case 13:
+		goto L_108
+	case 14:
+		goto L_114
+	case 15:
+		goto L_120
+	case 16:
+		goto L_126
+	case 17:
+		goto L_132
+	case 18:
+		goto L_141
+	case 19:
+		goto L_150
+	case 20:
+		goto L_159
+	case 21:
+		goto L_165
+	}
+	if ic, err = rs.Unknown(ic, tg, id, unsafe.Pointer(uintptr(wp)+160)); err != nil {
+		return ic, err
+	}
+	goto L_29
+L_36:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_29
+L_38:
+	if tg != 2 {
+		goto L_36
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_29
+L_42:
+	if tg != 3 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 1)
+	goto L_29
+L_48:
+	if tg != 6 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 2)
+	goto L_29
+L_54:
+	if tg != 8 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 4)
+	goto L_29
+L_60:
+	if tg != 10 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_29
+L_66:
+	if tg != 10 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_29
+L_72:
+	if tg != 4 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_29
+L_78:
+	if tg != 4 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.Float(ic, wp); err != nil {
+		return ic, err
+	}
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_29
+L_84:
+	if tg != 3 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 36)
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U8(ic))
+	if tr > 127 {
+		return ic, rs.RangeError(_frugal_t0, tr, 1)
+	}
+	*(*uint8)(wp) = uint8(tr)
+	ic += 1
+	wp = unsafe.Pointer(uintptr(wp) - 36)
+	goto L_29
+L_90:
+	if tg != 8 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 38)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U32(ic))
+	if tr > 65535 {
+		return ic, rs.RangeError(_frugal_t1, tr, 4)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 38)
+	goto L_29
+L_96:
+	if tg != 10 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 4294967295 {
+		return ic, rs.RangeError(_frugal_t2, tr, 8)
+	}
+	*(*uint32)(wp) = uint32(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_29
+L_102:
+	if tg != 10 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U64(ic))
+	if tr > 9223372036854775807 {
+		return ic, rs.RangeError(_frugal_t3, tr, 8)
+	}
+	*(*uint64)(wp) = uint64(tr)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_29
+L_108:
+	if tg != 11 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 56)
+	goto L_29
+L_114:
+	if tg != 11 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_29
+L_120:
+	if tg != 8 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 96)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 96)
+	goto L_29
+L_126:
+	if tg != 11 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 104)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Fixed(ic, wp, _frugal_t4); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 104)
+	goto L_29
+L_132:
+	if tg != 8 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_29
+L_141:
+	if tg != 11 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 120)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t6); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 120)
+	goto L_29
+L_150:
+	if tg != 6 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 128)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t1); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	tr = uint64(rs.U16(ic))
+	if tr > 32767 {
+		return ic, rs.RangeError(_frugal_t1, tr, 2)
+	}
+	*(*uint16)(wp) = uint16(tr)
+	ic += 2
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 128)
+	goto L_29
+L_159:
+	if tg != 6 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	*(*uint16)(wp) = rs.U16(ic)
+	ic += 2
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_29
+L_165:
+	if tg != 11 {
+		goto L_36
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_29
+L_171:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_2
+L_175:
+	if tg != 10 {
+		goto L_7
+	}
+	rs.State(st).Fm[4] |= 17592186044416
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_2
+L_182:
+	if err = rs.Require(st, _frugal_t33, 0, 14); err != nil {
+		return ic, err
+	}
+	if err = rs.Require(st, _frugal_t33, 4, 17592186044416); err != nil {
+		return ic, err
+	}
+	rs.Release(st)
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_dec_6(buf unsafe.Pointer, nb int, ic int, wp unsafe.Pointer, rs *aot.DecodeState, st int) (int, error) {
+	var err error
+	var id int
+	var tg uint8
+	rs.Reset(buf, nb)
+	_frugal_i1(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_2:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_267
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_9
+	case 2:
+		goto L_13
+	case 3:
+		goto L_19
+	case 4:
+		goto L_25
+	case 5:
+		goto L_31
+	case 6:
+		goto L_37
+	case 7:
+		goto L_43
+	case 8:
+		goto L_49
+	case 9:
+		goto L_106
+	case 10:
+		goto L_123
+	case 11:
+		goto L_140
+	case 12:
+		goto L_159
+	case 13:
+		goto L_178
+	case 14:
+		goto L_197
+	}
+L_7:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_2
+L_9:
+	if tg != 3 {
+		goto L_7
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_2
+L_13:
+	if tg != 10 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_2
+L_19:
+	if tg != 4 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_2
+L_25:
+	if tg != 8 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_2
+L_31:
+	if tg != 11 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_2
+L_37:
+	if tg != 11 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_2
+L_43:
+	if tg != 8 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_2
+L_49:
+	if tg != 12 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 80)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t34); err != nil {
+		return ic, err
+	}
+	_frugal_i2(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_55:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_102
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_62
+	case 2:
+		goto L_66
+	case 3:
+		goto L_72
+	case 4:
+		goto L_78
+	case 5:
+		goto L_84
+	case 6:
+		goto L_90
+	case 7:
+		goto L_96
+	}
+L_60:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_55
+L_62:
+	if tg != 3 {
+		goto L_60
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_55
+L_66:
+	if tg != 10 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_55
+L_72:
+	if tg != 4 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_55
+L_78:
+	if tg != 8 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_55
+L_84:
+	if tg != 11 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_55
+L_90:
+	if tg != 11 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_55
+L_96:
+	if tg != 8 {
+		goto L_60
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_55
+L_102:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 80)
+	goto L_2
+L_106:
+	if tg != 15 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 88)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_120
+	}
+L_114:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_120
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_114
+L_120:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 88)
+	goto L_2
+L_123:
+	if tg != 14 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 112)
+	if ic, err = rs.Check(ic, 5); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 4); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.ListAlloc(wp, st, _frugal_t5); err != nil {
+		return ic, err
+	}
+	if rs.State(st).Nb == 0 {
+		goto L_137
+	}
+L_131:
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	rs.State(st).Nb--
+	if rs.State(st).Nb == 0 {
+		goto L_137
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	goto L_131
+L_137:
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 112)
+	goto L_2
+L_140:
+	if tg != 13 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 136)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 8 {
+		return ic, rs.TypeError(8, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 12); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t35); err != nil {
+		return ic, err
+	}
+L_148:
+	if rs.State(st).Nb == 0 {
+		goto L_155
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t35, 4)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	rs.State(st).Nb--
+	goto L_148
+L_155:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 136)
+	goto L_2
+L_159:
+	if tg != 13 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 144)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 10 {
+		return ic, rs.TypeError(10, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 12); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t36); err != nil {
+		return ic, err
+	}
+L_167:
+	if rs.State(st).Nb == 0 {
+		goto L_174
+	}
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	ic, wp = rs.MapSetInt(ic, st, _frugal_t36, 8)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	goto L_167
+L_174:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 144)
+	goto L_2
+L_178:
+	if tg != 13 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 152)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 8); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t21); err != nil {
+		return ic, err
+	}
+L_186:
+	if rs.State(st).Nb == 0 {
+		goto L_193
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t21); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	rs.State(st).Nb--
+	goto L_186
+L_193:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 152)
+	goto L_2
+L_197:
+	if tg != 13 {
+		goto L_7
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 160)
+	if ic, err = rs.Check(ic, 6); err != nil {
+		return ic, err
+	}
+	if tv := rs.U8(ic); tv != 11 {
+		return ic, rs.TypeError(11, tv)
+	}
+	ic += 1
+	if tv := rs.U8(ic); tv != 12 {
+		return ic, rs.TypeError(12, tv)
+	}
+	ic += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Container(ic, st, 5); err != nil {
+		return ic, err
+	}
+	if err = rs.MapAlloc(wp, st, _frugal_t37); err != nil {
+		return ic, err
+	}
+L_205:
+	if rs.State(st).Nb == 0 {
+		goto L_263
+	}
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, wp, err = rs.MapSetString(ic, st, _frugal_t37); err != nil {
+		return ic, err
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+	if wp, err = rs.Deref(wp, _frugal_t34); err != nil {
+		return ic, err
+	}
+	_frugal_i2(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return ic, err
+	}
+L_212:
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	tg = rs.U8(ic)
+	ic += 1
+	if tg == 0 {
+		goto L_259
+	}
+	if ic, err = rs.Check(ic, 2); err != nil {
+		return ic, err
+	}
+	id = int(rs.U16(ic))
+	ic += 2
+	switch id {
+	case 1:
+		goto L_219
+	case 2:
+		goto L_223
+	case 3:
+		goto L_229
+	case 4:
+		goto L_235
+	case 5:
+		goto L_241
+	case 6:
+		goto L_247
+	case 7:
+		goto L_253
+	}
+L_217:
+	if ic, err = rs.Skip(ic, tg); err != nil {
+		return ic, err
+	}
+	goto L_212
+L_219:
+	if tg != 3 {
+		goto L_217
+	}
+	if ic, err = rs.Check(ic, 1); err != nil {
+		return ic, err
+	}
+	*(*uint8)(wp) = rs.U8(ic)
+	ic += 1
+	goto L_212
+L_223:
+	if tg != 10 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 8)
+	goto L_212
+L_229:
+	if tg != 4 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if ic, err = rs.Check(ic, 8); err != nil {
+		return ic, err
+	}
+	*(*uint64)(wp) = rs.U64(ic)
+	ic += 8
+	wp = unsafe.Pointer(uintptr(wp) - 16)
+	goto L_212
+L_235:
+	if tg != 8 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*uint32)(wp) = rs.U32(ic)
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 24)
+	goto L_212
+L_241:
+	if tg != 11 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.String(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_212
+L_247:
+	if tg != 11 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 48)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	if ic, err = rs.Binary(ic, wp, false); err != nil {
+		return ic, err
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_212
+L_253:
+	if tg != 8 {
+		goto L_217
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 72)
+	if ic, err = rs.Check(ic, 4); err != nil {
+		return ic, err
+	}
+	*(*int64)(wp) = int64(int32(rs.U32(ic)))
+	ic += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	goto L_212
+L_259:
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+	rs.State(st).Nb--
+	goto L_205
+L_263:
+	rs.State(st).Mp = nil
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	goto L_2
+L_267:
+	st, wp = rs.Pop(st)
+	return ic, nil
+}
+
+func _frugal_enc_0(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_39
+	}
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_13
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_13:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_21
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_21:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_28
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_28:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_31
+	}
+	rl += 5
+L_31:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_35
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_35:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	goto L_157
+L_39:
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_118
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_118:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_129
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_129:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_139
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_139:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_145
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_145:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_152
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_152:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_157:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_1(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_43
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_42
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_16
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_16:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_24
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_24:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_31
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_31:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_34
+	}
+	rl += 5
+L_34:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_38
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_38:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+L_42:
+	goto L_165
+L_43:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_165
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_125
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_125:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_136
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_136:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_146
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_146:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_152
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_152:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_159
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_159:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_165:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_2(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_361
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_4
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_4:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_8
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 2
+L_8:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_12
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 8
+L_12:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_25
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_25
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_20
+L_19:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_20:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_19
+	}
+	st, wp = rs.Pop(st)
+L_25:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_38
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_38
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_33
+L_32:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+L_33:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_32
+	}
+	st, wp = rs.Pop(st)
+L_38:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_92
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_92
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_46
+L_45:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_46:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_88
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_61
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_61:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_69
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_69:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_76
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_76:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_79
+	}
+	rl += 5
+L_79:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_83
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_83:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_89
+L_88:
+	rl += 1
+L_89:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_45
+	}
+	st, wp = rs.Pop(st)
+L_92:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_96
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_96:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_100
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 2
+L_100:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_104
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_104:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_117
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_117
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_112
+L_111:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_112:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_111
+	}
+	st, wp = rs.Pop(st)
+L_117:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_121
+	}
+	rl += aot.MapLen(wp) * 2
+L_121:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_134
+	}
+	rl += aot.MapLen(wp) * 2
+	if aot.MapLen(wp) == 0 {
+		goto L_134
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t14, wp)
+L_128:
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_128
+	}
+	st, wp = rs.Pop(st)
+L_134:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_148
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_148
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t15, wp)
+L_141:
+	wp = rs.State(st).Mi.V
+	rl += 5
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_145
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 8
+L_145:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_141
+	}
+	st, wp = rs.Pop(st)
+L_148:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_202
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_202
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t16, wp)
+L_155:
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_198
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_171
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_171:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_179
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_179:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_186
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_186:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_189
+	}
+	rl += 5
+L_189:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_193
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_193:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_199
+L_198:
+	rl += 1
+L_199:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_155
+	}
+	st, wp = rs.Pop(st)
+L_202:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_215
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_215
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t17, wp)
+L_209:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_209
+	}
+	st, wp = rs.Pop(st)
+L_215:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_219
+	}
+	rl += aot.MapLen(wp) * 5
+L_219:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_273
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_273
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t19, wp)
+L_226:
+	wp = rs.State(st).Mi.K
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_269
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_242
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_242:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_250
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_250:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_257
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_257:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_260
+	}
+	rl += 5
+L_260:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_264
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_264:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_270
+L_269:
+	rl += 1
+L_270:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_226
+	}
+	st, wp = rs.Pop(st)
+L_273:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_300
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_300
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t20, wp)
+L_279:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 6
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_297
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_297
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_288:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_288
+	}
+	st, wp = rs.Pop(st)
+L_297:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_279
+	}
+	st, wp = rs.Pop(st)
+L_300:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 28
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_307
+L_306:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_307:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_306
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_317
+L_316:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_317:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_316
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_333
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_333
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_329
+L_328:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_329:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_328
+	}
+	st, wp = rs.Pop(st)
+L_333:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_345
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_345
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_341
+L_340:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_341:
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_340
+	}
+	st, wp = rs.Pop(st)
+L_345:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_359
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_359
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t26, wp)
+L_351:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 2
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_351
+	}
+	st, wp = rs.Pop(st)
+L_359:
+	wp = unsafe.Pointer(uintptr(wp) - 392)
+	goto L_1106
+L_361:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000103)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_366
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_366:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000206)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_372
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 2); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_372:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00030a)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_378
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_378:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_394
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_394
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_388
+L_387:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_388:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_387
+	}
+	st, wp = rs.Pop(st)
+L_394:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00050b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_410
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_410
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_404
+L_403:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+L_404:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_403
+	}
+	st, wp = rs.Pop(st)
+L_410:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00060c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_548
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_548
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_420
+L_419:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_420:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_543
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_502
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_502:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_513
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_513:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_523
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_523:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_529
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_529:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_536
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_536:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_545
+L_543:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_545:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_419
+	}
+	st, wp = rs.Pop(st)
+L_548:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000703)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_555
+	}
+	if err = rs.Unique(wp, _frugal_t7); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_555:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000806)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_562
+	}
+	if err = rs.Unique(wp, _frugal_t8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 2); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_562:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000908)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_569
+	}
+	if err = rs.Unique(wp, _frugal_t5); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_569:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000a0b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_586
+	}
+	if err = rs.Unique(wp, _frugal_t6); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_586
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_580
+L_579:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_580:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_579
+	}
+	st, wp = rs.Pop(st)
+L_586:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000b03)
+	rl += 4
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_605
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_606
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t13, wp)
+L_595:
+	wp = rs.State(st).Mi.K
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = rs.State(st).Mi.V
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_595
+	}
+	st, wp = rs.Pop(st)
+	goto L_606
+L_605:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_606:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000c06)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_626
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_627
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t14, wp)
+L_615:
+	wp = rs.State(st).Mi.K
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_615
+	}
+	st, wp = rs.Pop(st)
+	goto L_627
+L_626:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_627:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000d08)
+	rl += 4
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_649
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_650
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t15, wp)
+L_636:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_645
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_645:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_636
+	}
+	st, wp = rs.Pop(st)
+	goto L_650
+L_649:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_650:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000e0a)
+	rl += 4
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_792
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_793
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t16, wp)
+L_659:
+	wp = rs.State(st).Mi.K
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_786
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_745
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_745:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_756
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_756:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_766
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_766:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_772
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_772:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_779
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_779:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_788
+L_786:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_788:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_659
+	}
+	st, wp = rs.Pop(st)
+	goto L_793
+L_792:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_793:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000f0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_813
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_814
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t17, wp)
+L_802:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_802
+	}
+	st, wp = rs.Pop(st)
+	goto L_814
+L_813:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_814:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d001008)
+	rl += 4
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_833
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_834
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t18, wp)
+L_823:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_823
+	}
+	st, wp = rs.Pop(st)
+	goto L_834
+L_833:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_834:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00110c)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_976
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_977
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t19, wp)
+L_843:
+	wp = rs.State(st).Mi.K
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_967
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_926
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_926:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_937
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_937:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_947
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_947:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_953
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_953:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_960
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_960:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_969
+L_967:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_969:
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_843
+	}
+	st, wp = rs.Pop(st)
+	goto L_977
+L_976:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_977:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00120b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1015
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1016
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t20, wp)
+L_986:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+6 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 6); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b0b)
+	rl += 2
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1010
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1011
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_998:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_998
+	}
+	st, wp = rs.Pop(st)
+	goto L_1011
+L_1010:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1011:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_986
+	}
+	st, wp = rs.Pop(st)
+	goto L_1016
+L_1015:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1016:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00130800000003)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 3
+	goto L_1023
+L_1022:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1023:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1022
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00140a00000002)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_1035
+L_1034:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_1035:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1034
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00150400000002)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_1047
+L_1046:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1047:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1046
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f001604)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1067
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_1067
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_1062
+L_1061:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1062:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1061
+	}
+	st, wp = rs.Pop(st)
+L_1067:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f001708)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1082
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_1082
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_1077
+L_1076:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1077:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint32)(wp))
+	if tr > 2147483647 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t2, tr, 4))
+	}
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1076
+	}
+	st, wp = rs.Pop(st)
+L_1082:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00180b)
+	rl += 4
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1102
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1103
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t26, wp)
+L_1091:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_1091
+	}
+	st, wp = rs.Pop(st)
+	goto L_1103
+L_1102:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1103:
+	wp = unsafe.Pointer(uintptr(wp) - 392)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_1106:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_3(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_365
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_364
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_7
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_7:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_11
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 2
+L_11:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_15
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 8
+L_15:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_28
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_28
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_23
+L_22:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_23:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_22
+	}
+	st, wp = rs.Pop(st)
+L_28:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_41
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_41
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_36
+L_35:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+L_36:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_35
+	}
+	st, wp = rs.Pop(st)
+L_41:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_95
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_95
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_49
+L_48:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_49:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_91
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_64
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_64:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_72
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_72:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_79
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_79:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_82
+	}
+	rl += 5
+L_82:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_86
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_86:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_92
+L_91:
+	rl += 1
+L_92:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_48
+	}
+	st, wp = rs.Pop(st)
+L_95:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_99
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_99:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_103
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 2
+L_103:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_107
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_107:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_120
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_120
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_115
+L_114:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_115:
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_114
+	}
+	st, wp = rs.Pop(st)
+L_120:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_124
+	}
+	rl += aot.MapLen(wp) * 2
+L_124:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_137
+	}
+	rl += aot.MapLen(wp) * 2
+	if aot.MapLen(wp) == 0 {
+		goto L_137
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t14, wp)
+L_131:
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_131
+	}
+	st, wp = rs.Pop(st)
+L_137:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_151
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_151
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t15, wp)
+L_144:
+	wp = rs.State(st).Mi.V
+	rl += 5
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_148
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 8
+L_148:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_144
+	}
+	st, wp = rs.Pop(st)
+L_151:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_205
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_205
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t16, wp)
+L_158:
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_201
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_174
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_174:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_182
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_182:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_189
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_189:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_192
+	}
+	rl += 5
+L_192:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_196
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_196:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_202
+L_201:
+	rl += 1
+L_202:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_158
+	}
+	st, wp = rs.Pop(st)
+L_205:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_218
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_218
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t17, wp)
+L_212:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_212
+	}
+	st, wp = rs.Pop(st)
+L_218:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_222
+	}
+	rl += aot.MapLen(wp) * 5
+L_222:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_276
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_276
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t19, wp)
+L_229:
+	wp = rs.State(st).Mi.K
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_272
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_245
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_245:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_253
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_253:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_260
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_260:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_263
+	}
+	rl += 5
+L_263:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_267
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_267:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_273
+L_272:
+	rl += 1
+L_273:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_229
+	}
+	st, wp = rs.Pop(st)
+L_276:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_303
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_303
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t20, wp)
+L_282:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 6
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_300
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_300
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_291:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_291
+	}
+	st, wp = rs.Pop(st)
+L_300:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_282
+	}
+	st, wp = rs.Pop(st)
+L_303:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 28
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_310
+L_309:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_310:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_309
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_320
+L_319:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_320:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_319
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_336
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_336
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_332
+L_331:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_332:
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_331
+	}
+	st, wp = rs.Pop(st)
+L_336:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_348
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_348
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_344
+L_343:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_344:
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_343
+	}
+	st, wp = rs.Pop(st)
+L_348:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_362
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_362
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t26, wp)
+L_354:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 2
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_354
+	}
+	st, wp = rs.Pop(st)
+L_362:
+	wp = unsafe.Pointer(uintptr(wp) - 392)
+	st, wp = rs.Pop(st)
+L_364:
+	goto L_1114
+L_365:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1114
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000103)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_373
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_373:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000206)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_379
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 2); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_379:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00030a)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_385
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_385:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_401
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_401
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_395
+L_394:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_395:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_394
+	}
+	st, wp = rs.Pop(st)
+L_401:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00050b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_417
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_417
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_411
+L_410:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+L_411:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_410
+	}
+	st, wp = rs.Pop(st)
+L_417:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00060c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_555
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_555
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_427
+L_426:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_427:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_550
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_509
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_509:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_520
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_520:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_530
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_530:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_536
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_536:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_543
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_543:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_552
+L_550:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_552:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_426
+	}
+	st, wp = rs.Pop(st)
+L_555:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000703)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_562
+	}
+	if err = rs.Unique(wp, _frugal_t7); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_562:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000806)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_569
+	}
+	if err = rs.Unique(wp, _frugal_t8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 2); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_569:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000908)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_576
+	}
+	if err = rs.Unique(wp, _frugal_t5); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_576:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000a0b)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_593
+	}
+	if err = rs.Unique(wp, _frugal_t6); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_593
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_587
+L_586:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+L_587:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_586
+	}
+	st, wp = rs.Pop(st)
+L_593:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000b03)
+	rl += 4
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_612
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_613
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t13, wp)
+L_602:
+	wp = rs.State(st).Mi.K
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = rs.State(st).Mi.V
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_602
+	}
+	st, wp = rs.Pop(st)
+	goto L_613
+L_612:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_613:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000c06)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_633
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_634
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t14, wp)
+L_622:
+	wp = rs.State(st).Mi.K
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_622
+	}
+	st, wp = rs.Pop(st)
+	goto L_634
+L_633:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_634:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000d08)
+	rl += 4
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_656
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_657
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t15, wp)
+L_643:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_652
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 8); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_652:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_643
+	}
+	st, wp = rs.Pop(st)
+	goto L_657
+L_656:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_657:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000e0a)
+	rl += 4
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_799
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_800
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t16, wp)
+L_666:
+	wp = rs.State(st).Mi.K
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_793
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_752
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_752:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_763
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_763:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_773
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_773:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_779
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_779:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_786
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_786:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_795
+L_793:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_795:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_666
+	}
+	st, wp = rs.Pop(st)
+	goto L_800
+L_799:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_800:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000f0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_820
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_821
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t17, wp)
+L_809:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_809
+	}
+	st, wp = rs.Pop(st)
+	goto L_821
+L_820:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_821:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d001008)
+	rl += 4
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_840
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_841
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t18, wp)
+L_830:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_830
+	}
+	st, wp = rs.Pop(st)
+	goto L_841
+L_840:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_841:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00110c)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_983
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_984
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t19, wp)
+L_850:
+	wp = rs.State(st).Mi.K
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_974
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_933
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_933:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_944
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_944:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_954
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_954:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_960
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_960:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_967
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_967:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_976
+L_974:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_976:
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_850
+	}
+	st, wp = rs.Pop(st)
+	goto L_984
+L_983:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_984:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00120b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1022
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1023
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t20, wp)
+L_993:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+6 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 6); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b0b)
+	rl += 2
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1017
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1018
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_1005:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_1005
+	}
+	st, wp = rs.Pop(st)
+	goto L_1018
+L_1017:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1018:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_993
+	}
+	st, wp = rs.Pop(st)
+	goto L_1023
+L_1022:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1023:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00130800000003)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 3
+	goto L_1030
+L_1029:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1030:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1029
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00140a00000002)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_1042
+L_1041:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_1042:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1041
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, 0x0f00150400000002)
+	rl += 8
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = 2
+	goto L_1054
+L_1053:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1054:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1053
+	}
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f001604)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1074
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_1074
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_1069
+L_1068:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1069:
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1068
+	}
+	st, wp = rs.Pop(st)
+L_1074:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f001708)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1089
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_1089
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_1084
+L_1083:
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+L_1084:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint32)(wp))
+	if tr > 2147483647 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t2, tr, 4))
+	}
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_1083
+	}
+	st, wp = rs.Pop(st)
+L_1089:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00180b)
+	rl += 4
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_1109
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_1110
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t26, wp)
+L_1098:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_1098
+	}
+	st, wp = rs.Pop(st)
+	goto L_1110
+L_1109:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_1110:
+	wp = unsafe.Pointer(uintptr(wp) - 392)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_1114:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_4(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var nb int
+	var rl int
+	if rp != nil {
+		goto L_84
+	}
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_8
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 8
+	st, wp = rs.Pop(st)
+L_8:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_16
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_16:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_21
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_21
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_21:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_35
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_35
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_35
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_29:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_29
+	}
+	st, wp = rs.Pop(st)
+L_35:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_82
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 12
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_48
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_48:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_55
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_55:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_73
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_73
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_63
+L_62:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_63:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_69
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+	goto L_70
+L_69:
+	rl += 1
+L_70:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_62
+	}
+	st, wp = rs.Pop(st)
+L_73:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_80
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t27, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_80:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_82:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_206
+L_84:
+	nb = 0
+	if *(*unsafe.Pointer)(wp) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 8)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 16)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 40)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 48)) != nil {
+		nb++
+	}
+	if nb != 1 {
+		return rs.Return(rp, rc, rl, rs.UnionError(_frugal_t27, nb))
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_100
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+L_100:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_111
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_111:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_118
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000308)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_118
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_118:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_140
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_139
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_140
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_128:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_128
+	}
+	st, wp = rs.Pop(st)
+	goto L_140
+L_139:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_140:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_203
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_160
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_160:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_169
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_169:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_190
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_190
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_179
+L_178:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_179:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_185
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+	goto L_187
+L_185:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_187:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_178
+	}
+	st, wp = rs.Pop(st)
+L_190:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_199
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t27, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_199:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_203:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_206:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_5(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var nb int
+	var rl int
+	if rp != nil {
+		goto L_88
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_87
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_11
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 8
+	st, wp = rs.Pop(st)
+L_11:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_19
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_19:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_24
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_24
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_24:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_38
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_38
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_38
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_32:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_32
+	}
+	st, wp = rs.Pop(st)
+L_38:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_85
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 12
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_51
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_51:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_58
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_58:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_76
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_76
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_66
+L_65:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_66:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_72
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+	goto L_73
+L_72:
+	rl += 1
+L_73:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_65
+	}
+	st, wp = rs.Pop(st)
+L_76:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_83
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t27, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_83:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_85:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_87:
+	goto L_214
+L_88:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_214
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	nb = 0
+	if *(*unsafe.Pointer)(wp) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 8)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 16)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 40)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 48)) != nil {
+		nb++
+	}
+	if nb != 1 {
+		return rs.Return(rp, rc, rl, rs.UnionError(_frugal_t27, nb))
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_107
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+L_107:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_118
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_118:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_125
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000308)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_125
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_125:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_147
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_146
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_147
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_135:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_135
+	}
+	st, wp = rs.Pop(st)
+	goto L_147
+L_146:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_147:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_210
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_167
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_167:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_176
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_176:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_197
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_197
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_186
+L_185:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_186:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_192
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+	goto L_194
+L_192:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_194:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_185
+	}
+	st, wp = rs.Pop(st)
+L_197:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_206
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t27, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_206:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_210:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_214:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_6(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var nb int
+	var rl int
+	if rp != nil {
+		goto L_84
+	}
+	rl += 12
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_9
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_9:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_16
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_16:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_34
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_34
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_24
+L_23:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_24:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_30
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+	goto L_31
+L_30:
+	rl += 1
+L_31:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_23
+	}
+	st, wp = rs.Pop(st)
+L_34:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_82
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_46
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 8
+	st, wp = rs.Pop(st)
+L_46:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_54
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_54:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_59
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_59
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_59:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_73
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_73
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_73
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_67:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_67
+	}
+	st, wp = rs.Pop(st)
+L_73:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_80
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_80:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_82:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	goto L_206
+L_84:
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_97
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_97:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_106
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_106:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_127
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_127
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_116
+L_115:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_116:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_122
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+	goto L_124
+L_122:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_124:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_115
+	}
+	st, wp = rs.Pop(st)
+L_127:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_203
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	nb = 0
+	if *(*unsafe.Pointer)(wp) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 8)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 16)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 40)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 48)) != nil {
+		nb++
+	}
+	if nb != 1 {
+		return rs.Return(rp, rc, rl, rs.UnionError(_frugal_t27, nb))
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_150
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+L_150:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_161
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_161:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_168
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000308)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_168
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_168:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_190
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_189
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_190
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_178:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_178
+	}
+	st, wp = rs.Pop(st)
+	goto L_190
+L_189:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_190:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_199
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_199:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_203:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_206:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_7(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var nb int
+	var rl int
+	if rp != nil {
+		goto L_88
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_87
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 12
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_12
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_12:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_19
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_19:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_37
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_37
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_27
+L_26:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_27:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_33
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+	goto L_34
+L_33:
+	rl += 1
+L_34:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_26
+	}
+	st, wp = rs.Pop(st)
+L_37:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_85
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_49
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 8
+	st, wp = rs.Pop(st)
+L_49:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_57
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_57:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_62
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_62
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_62:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_76
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_76
+	}
+	rl += aot.MapLen(wp) * 4
+	if aot.MapLen(wp) == 0 {
+		goto L_76
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_70:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_70
+	}
+	st, wp = rs.Pop(st)
+L_76:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_83
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if nb, err = rs.Size(_frugal_t29, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += nb
+	st, wp = rs.Pop(st)
+L_83:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_85:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_87:
+	goto L_214
+L_88:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_214
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_104
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_104:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_113
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_113:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00040c)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_134
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_134
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_123
+L_122:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_123:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_129
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+	goto L_131
+L_129:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_131:
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_122
+	}
+	st, wp = rs.Pop(st)
+L_134:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_210
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	nb = 0
+	if *(*unsafe.Pointer)(wp) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 8)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 16)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 40)) != nil {
+		nb++
+	}
+	if *(*unsafe.Pointer)(unsafe.Pointer(uintptr(wp) + 48)) != nil {
+		nb++
+	}
+	if nb != 1 {
+		return rs.Return(rp, rc, rl, rs.UnionError(_frugal_t27, nb))
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_157
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+L_157:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_168
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_168:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_175
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000308)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_175
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_175:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_197
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_196
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_197
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t28, wp)
+L_185:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_185
+	}
+	st, wp = rs.Pop(st)
+	goto L_197
+L_196:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_197:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_206
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rp, rl, rc, err = rs.Encode(_frugal_t29, rp, rl, rc, mem, wp, st); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_206:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_210:
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_214:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_8(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	if rp != nil {
+		goto L_50
+	}
+	rl += 4
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_16
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+L_16:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_31
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_31
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_24
+L_23:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_24:
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_23
+	}
+	st, wp = rs.Pop(st)
+L_31:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_48
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_48
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t32, wp)
+L_37:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_37
+	}
+	st, wp = rs.Pop(st)
+L_48:
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	goto L_116
+L_50:
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_71
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+L_71:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00030a)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_89
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_89
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_81
+L_80:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_81:
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_80
+	}
+	st, wp = rs.Pop(st)
+L_89:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_112
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_113
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t32, wp)
+L_98:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_98
+	}
+	st, wp = rs.Pop(st)
+	goto L_113
+L_112:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_113:
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_116:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_9(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	if rp != nil {
+		goto L_54
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_53
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_19
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+L_19:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_34
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_34
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_27
+L_26:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_27:
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_26
+	}
+	st, wp = rs.Pop(st)
+L_34:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_51
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_51
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t32, wp)
+L_40:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_40
+	}
+	st, wp = rs.Pop(st)
+L_51:
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	st, wp = rs.Pop(st)
+L_53:
+	goto L_124
+L_54:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_124
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_78
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	st, wp = rs.Pop(st)
+L_78:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f00030a)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_96
+	}
+	if *(*int)(unsafe.Pointer(uintptr(wp) + 8)) == 0 {
+		goto L_96
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.State(st).Ln = uintptr(*(*int)(unsafe.Pointer(uintptr(wp) + 8)))
+	wp = *(*unsafe.Pointer)(wp)
+	goto L_88
+L_87:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+L_88:
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.State(st).Ln--
+	if rs.State(st).Ln != 0 {
+		goto L_87
+	}
+	st, wp = rs.Pop(st)
+L_96:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d00040b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_119
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_120
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t32, wp)
+L_105:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if wp, err = rs.Marshal(_frugal_t31, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	st, wp = rs.Pop(st)
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_105
+	}
+	st, wp = rs.Pop(st)
+	goto L_120
+L_119:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_120:
+	wp = unsafe.Pointer(uintptr(wp) - 40)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_124:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_10(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_53
+	}
+	rl += 15
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_48
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_21
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_21:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_29
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_29:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_36
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_36:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_39
+	}
+	rl += 5
+L_39:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_43
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_43:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_49
+L_48:
+	rl += 4
+L_49:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 11
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	goto L_198
+L_53:
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_189
+	}
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_148
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_148:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_159
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_159:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_169
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_169:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_175
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_175:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_182
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_182:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_191
+L_189:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0c000300)
+	rl += 4
+L_191:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+12 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 12); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a01)
+	rl += 2
+	aot.Store8(rp, rl, 0x2c)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_198:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_11(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	var tr uint64
+	if rp != nil {
+		goto L_57
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_56
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 15
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_51
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 130
+	wp = unsafe.Pointer(uintptr(wp) + 56)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 40)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_24
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	st, wp = rs.Pop(st)
+L_24:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_32
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	st, wp = rs.Pop(st)
+L_32:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_39
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 2
+	st, wp = rs.Pop(st)
+L_39:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_42
+	}
+	rl += 5
+L_42:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_46
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_46:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+	goto L_52
+L_51:
+	rl += 4
+L_52:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	rl += 11
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	st, wp = rs.Pop(st)
+L_56:
+	goto L_206
+L_57:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_206
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_196
+	}
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+104 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 104); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0200)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 1)
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	aot.Store64(rp, rl, aot.Widen(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 4)
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x09)
+	rl += 1
+	tr = uint64(*(*uint8)(wp))
+	if tr > 127 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t0, tr, 1))
+	}
+	aot.Store8(rp, rl, uint8(tr))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	tr = uint64(*(*uint16)(wp))
+	aot.Store32(rp, rl, uint32(tr))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 2)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	tr = uint64(*(*uint32)(wp))
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	tr = uint64(*(*uint64)(wp))
+	if tr > 9223372036854775807 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t3, tr, 8))
+	}
+	aot.Store64(rp, rl, uint64(tr))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0d)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x0e)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+14 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 14); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x0f)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store32(rp, rl, 0x0b001000)
+	rl += 4
+	aot.Store16(rp, rl, 0x0000)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	if rp, rl, rc, err = rs.Memcpy(rp, rl, rc, wp, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_155
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x11)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	st, wp = rs.Pop(st)
+L_155:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_166
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x12)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	st, wp = rs.Pop(st)
+L_166:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_176
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x13)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+2 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 2); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	tr = uint64(*(*uint16)(wp))
+	if tr > 32767 {
+		return rs.Return(rp, rc, rl, rs.RangeError(_frugal_t1, tr, 2))
+	}
+	aot.Store16(rp, rl, uint16(tr))
+	rl += 2
+	st, wp = rs.Pop(st)
+L_176:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int16)(wp) == 7 {
+		goto L_182
+	}
+	if rl+5 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 5); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0600)
+	rl += 2
+	aot.Store8(rp, rl, 0x14)
+	rl += 1
+	aot.Store16(rp, rl, *(*uint16)(wp))
+	rl += 2
+L_182:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "seven" {
+		goto L_189
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x15)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_189:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_198
+L_196:
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0c000300)
+	rl += 4
+L_198:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if rl+12 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 12); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a01)
+	rl += 2
+	aot.Store8(rp, rl, 0x2c)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) - 32)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_206:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_12(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	if rp != nil {
+		goto L_109
+	}
+	rl += 1
+	if *(*int8)(wp) == 1 {
+		goto L_4
+	}
+	rl += 4
+L_4:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 2 {
+		goto L_7
+	}
+	rl += 11
+L_7:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 4613937818241073152 {
+		goto L_10
+	}
+	rl += 11
+L_10:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int32)(wp) == 4 {
+		goto L_13
+	}
+	rl += 7
+L_13:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "string field default text" {
+		goto L_17
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_17:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*string)(wp) == "binary field default data" {
+		goto L_21
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_21:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*int32)(wp) == 0 {
+		goto L_24
+	}
+	rl += 7
+L_24:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_36
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 55
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_36:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_41
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_41
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_41:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_46
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_46
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_46:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_51
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_51
+	}
+	rl += aot.MapLen(wp) * 12
+L_51:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_65
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_65
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_65
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t36, wp)
+L_59:
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_59
+	}
+	st, wp = rs.Pop(st)
+L_65:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_81
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_81
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_81
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_72:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_72
+	}
+	st, wp = rs.Pop(st)
+L_81:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_107
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_107
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_107
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t37, wp)
+L_88:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_103
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 55
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+	goto L_104
+L_103:
+	rl += 1
+L_104:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_88
+	}
+	st, wp = rs.Pop(st)
+L_107:
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	goto L_339
+L_109:
+	if *(*int8)(wp) == 1 {
+		goto L_114
+	}
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+L_114:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 2 {
+		goto L_120
+	}
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+L_120:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 4613937818241073152 {
+		goto L_126
+	}
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+L_126:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int32)(wp) == 4 {
+		goto L_132
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+L_132:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "string field default text" {
+		goto L_139
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_139:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*string)(wp) == "binary field default data" {
+		goto L_146
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_146:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*int32)(wp) == 0 {
+		goto L_152
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+L_152:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_194
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+40 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 40); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_194:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_201
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000908)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_201
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_201:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_209
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000a08)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_209
+	}
+	if err = rs.Unique(wp, _frugal_t5); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_209:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_230
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000b08)
+	rl += 4
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_229
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_230
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t35, wp)
+L_219:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_219
+	}
+	st, wp = rs.Pop(st)
+	goto L_230
+L_229:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_230:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_252
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000c0a)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_251
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_252
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t36, wp)
+L_240:
+	wp = rs.State(st).Mi.K
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_240
+	}
+	st, wp = rs.Pop(st)
+	goto L_252
+L_251:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_252:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_275
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000d0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_274
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_275
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_262:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_262
+	}
+	st, wp = rs.Pop(st)
+	goto L_275
+L_274:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_275:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_336
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000e0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_335
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_336
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t37, wp)
+L_285:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_329
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+40 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 40); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_331
+L_329:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_331:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_285
+	}
+	st, wp = rs.Pop(st)
+	goto L_336
+L_335:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_336:
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_339:
+	return rs.Return(rp, rc, rl, nil)
+}
+
+func _frugal_enc_13(rp unsafe.Pointer, rc int, mem iov.BufferWriter, wp unsafe.Pointer, rs *aot.EncodeState, st int) (int, error) {
+	var err error
+	var rl int
+	if rp != nil {
+		goto L_113
+	}
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_112
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 1
+	if *(*int8)(wp) == 1 {
+		goto L_7
+	}
+	rl += 4
+L_7:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 2 {
+		goto L_10
+	}
+	rl += 11
+L_10:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 4613937818241073152 {
+		goto L_13
+	}
+	rl += 11
+L_13:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int32)(wp) == 4 {
+		goto L_16
+	}
+	rl += 7
+L_16:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "string field default text" {
+		goto L_20
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_20:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*string)(wp) == "binary field default data" {
+		goto L_24
+	}
+	rl += 7
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+L_24:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*int32)(wp) == 0 {
+		goto L_27
+	}
+	rl += 7
+L_27:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_39
+	}
+	rl += 3
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 55
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+L_39:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_44
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_44
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_44:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_49
+	}
+	rl += 8
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_49
+	}
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 4
+L_49:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_54
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_54
+	}
+	rl += aot.MapLen(wp) * 12
+L_54:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_68
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_68
+	}
+	rl += aot.MapLen(wp) * 8
+	if aot.MapLen(wp) == 0 {
+		goto L_68
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t36, wp)
+L_62:
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_62
+	}
+	st, wp = rs.Pop(st)
+L_68:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_84
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_84
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_84
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_75:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_75
+	}
+	st, wp = rs.Pop(st)
+L_84:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_110
+	}
+	rl += 9
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_110
+	}
+	if aot.MapLen(wp) == 0 {
+		goto L_110
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t37, wp)
+L_91:
+	wp = rs.State(st).Mi.K
+	rl += 4
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_106
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	rl += 55
+	wp = unsafe.Pointer(uintptr(wp) + 32)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	rl += *(*int)(unsafe.Pointer(uintptr(wp) + 8)) * 1
+	wp = unsafe.Pointer(uintptr(wp) - 48)
+	st, wp = rs.Pop(st)
+	goto L_107
+L_106:
+	rl += 1
+L_107:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_91
+	}
+	st, wp = rs.Pop(st)
+L_110:
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	st, wp = rs.Pop(st)
+L_112:
+	goto L_347
+L_113:
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_347
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if *(*int8)(wp) == 1 {
+		goto L_121
+	}
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+L_121:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 2 {
+		goto L_127
+	}
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+L_127:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int64)(wp) == 4613937818241073152 {
+		goto L_133
+	}
+	if rl+11 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 11); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+L_133:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*int32)(wp) == 4 {
+		goto L_139
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+L_139:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*string)(wp) == "string field default text" {
+		goto L_146
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_146:
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if *(*string)(wp) == "binary field default data" {
+		goto L_153
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_153:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*int32)(wp) == 0 {
+		goto L_159
+	}
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+L_159:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_201
+	}
+	if rl+3 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 3); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0c00)
+	rl += 2
+	aot.Store8(rp, rl, 0x08)
+	rl += 1
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+40 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 40); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_201:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_208
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0f000908)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_208
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_208:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_216
+	}
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0e000a08)
+	rl += 4
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_216
+	}
+	if err = rs.Unique(wp, _frugal_t5); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 4); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+L_216:
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_237
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000b08)
+	rl += 4
+	aot.Store8(rp, rl, 0x0a)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_236
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_237
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t35, wp)
+L_226:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = rs.State(st).Mi.V
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_226
+	}
+	st, wp = rs.Pop(st)
+	goto L_237
+L_236:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_237:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_259
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000c0a)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_258
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_259
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t36, wp)
+L_247:
+	wp = rs.State(st).Mi.K
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_247
+	}
+	st, wp = rs.Pop(st)
+	goto L_259
+L_258:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_259:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_282
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000d0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0b)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_281
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_282
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t21, wp)
+L_269:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_269
+	}
+	st, wp = rs.Pop(st)
+	goto L_282
+L_281:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_282:
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_343
+	}
+	if rl+9 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 9); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, 0x0d000e0b)
+	rl += 4
+	aot.Store8(rp, rl, 0x0c)
+	rl += 1
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_342
+	}
+	aot.Store32(rp, rl, uint32(aot.MapLen(wp)))
+	rl += 4
+	if aot.MapLen(wp) == 0 {
+		goto L_343
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	rs.MapBegin(st, _frugal_t37, wp)
+L_292:
+	wp = rs.State(st).Mi.K
+	if rl+4 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 4); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = rs.State(st).Mi.V
+	if *(*unsafe.Pointer)(wp) == nil {
+		goto L_336
+	}
+	if st, err = rs.Push(st, wp); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = *(*unsafe.Pointer)(wp)
+	if rl+40 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 40); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0300)
+	rl += 2
+	aot.Store8(rp, rl, 0x01)
+	rl += 1
+	aot.Store8(rp, rl, *(*uint8)(wp))
+	rl += 1
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0a00)
+	rl += 2
+	aot.Store8(rp, rl, 0x02)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0400)
+	rl += 2
+	aot.Store8(rp, rl, 0x03)
+	rl += 1
+	aot.Store64(rp, rl, *(*uint64)(wp))
+	rl += 8
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x04)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) + 8)
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x05)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 16)
+	if rl+7 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 7); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0b00)
+	rl += 2
+	aot.Store8(rp, rl, 0x06)
+	rl += 1
+	aot.Store32(rp, rl, uint32(*(*int)(unsafe.Pointer(uintptr(wp) + 8))))
+	rl += 4
+	if rp, rl, rc, err = rs.MemcpyBE(rp, rl, rc, mem, wp, 8, 1); err != nil {
+		return rs.Return(rp, rc, rl, err)
+	}
+	wp = unsafe.Pointer(uintptr(wp) + 24)
+	if rl+8 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 8); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store16(rp, rl, 0x0800)
+	rl += 2
+	aot.Store8(rp, rl, 0x07)
+	rl += 1
+	aot.Store32(rp, rl, *(*uint32)(wp))
+	rl += 4
+	wp = unsafe.Pointer(uintptr(wp) - 72)
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+	goto L_338
+L_336:
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+L_338:
+	rs.MapNext(st)
+	if rs.State(st).Mi.K != nil {
+		goto L_292
+	}
+	st, wp = rs.Pop(st)
+	goto L_343
+L_342:
+	aot.Store32(rp, rl, 0x00000000)
+	rl += 4
+L_343:
+	wp = unsafe.Pointer(uintptr(wp) - 160)
+	if rl+1 > rc {
+		if rp, rl, rc, err = rs.Flush(rp, rl, 1); err != nil {
+			return rs.Return(rp, rc, rl, err)
+		}
+	}
+	aot.Store8(rp, rl, 0x00)
+	rl += 1
+	st, wp = rs.Pop(st)
+L_347:
+	return rs.Return(rp, rc, rl, nil)
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Package aotgen contains types with ahead-of-time generated codecs, regenerate frugal_gen.go with
+//
+//     go generate ./aotgen
+//
+package aotgen
+
+import (
+    `github.com/cloudwego/frugal`
+)
+
+//go:generate go run github.com/cloudwego/frugal/cmd/frugal gen . Scalars Containers Union Tree Custom Required github.com/cloudwego/frugal/testdata/kitex_gen/baseline.OptionalDefaultValues
+
+type Kind int64
+
+type Cents struct {
+    v int64
+}
+
+type Scalars struct {
+    B    bool       `frugal:"1,default,bool"`
+    I8   int8       `frugal:"2,default,byte"`
+    I16  int16      `frugal:"3,default,i16"`
+    I32  int32      `frugal:"4,default,i32"`
+    I64  int64      `frugal:"5,default,i64"`
+    I    int        `frugal:"6,default,i64"`
+    F64  float64    `frugal:"7,default,double"`
+    F32  float32    `frugal:"8,default,double"`
+    U8   uint8      `frugal:"9,default,byte"`
+    U16  uint16     `frugal:"10,default,i32"`
+    U32  uint32     `frugal:"11,default,i64"`
+    U64  uint64     `frugal:"12,default,i64"`
+    S    string     `frugal:"13,default,string"`
+    Bin  []byte     `frugal:"14,default,binary"`
+    E    Kind       `frugal:"15,default,Kind"`
+    ID   [4]byte    `frugal:"16,default,binary"`
+    PI   *int32     `frugal:"17,optional,i32"`
+    PS   *string    `frugal:"18,optional,string"`
+    PU   *uint16    `frugal:"19,optional,i16"`
+    OI   int16      `frugal:"20,optional,i16"`
+    OS   string     `frugal:"21,optional,string"`
+    Unknown []byte  `frugal:"_unknown"`
+}
+
+func (p *Scalars) InitDefault() {
+    *p = Scalars {
+        I32 : 32,
+        S   : "hello",
+        OI  : 7,
+        OS  : "seven",
+    }
+}
+
+type Containers struct {
+    L8   []int8               `frugal:"1,default,list<byte>"`
+    L16  []int16              `frugal:"2,default,list<i16>"`
+    L64  []int64              `frugal:"3,default,list<i64>"`
+    LS   []string             `frugal:"4,default,list<string>"`
+    LB   [][]byte             `frugal:"5,default,list<binary>"`
+    LP   []*Scalars           `frugal:"6,default,list<Scalars>"`
+    S8   []int8               `frugal:"7,default,set<byte>"`
+    S16  []int16              `frugal:"8,default,set<i16>"`
+    S32  []int32              `frugal:"9,default,set<i32>"`
+    SS   []string             `frugal:"10,default,set<string>"`
+    M8   map[int8]int8        `frugal:"11,default,map<byte:byte>"`
+    M16  map[int16]string     `frugal:"12,default,map<i16:string>"`
+    M32  map[int32][]int64    `frugal:"13,default,map<i32:list<i64>>"`
+    M64  map[int64]*Scalars   `frugal:"14,default,map<i64:Scalars>"`
+    MS   map[string]float64   `frugal:"15,default,map<string:double>"`
+    ME   map[Kind]bool        `frugal:"16,default,map<Kind:bool>"`
+    MP   map[*Scalars]int32   `frugal:"17,default,map<Scalars:i32>"`
+    MM   map[string]map[string]string `frugal:"18,default,map<string:map<string:string>>"`
+    AI   [3]int32             `frugal:"19,default,list<i32>"`
+    AU   [2]uint64            `frugal:"20,default,list<i64>"`
+    AF   [2]float32           `frugal:"21,default,list<double>"`
+    LF   []float32            `frugal:"22,default,list<double>"`
+    LU   []uint32             `frugal:"23,default,list<i32>"`
+    MU   map[string]uint16    `frugal:"24,default,map<string:i16>"`
+}
+
+type Union struct {
+    _ struct{}          `frugal:"_union"`
+    I *int64            `frugal:"1,optional,i64"`
+    S *string           `frugal:"2,optional,string"`
+    L []int32           `frugal:"3,optional,list<i32>"`
+    M map[string]int32  `frugal:"4,optional,map<string:i32>"`
+    T *Tree             `frugal:"5,optional,Tree"`
+}
+
+type Tree struct {
+    Value    int64    `frugal:"1,default,i64"`
+    Left     *Tree    `frugal:"2,optional,Tree"`
+    Right    *Tree    `frugal:"3,optional,Tree"`
+    Children []*Tree  `frugal:"4,default,list<Tree>"`
+    Union    *Union   `frugal:"5,optional,Union"`
+}
+
+type Custom struct {
+    C  Cents            `frugal:"1,default,i64"`
+    PC *Cents           `frugal:"2,optional,i64"`
+    LC []Cents          `frugal:"3,default,list<i64>"`
+    MC map[string]Cents `frugal:"4,default,map<string:i64>"`
+}
+
+type Required struct {
+    A int32     `frugal:"1,required,i32"`
+    B string    `frugal:"2,required,string"`
+    C *Scalars  `frugal:"3,required,Scalars"`
+    D int64     `frugal:"300,required,i64"`
+}
+
+func init() {
+    if err := frugal.RegisterTypeCodec(
+        func(v Cents) (int64, error) { return v.v, nil },
+        func(v int64) (Cents, error) { return Cents { v }, nil },
+    ); err != nil {
+        panic(err)
+    }
+}
+
+func MakeCents(v int64) Cents {
+    return Cents { v }
+}
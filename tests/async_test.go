@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package tests
+
+import (
+    `os`
+    `reflect`
+    `testing`
+    `time`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/debug`
+    `github.com/stretchr/testify/require`
+)
+
+type AsyncTestStruct struct {
+    A int64             `frugal:"1,default,i64"`
+    B string            `frugal:"2,default,string"`
+    C []int32           `frugal:"3,default,list<i32>"`
+    D map[string]string `frugal:"4,default,map<string:string>"`
+}
+
+func TestAsyncCompile(t *testing.T) {
+    if os.Getenv("FRUGAL_BACKEND") == "emu" {
+        t.Skip("everything runs in the emulator")
+    }
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(2))
+    frugal.Unload(reflect.TypeOf(AsyncTestStruct{}))
+    v := AsyncTestStruct { A: 1, B: "foo", C: []int32 { 2, 3 }, D: map[string]string { "bar": "baz" } }
+    run := func() {
+        var r AsyncTestStruct
+        buf := make([]byte, frugal.EncodedSize(v))
+        _, err := frugal.EncodeObject(buf, nil, v)
+        require.NoError(t, err)
+        _, err = frugal.DecodeObject(buf, &r)
+        require.NoError(t, err)
+        require.Equal(t, v, r)
+    }
+    s0 := debug.GetStats()
+    run()
+    s1 := debug.GetStats()
+    require.Greater(t, s1.Encoder.Emulated, s0.Encoder.Emulated)
+    require.Greater(t, s1.Decoder.Emulated, s0.Decoder.Emulated)
+    for end := time.Now().Add(10 * time.Second); ; {
+        s0 = debug.GetStats()
+        run()
+        s1 = debug.GetStats()
+        if s1.Encoder.Emulated == s0.Encoder.Emulated && s1.Decoder.Emulated == s0.Decoder.Emulated {
+            break
+        }
+        require.True(t, time.Now().Before(end), "native code is not ready after 10 seconds")
+        time.Sleep(time.Millisecond)
+    }
+}
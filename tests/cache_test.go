@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package tests
+
+import (
+    `fmt`
+    `os`
+    `os/exec`
+    `regexp`
+    `strconv`
+    `testing`
+
+    `github.com/apache/thrift/lib/go/thrift`
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/debug`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+var cachedStats = regexp.MustCompile(`cached: encoder=(\d+) decoder=(\d+)`)
+
+func TestMachineCodeCacheChild(t *testing.T) {
+    if os.Getenv("FRUGAL_CACHE_CHILD") != "yes" {
+        t.Skip("only runs as a child process of TestMachineCodeCache")
+    }
+    var v, v1, v2 baseline.Nesting2
+    loaddata(t, &v)
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    mm := thrift.NewTMemoryBuffer()
+    _, _ = mm.Write(buf)
+    require.NoError(t, v1.Read(thrift.NewTBinaryProtocolTransport(mm)))
+    _, err = frugal.DecodeObject(buf, &v2)
+    require.NoError(t, err)
+    require.Equal(t, dumpval(v1), dumpval(v2))
+    var d, d1 baseline.OptionalDefaultValues
+    d.InitDefault()
+    buf = make([]byte, frugal.EncodedSize(d))
+    _, err = frugal.EncodeObject(buf, nil, d)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, &d1)
+    require.NoError(t, err)
+    require.Equal(t, dumpval(d), dumpval(d1))
+    s := debug.GetStats()
+    fmt.Printf("cached: encoder=%d decoder=%d\n", s.Encoder.Cached, s.Decoder.Cached)
+}
+
+func TestMachineCodeCache(t *testing.T) {
+    if os.Getenv("FRUGAL_BACKEND") == "emu" {
+        t.Skip("the cache is not used by the emulator")
+    }
+    dir := t.TempDir()
+    for i := 0; i < 2; i++ {
+        cmd := exec.Command(os.Args[0], "-test.run=^TestMachineCodeCacheChild$", "-test.v")
+        cmd.Env = append(os.Environ(), "FRUGAL_CACHE_DIR=" + dir, "FRUGAL_CACHE_CHILD=yes")
+        out, err := cmd.CombinedOutput()
+        require.NoError(t, err, string(out))
+        m := cachedStats.FindStringSubmatch(string(out))
+        require.NotNil(t, m, string(out))
+        ne, _ := strconv.Atoi(m[1])
+        nd, _ := strconv.Atoi(m[2])
+        if i == 0 {
+            require.Zero(t, ne)
+            require.Zero(t, nd)
+        } else {
+            require.NotZero(t, ne)
+            require.NotZero(t, nd)
+        }
+    }
+}
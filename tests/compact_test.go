@@ -0,0 +1,116 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `testing`
+
+    `github.com/apache/thrift/lib/go/thrift`
+    `github.com/brianvoe/gofakeit`
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+func requireSameValue(t *testing.T, x interface{}, y interface{}) {
+    a := make([]byte, frugal.EncodedSize(x))
+    _, err := frugal.EncodeObject(a, nil, x)
+    require.NoError(t, err)
+    b := make([]byte, frugal.EncodedSize(y))
+    _, err = frugal.EncodeObject(b, nil, y)
+    require.NoError(t, err)
+    _, va := buildvalue(defs.T_struct, a, 0)
+    _, vb := buildvalue(defs.T_struct, b, 0)
+    require.Equal(t, va, vb)
+}
+
+func TestCompactMarshalCompare(t *testing.T) {
+    var v baseline.Nesting2
+    loaddata(t, &v)
+    mm := thrift.NewTMemoryBuffer()
+    err := v.Write(thrift.NewTCompactProtocol(mm))
+    require.NoError(t, err)
+    println("Expected Size :", mm.Len())
+    nb := frugal.CompactEncodedSize(v)
+    println("Measured Size :", nb)
+    require.Equal(t, mm.Len(), nb)
+    buf := make([]byte, nb)
+    ret, err := frugal.EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    println("Encoded Size  :", ret)
+    require.Equal(t, nb, ret)
+    var x, y baseline.Nesting2
+    err = x.Read(thrift.NewTCompactProtocol(mm))
+    require.NoError(t, err)
+    mm = thrift.NewTMemoryBuffer()
+    _, err = mm.Write(buf[:ret])
+    require.NoError(t, err)
+    err = y.Read(thrift.NewTCompactProtocol(mm))
+    require.NoError(t, err)
+    requireSameValue(t, &x, &y)
+}
+
+func TestCompactUnmarshalCompare(t *testing.T) {
+    var v, x, y baseline.Nesting2
+    loaddata(t, &v)
+    mm := thrift.NewTMemoryBuffer()
+    err := v.Write(thrift.NewTCompactProtocol(mm))
+    require.NoError(t, err)
+    buf := mm.Bytes()
+    ret, err := frugal.DecodeCompact(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    err = y.Read(thrift.NewTCompactProtocol(mm))
+    require.NoError(t, err)
+    requireSameValue(t, &y, &x)
+}
+
+func TestCompactTypeSerdes(t *testing.T) {
+    s := &MyTypeTest{}
+    gofakeit.Struct(s)
+    s.I161 = new(int16)
+    *s.I161 = -1234
+    got := make([]byte, frugal.CompactEncodedSize(s))
+    ret, err := frugal.EncodeCompact(got, nil, s)
+    require.NoError(t, err)
+    require.Equal(t, len(got), ret)
+    gotS := &MyTypeTest{}
+    ret, err = frugal.DecodeCompact(got, gotS)
+    require.NoError(t, err)
+    require.Equal(t, len(got), ret)
+    bin := make([]byte, frugal.EncodedSize(s))
+    _, err = frugal.EncodeObject(bin, nil, s)
+    require.NoError(t, err)
+    binS := &MyTypeTest{}
+    _, err = frugal.DecodeObject(bin, binS)
+    require.NoError(t, err)
+    requireSameValue(t, binS, gotS)
+}
+
+func TestCompactTruncated(t *testing.T) {
+    var v baseline.Nesting2
+    loaddata(t, &v)
+    buf := make([]byte, frugal.CompactEncodedSize(v))
+    ret, err := frugal.EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    for _, n := range []int { 0, 1, ret / 3, ret / 2, ret - 1 } {
+        var x baseline.Nesting2
+        _, err = frugal.DecodeCompact(buf[:n], &x)
+        require.Error(t, err)
+    }
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `math`
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+type ConvertTestItem struct {
+    A uint16   `frugal:"1,default,i32"`
+    B float32  `frugal:"2,default,double"`
+    C string   `frugal:"3,default"`
+}
+
+type ConvertTestStruct struct {
+    U8   uint8                  `frugal:"1,default,byte"`
+    U16  uint16                 `frugal:"2,default,i32"`
+    U32  uint32                 `frugal:"3,default,i64"`
+    U64  uint64                 `frugal:"4,default,i64"`
+    U    uint                   `frugal:"5,default,i64"`
+    F32  float32                `frugal:"6,default,double"`
+    PU   *uint32                `frugal:"7,optional,i32"`
+    PF   *float32               `frugal:"8,optional,double"`
+    ID   [16]byte               `frugal:"9,default,binary"`
+    AI   [3]int32               `frugal:"10,default,list<i32>"`
+    AU   [4]uint16              `frugal:"11,default,list<i16>"`
+    AS   [2]string              `frugal:"12,default,list<string>"`
+    AP   [2]*ConvertTestItem    `frugal:"13,default,list<ConvertTestItem>"`
+    AA   [2][2]float32          `frugal:"14,default,list<list<double>>"`
+    AE   [0]int64               `frugal:"15,default,list<i64>"`
+    AL   [20]uint8              `frugal:"16,default,list<byte>"`
+    LU   []uint64               `frugal:"17,default,list<i64>"`
+    MU   map[string]uint32      `frugal:"18,default,map<string:i64>"`
+    PA   *[2]int64              `frugal:"19,optional,list<i64>"`
+}
+
+type ConvertTestWire struct {
+    U8   int8                   `frugal:"1,default,byte"`
+    U16  int32                  `frugal:"2,default,i32"`
+    U32  int64                  `frugal:"3,default,i64"`
+    U64  int64                  `frugal:"4,default,i64"`
+    U    int64                  `frugal:"5,default,i64"`
+    F32  float64                `frugal:"6,default,double"`
+    PU   *int32                 `frugal:"7,optional,i32"`
+    PF   *float64               `frugal:"8,optional,double"`
+    ID   []byte                 `frugal:"9,default,binary"`
+    AI   []int32                `frugal:"10,default,list<i32>"`
+    AU   []int16                `frugal:"11,default,list<i16>"`
+    AS   []string               `frugal:"12,default,list<string>"`
+    AP   []*ConvertTestItem     `frugal:"13,default,list<ConvertTestItem>"`
+    AA   [][]float64            `frugal:"14,default,list<list<double>>"`
+    AE   []int64                `frugal:"15,default,list<i64>"`
+    AL   []int8                 `frugal:"16,default,list<byte>"`
+    LU   []int64                `frugal:"17,default,list<i64>"`
+    MU   map[string]int64       `frugal:"18,default,map<string:i64>"`
+    PA   []int64                `frugal:"19,optional,list<i64>"`
+}
+
+type ConvertTestNarrow struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+type ConvertTestUint8 struct {
+    A uint8 `frugal:"1,default,i64"`
+}
+
+type ConvertTestUint64 struct {
+    A uint64 `frugal:"1,default,i64"`
+}
+
+type ConvertTestFloat struct {
+    A float64 `frugal:"1,default,double"`
+}
+
+type ConvertTestFloat32 struct {
+    A float32 `frugal:"1,default,double"`
+}
+
+type ConvertTestList struct {
+    A []int64 `frugal:"1,default,list<i64>"`
+    B []byte  `frugal:"2,default,binary"`
+}
+
+type ConvertTestArray struct {
+    A [3]int64 `frugal:"1,default,list<i64>"`
+    B [4]byte  `frugal:"2,default,binary"`
+}
+
+func newConvertTestStruct() *ConvertTestStruct {
+    pu := uint32(math.MaxInt32)
+    pf := float32(-1.5)
+    return &ConvertTestStruct {
+        U8  : math.MaxInt8,
+        U16 : 65535,
+        U32 : math.MaxUint32,
+        U64 : math.MaxInt64,
+        U   : 123456789,
+        F32 : 3.25,
+        PU  : &pu,
+        PF  : &pf,
+        ID  : [16]byte { 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16 },
+        AI  : [3]int32 { -1, 0, 1 },
+        AU  : [4]uint16 { 0, 1, 2, 32767 },
+        AS  : [2]string { "hello", "world" },
+        AP  : [2]*ConvertTestItem { { A: 1, B: 0.5, C: "a" }, { A: 65535, B: -2, C: "b" } },
+        AA  : [2][2]float32 { { 1, 2 }, { 3, 4 } },
+        AL  : [20]uint8 { 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, math.MaxInt8 },
+        LU  : []uint64 { 0, 1, math.MaxInt64 },
+        MU  : map[string]uint32 { "a": 1, "b": math.MaxUint32 },
+        PA  : &[2]int64 { 7, 8 },
+    }
+}
+
+func TestConvertBinary(t *testing.T) {
+    var x ConvertTestStruct
+    var w ConvertTestWire
+    v := newConvertTestStruct()
+    buf := make([]byte, frugal.EncodedSize(v))
+    ret, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    _, err = frugal.DecodeObject(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, v, &x)
+    _, err = frugal.DecodeObject(buf, &w)
+    require.NoError(t, err)
+    require.Equal(t, int8(math.MaxInt8), w.U8)
+    require.Equal(t, int32(65535), w.U16)
+    require.Equal(t, int64(math.MaxUint32), w.U32)
+    require.Equal(t, 3.25, w.F32)
+    require.Equal(t, v.ID[:], w.ID)
+    require.Equal(t, []int16 { 0, 1, 2, 32767 }, w.AU)
+    require.Equal(t, [][]float64 { { 1, 2 }, { 3, 4 } }, w.AA)
+    require.Equal(t, []int64 {}, w.AE)
+    require.Equal(t, int8(math.MaxInt8), w.AL[19])
+    require.Equal(t, []int64 { 7, 8 }, w.PA)
+}
+
+func TestConvertCompact(t *testing.T) {
+    var x ConvertTestStruct
+    var w ConvertTestWire
+    v := newConvertTestStruct()
+    buf := make([]byte, frugal.CompactEncodedSize(v))
+    ret, err := frugal.EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    _, err = frugal.DecodeCompact(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, v, &x)
+    _, err = frugal.DecodeCompact(buf, &w)
+    require.NoError(t, err)
+    require.Equal(t, int64(math.MaxUint32), w.U32)
+    require.Equal(t, v.ID[:], w.ID)
+    require.Equal(t, []int8 { 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, math.MaxInt8 }, w.AL)
+}
+
+func TestConvertRangeError(t *testing.T) {
+    buf := make([]byte, 64)
+    _, err := frugal.EncodeObject(buf, nil, &ConvertTestUint64 { A: math.MaxInt64 + 1 })
+    require.Error(t, err)
+    require.Contains(t, err.Error(), "overflows i64")
+    _, err = frugal.EncodeObject(buf, nil, &struct { A uint8 `frugal:"1,default,byte"` } { A: 128 })
+    require.Error(t, err)
+    _, err = frugal.EncodeCompact(buf, nil, &ConvertTestUint64 { A: math.MaxUint64 })
+    require.Error(t, err)
+    for _, v := range []int64 { -1, 256, math.MinInt64 } {
+        src := &ConvertTestNarrow { A: v }
+        n, err := frugal.EncodeObject(buf, nil, src)
+        require.NoError(t, err)
+        _, err = frugal.DecodeObject(buf[:n], new(ConvertTestUint8))
+        require.Error(t, err)
+        require.Contains(t, err.Error(), "out of range")
+        n, err = frugal.EncodeCompact(buf, nil, src)
+        require.NoError(t, err)
+        _, err = frugal.DecodeCompact(buf[:n], new(ConvertTestUint8))
+        require.Error(t, err)
+    }
+}
+
+func TestConvertFloatOverflow(t *testing.T) {
+    var x ConvertTestFloat32
+    buf := make([]byte, 64)
+    for _, v := range []float64 { math.Inf(1), math.Inf(-1), math.NaN(), 1e-300 } {
+        n, err := frugal.EncodeObject(buf, nil, &ConvertTestFloat { A: v })
+        require.NoError(t, err)
+        _, err = frugal.DecodeObject(buf[:n], &x)
+        require.NoError(t, err)
+    }
+    n, err := frugal.EncodeObject(buf, nil, &ConvertTestFloat { A: math.MaxFloat64 })
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf[:n], &x)
+    require.Error(t, err)
+    n, err = frugal.EncodeCompact(buf, nil, &ConvertTestFloat { A: -math.MaxFloat64 })
+    require.NoError(t, err)
+    _, err = frugal.DecodeCompact(buf[:n], &x)
+    require.Error(t, err)
+}
+
+func TestConvertLengthMismatch(t *testing.T) {
+    var x ConvertTestArray
+    buf := make([]byte, 256)
+    for _, v := range []ConvertTestList {
+        { A: []int64 { 1, 2 }, B: []byte("abcd") },
+        { A: []int64 { 1, 2, 3, 4 }, B: []byte("abcd") },
+        { A: []int64 { 1, 2, 3 }, B: []byte("abc") },
+        { A: []int64 { 1, 2, 3 }, B: []byte("abcde") },
+    } {
+        n, err := frugal.EncodeObject(buf, nil, &v)
+        require.NoError(t, err)
+        _, err = frugal.DecodeObject(buf[:n], &x)
+        require.Error(t, err)
+        require.Contains(t, err.Error(), "length mismatch")
+        n, err = frugal.EncodeCompact(buf, nil, &v)
+        require.NoError(t, err)
+        _, err = frugal.DecodeCompact(buf[:n], &x)
+        require.Error(t, err)
+    }
+    n, err := frugal.EncodeObject(buf, nil, &ConvertTestList { A: []int64 { 1, 2, 3 }, B: []byte("abcd") })
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf[:n], &x)
+    require.NoError(t, err)
+    require.Equal(t, ConvertTestArray { A: [3]int64 { 1, 2, 3 }, B: [4]byte { 'a', 'b', 'c', 'd' } }, x)
+}
+
+func TestConvertRequiresTag(t *testing.T) {
+    for _, v := range []interface{} {
+        &struct { A uint32    `frugal:"1,default"` } {},
+        &struct { A float32   `frugal:"1,default"` } {},
+        &struct { A [4]byte   `frugal:"1,default"` } {},
+        &struct { A [4]int64  `frugal:"1,default"` } {},
+        &struct { A uint32    `frugal:"1,default,string"` } {},
+        &struct { A [4]int64  `frugal:"1,default,set<i64>"` } {},
+    } {
+        require.Error(t, frugal.Pretouch(reflect.TypeOf(v)))
+    }
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `errors`
+    `net`
+    `reflect`
+    `testing`
+    `time`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+type CustomDecimal struct {
+    unscaled int64
+    scale    int32
+}
+
+type CustomDecimalWire struct {
+    Unscaled int64 `frugal:"1,required"`
+    Scale    int32 `frugal:"2,required"`
+}
+
+type CustomStrict int32
+
+type CustomTestStruct struct {
+    T  time.Time            `frugal:"1,default,i64"`
+    PT *time.Time           `frugal:"2,optional,i64"`
+    IP net.IP               `frugal:"3,default,string"`
+    D  CustomDecimal        `frugal:"4,default,CustomDecimalWire"`
+    L  []time.Time          `frugal:"5,default,list<i64>"`
+    M  map[string]time.Time `frugal:"6,default,map<string:i64>"`
+    A  [2]CustomDecimal     `frugal:"7,default,list<CustomDecimalWire>"`
+}
+
+type CustomTestWire struct {
+    T  int64                `frugal:"1,default,i64"`
+    PT *int64               `frugal:"2,optional,i64"`
+    IP string               `frugal:"3,default,string"`
+    D  CustomDecimalWire    `frugal:"4,default,CustomDecimalWire"`
+    L  []int64              `frugal:"5,default,list<i64>"`
+    M  map[string]int64     `frugal:"6,default,map<string:i64>"`
+    A  []*CustomDecimalWire `frugal:"7,default,list<CustomDecimalWire>"`
+}
+
+type CustomStrictStruct struct {
+    A CustomStrict `frugal:"1,default,i64"`
+}
+
+type CustomStrictWire struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+var errCustomStrict = errors.New("negative value")
+
+func init() {
+    must := func(err error) {
+        if err != nil {
+            panic(err)
+        }
+    }
+    must(frugal.RegisterTypeCodec(
+        func(v time.Time) (int64, error) { return v.UnixNano(), nil },
+        func(v int64) (time.Time, error) { return time.Unix(0, v).UTC(), nil },
+    ))
+    must(frugal.RegisterTypeCodec(
+        func(v net.IP) (string, error) { return v.String(), nil },
+        func(v string) (net.IP, error) { return net.ParseIP(v), nil },
+    ))
+    must(frugal.RegisterTypeCodec(
+        func(v CustomDecimal) (CustomDecimalWire, error) { return CustomDecimalWire { v.unscaled, v.scale }, nil },
+        func(v CustomDecimalWire) (CustomDecimal, error) { return CustomDecimal { v.Unscaled, v.Scale }, nil },
+    ))
+    must(frugal.RegisterTypeCodec(
+        func(v CustomStrict) (int64, error) {
+            if v < 0 {
+                return 0, errCustomStrict
+            } else {
+                return int64(v), nil
+            }
+        },
+        func(v int64) (CustomStrict, error) {
+            if v < 0 {
+                return 0, errCustomStrict
+            } else {
+                return CustomStrict(v), nil
+            }
+        },
+    ))
+}
+
+func newCustomTestStruct() *CustomTestStruct {
+    pt := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+    return &CustomTestStruct {
+        T  : time.Date(2021, 12, 31, 23, 59, 59, 999, time.UTC),
+        PT : &pt,
+        IP : net.ParseIP("2001:db8::1"),
+        D  : CustomDecimal { 123456, 3 },
+        L  : []time.Time { time.Unix(0, 0).UTC(), time.Unix(1, 2).UTC() },
+        M  : map[string]time.Time { "epoch": time.Unix(0, 0).UTC() },
+        A  : [2]CustomDecimal { { 1, 2 }, { -3, 4 } },
+    }
+}
+
+func TestCustomCodec(t *testing.T) {
+    var x CustomTestStruct
+    var w CustomTestWire
+    v := newCustomTestStruct()
+    buf := make([]byte, frugal.EncodedSize(v))
+    ret, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    _, err = frugal.DecodeObject(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, v, &x)
+    _, err = frugal.DecodeObject(buf, &w)
+    require.NoError(t, err)
+    require.Equal(t, v.T.UnixNano(), w.T)
+    require.Equal(t, v.PT.UnixNano(), *w.PT)
+    require.Equal(t, "2001:db8::1", w.IP)
+    require.Equal(t, CustomDecimalWire { 123456, 3 }, w.D)
+    require.Equal(t, []int64 { 0, 1000000002 }, w.L)
+    require.Equal(t, []*CustomDecimalWire { { 1, 2 }, { -3, 4 } }, w.A)
+}
+
+func TestCustomCodecCompact(t *testing.T) {
+    var x CustomTestStruct
+    var w CustomTestWire
+    v := newCustomTestStruct()
+    buf := make([]byte, frugal.CompactEncodedSize(v))
+    ret, err := frugal.EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    _, err = frugal.DecodeCompact(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, v, &x)
+    _, err = frugal.DecodeCompact(buf, &w)
+    require.NoError(t, err)
+    require.Equal(t, v.T.UnixNano(), w.T)
+    require.Equal(t, "2001:db8::1", w.IP)
+}
+
+func TestCustomCodecNil(t *testing.T) {
+    var x CustomTestStruct
+    v := &CustomTestStruct { T: time.Unix(0, 0).UTC(), IP: net.IP{} }
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, &x)
+    require.NoError(t, err)
+    require.Nil(t, x.PT)
+    require.Equal(t, v.T, x.T)
+}
+
+func TestCustomCodecError(t *testing.T) {
+    buf := make([]byte, 64)
+    _, err := frugal.EncodeObject(buf, nil, &CustomStrictStruct { A: -1 })
+    require.ErrorIs(t, err, errCustomStrict)
+    _, err = frugal.EncodeCompact(buf, nil, &CustomStrictStruct { A: -1 })
+    require.ErrorIs(t, err, errCustomStrict)
+    n, err := frugal.EncodeObject(buf, nil, &CustomStrictWire { A: -1 })
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf[:n], new(CustomStrictStruct))
+    require.ErrorIs(t, err, errCustomStrict)
+    n, err = frugal.EncodeCompact(buf, nil, &CustomStrictWire { A: -1 })
+    require.NoError(t, err)
+    _, err = frugal.DecodeCompact(buf[:n], new(CustomStrictStruct))
+    require.ErrorIs(t, err, errCustomStrict)
+}
+
+func TestCustomCodecRegister(t *testing.T) {
+    type custom int
+    enc := func(v custom) (int64, error) { return int64(v), nil }
+    dec := func(v int64) (custom, error) { return custom(v), nil }
+    require.Error(t, frugal.RegisterTypeCodec(1, 2))
+    require.Error(t, frugal.RegisterTypeCodec(enc, func(v int32) (custom, error) { return 0, nil }))
+    require.Error(t, frugal.RegisterTypeCodec(func(v custom) int64 { return 0 }, dec))
+    require.Error(t, frugal.RegisterTypeCodec(
+        func(v *custom) (int64, error) { return 0, nil },
+        func(v int64) (*custom, error) { return nil, nil },
+    ))
+    require.Error(t, frugal.RegisterTypeCodec(
+        func(v time.Duration) (time.Time, error) { return time.Time{}, nil },
+        func(v time.Time) (time.Duration, error) { return 0, nil },
+    ))
+    require.NoError(t, frugal.RegisterTypeCodec(enc, dec))
+    require.Error(t, frugal.RegisterTypeCodec(enc, dec))
+    require.Error(t, frugal.Pretouch(reflect.TypeOf(&struct { A custom `frugal:"1,default,string"` } {})))
+}
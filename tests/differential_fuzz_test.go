@@ -0,0 +1,41 @@
+// +build go1.18
+
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `testing`
+
+    `github.com/cloudwego/frugal`
+)
+
+// FuzzDifferential builds a random type and a random value from the seed, and checks them with
+// both backends and the reference codec, data is then decoded by both backends as arbitrary input.
+//
+//     go test -run '^$' -fuzz FuzzDifferential
+func FuzzDifferential(f *testing.F) {
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(0))
+    for i := int64(0); i < 16; i++ {
+        f.Add(i, []byte(nil))
+    }
+    f.Add(int64(0), []byte { 0x08, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00 })
+    f.Add(int64(1), []byte { 0x0f, 0x00, 0x02, 0x0b, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00 })
+    f.Fuzz(func(t *testing.T, seed int64, data []byte) {
+        testDifferentialSeed(t, seed, data)
+    })
+}
@@ -0,0 +1,709 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `fmt`
+    `math`
+    `math/rand`
+    `reflect`
+    `sort`
+    `strings`
+    `testing`
+    `time`
+
+    `github.com/apache/thrift/lib/go/thrift`
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/internal/utils`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+/** Differential Testing
+ *
+ *  Every value is encoded and decoded with the native backend, the emulator and a reference codec
+ *  built on Apache Thrift, all of them must produce exactly the same bytes and the same objects.
+ *
+ *  Types generated by Apache Thrift act as their own reference, random types are built with
+ *  reflect.StructOf the same way as fuzz/builder does, and use a reflection-based codec which
+ *  follows the semantics of the generated code.
+ */
+
+const (
+    _DiffMaxDepth  = 3
+    _DiffMaxFields = 8
+    _DiffMaxElems  = 3
+    _DiffMaxRetry  = 8
+)
+
+type DiffEnum int64
+
+type diffType struct {
+    T   thrift.TType
+    Go  reflect.Type
+    Tag string
+    K   *diffType
+    V   *diffType
+    F   []diffField
+}
+
+type diffField struct {
+    ID   int16
+    Req  string
+    Type *diffType
+}
+
+var diffScalars = [...]diffType {
+    { T: thrift.BOOL   , Go: reflect.TypeOf(false)       , Tag: "bool"     },
+    { T: thrift.BYTE   , Go: reflect.TypeOf(int8(0))     , Tag: "byte"     },
+    { T: thrift.I16    , Go: reflect.TypeOf(int16(0))    , Tag: "i16"      },
+    { T: thrift.I32    , Go: reflect.TypeOf(int32(0))    , Tag: "i32"      },
+    { T: thrift.I64    , Go: reflect.TypeOf(int64(0))    , Tag: "i64"      },
+    { T: thrift.DOUBLE , Go: reflect.TypeOf(float64(0))  , Tag: "double"   },
+    { T: thrift.STRING , Go: reflect.TypeOf("")          , Tag: "string"   },
+    { T: thrift.I32    , Go: reflect.TypeOf(DiffEnum(0)) , Tag: "DiffEnum" },
+    { T: thrift.STRING , Go: reflect.TypeOf([]byte(nil)) , Tag: "binary"   },
+}
+
+var diffRequiredness = [...]string {
+    "default",
+    "required",
+    "optional",
+}
+
+/* binary fields are the only scalars without a pointer when optional */
+func (self *diffType) isScalar() bool {
+    switch self.Go.Kind() {
+        case reflect.Map   : return false
+        case reflect.Ptr   : return false
+        case reflect.Slice : return false
+        default            : return true
+    }
+}
+
+func (self *diffType) isBinary() bool {
+    return self.T == thrift.STRING && self.Go.Kind() == reflect.Slice
+}
+
+func (self diffField) isPointer() bool {
+    return self.Req == "optional" && self.Type.isScalar()
+}
+
+/** Random Types **/
+
+type diffBuilder struct {
+    r *rand.Rand
+}
+
+func (self diffBuilder) build(depth int) *diffType {
+    if depth >= _DiffMaxDepth {
+        return self.scalar(len(diffScalars))
+    }
+
+    /* scalars are as likely as all the compound types together */
+    switch self.r.Intn(8) {
+        case 0  : return self.buildStruct(depth + 1)
+        case 1  : return self.buildList("list", depth + 1)
+        case 2  : return self.buildList("set", depth + 1)
+        case 3  : return self.buildMap(depth + 1)
+        default : return self.scalar(len(diffScalars))
+    }
+}
+
+/* binary is the last one of the scalars, which is not a valid map key */
+func (self diffBuilder) scalar(n int) *diffType {
+    ret := diffScalars[self.r.Intn(n)]
+    return &ret
+}
+
+func (self diffBuilder) buildList(kind string, depth int) *diffType {
+    et := self.build(depth)
+    tt := thrift.TType(thrift.LIST)
+
+    /* sets are lists with a different type tag */
+    if kind == "set" {
+        tt = thrift.SET
+    }
+
+    /* construct the list type */
+    return &diffType {
+        T   : tt,
+        V   : et,
+        Go  : reflect.SliceOf(et.Go),
+        Tag : fmt.Sprintf("%s<%s>", kind, et.Tag),
+    }
+}
+
+func (self diffBuilder) buildMap(depth int) *diffType {
+    kt := self.scalar(len(diffScalars) - 1)
+    et := self.build(depth)
+
+    /* construct the map type */
+    return &diffType {
+        T   : thrift.MAP,
+        K   : kt,
+        V   : et,
+        Go  : reflect.MapOf(kt.Go, et.Go),
+        Tag : fmt.Sprintf("map<%s:%s>", kt.Tag, et.Tag),
+    }
+}
+
+func (self diffBuilder) buildStruct(depth int) *diffType {
+    nf := self.r.Intn(_DiffMaxFields) + 1
+    id := self.r.Perm(nf * 4)[:nf]
+    sf := make([]reflect.StructField, nf)
+    ret := &diffType { T: thrift.STRUCT, Tag: "ANONYMOUS", F: make([]diffField, nf) }
+
+    /* fields are declared in the order of their IDs, like most IDLs do */
+    sort.Ints(id)
+
+    /* generate every field with a distinct ID */
+    for i := range sf {
+        fv := diffField {
+            ID   : int16(id[i] + 1),
+            Req  : diffRequiredness[self.r.Intn(len(diffRequiredness))],
+            Type : self.build(depth),
+        }
+
+        /* optional scalars are pointers */
+        ft := fv.Type.Go
+        ret.F[i] = fv
+
+        /* wrap the type if needed */
+        if fv.isPointer() {
+            ft = reflect.PtrTo(ft)
+        }
+
+        /* build the field */
+        sf[i] = reflect.StructField {
+            Name : fmt.Sprintf("F%d", fv.ID),
+            Type : ft,
+            Tag  : reflect.StructTag(fmt.Sprintf(`frugal:"%d,%s,%s"`, fv.ID, fv.Req, fv.Type.Tag)),
+        }
+    }
+
+    /* structs are always referenced by pointers, just like the generated code */
+    ret.Go = reflect.PtrTo(reflect.StructOf(sf))
+    return ret
+}
+
+/** Random Values **/
+
+type diffGen struct {
+    r *rand.Rand
+}
+
+func (self diffGen) value(v reflect.Value) {
+    switch v.Kind() {
+        case reflect.Bool    : v.SetBool(self.r.Float64() >= 0.5)
+        case reflect.Int8    : fallthrough
+        case reflect.Int16   : fallthrough
+        case reflect.Int32   : v.SetInt(mkint(self.r.Uint64()))
+        case reflect.Int64   : self.genint64(v)
+        case reflect.Float64 : v.SetFloat(self.r.NormFloat64())
+        case reflect.Map     : self.genmap(v)
+        case reflect.Ptr     : self.genptr(v)
+        case reflect.Slice   : self.genslice(v)
+        case reflect.String  : v.SetString(self.genstring())
+        case reflect.Struct  : self.genstruct(v)
+        default              : panic("unsupported type for thrift: " + v.Type().String())
+    }
+}
+
+/* enums are encoded as i32 */
+func (self diffGen) genint64(v reflect.Value) {
+    if v.Type() == reflect.TypeOf(int64(0)) {
+        v.SetInt(mkint(self.r.Uint64()))
+    } else {
+        v.SetInt(int64(int32(self.r.Uint32())))
+    }
+}
+
+/* nil pointers are only valid for optional fields, which are handled by genstruct */
+func (self diffGen) genptr(v reflect.Value) {
+    v.Set(reflect.New(v.Type().Elem()))
+    self.value(v.Elem())
+}
+
+/* maps have at most one element, because the iteration order is random */
+func (self diffGen) genmap(v reflect.Value) {
+    t := v.Type()
+    if self.r.Float64() < 0.5 {
+        v.Set(reflect.Zero(t))
+        return
+    }
+    k := reflect.New(t.Key()).Elem()
+    e := reflect.New(t.Elem()).Elem()
+    self.value(k)
+    self.value(e)
+    v.Set(reflect.MakeMap(t))
+    v.SetMapIndex(k, e)
+}
+
+func (self diffGen) genslice(v reflect.Value) {
+    t := v.Type()
+    if self.r.Float64() < 0.5 {
+        v.Set(reflect.Zero(t))
+        return
+    }
+    if t.Elem().Kind() == reflect.Uint8 {
+        b := make([]byte, self.r.Intn(16))
+        _, _ = self.r.Read(b)
+        v.SetBytes(b)
+        return
+    }
+    n := self.r.Intn(_DiffMaxElems)
+    v.Set(reflect.MakeSlice(t, n, n))
+    for i := 0; i < n; i++ {
+        if !self.genunique(v, i) {
+            v.SetLen(i)
+            return
+        }
+    }
+}
+
+/* elements must be unique in case of sets, give up if the element type has too few values */
+func (self diffGen) genunique(v reflect.Value, i int) bool {
+    for n := 0; n < _DiffMaxRetry; n++ {
+        dup := false
+        self.value(v.Index(i))
+        for j := 0; j < i && !dup; j++ {
+            dup = reflect.DeepEqual(v.Index(i).Interface(), v.Index(j).Interface())
+        }
+        if !dup {
+            return true
+        }
+    }
+    return false
+}
+
+func (self diffGen) genstruct(v reflect.Value) {
+    t := v.Type()
+    for i := 0; i < v.NumField(); i++ {
+        if !isOptionalField(t.Field(i)) || self.r.Float64() >= 0.5 {
+            self.value(v.Field(i))
+        }
+
+        /* nil optional binaries are written as empty values by the encoder, unlike the generated code */
+        if fv := v.Field(i); isOptionalField(t.Field(i)) && fv.Type() == reflect.TypeOf([]byte(nil)) && fv.IsNil() {
+            fv.SetBytes([]byte {})
+        }
+    }
+}
+
+func (self diffGen) genstring() string {
+    n := self.r.Intn(16)
+    c := make([]rune, n)
+    for i := range c {
+        f := math.Abs(self.r.NormFloat64() * 64 + 32)
+        if f > 0x10ffff {
+            f = 0x10ffff
+        }
+        c[i] = rune(f)
+    }
+    return string(c)
+}
+
+func isOptionalField(sf reflect.StructField) bool {
+    tv := strings.Split(sf.Tag.Get("frugal"), ",")
+    return len(tv) >= 2 && strings.TrimSpace(tv[1]) == "optional"
+}
+
+/** Reference Codecs **/
+
+type diffReference interface {
+    Write(p thrift.TProtocol, v interface{}) error
+    Read(p thrift.TProtocol, v interface{}) error
+}
+
+type generatedReference struct{}
+
+func (generatedReference) Write(p thrift.TProtocol, v interface{}) error {
+    return v.(thrift.TStruct).Write(p)
+}
+
+func (generatedReference) Read(p thrift.TProtocol, v interface{}) error {
+    return v.(thrift.TStruct).Read(p)
+}
+
+func (self *diffType) Write(p thrift.TProtocol, v interface{}) error {
+    return self.write(p, reflect.ValueOf(v))
+}
+
+func (self *diffType) Read(p thrift.TProtocol, v interface{}) error {
+    return self.readStruct(p, reflect.ValueOf(v).Elem())
+}
+
+func (self *diffType) write(p thrift.TProtocol, v reflect.Value) error {
+    switch self.T {
+        case thrift.BOOL   : return p.WriteBool(v.Bool())
+        case thrift.BYTE   : return p.WriteByte(int8(v.Int()))
+        case thrift.I16    : return p.WriteI16(int16(v.Int()))
+        case thrift.I32    : return p.WriteI32(int32(v.Int()))
+        case thrift.I64    : return p.WriteI64(v.Int())
+        case thrift.DOUBLE : return p.WriteDouble(v.Float())
+        case thrift.STRING : return self.writeString(p, v)
+        case thrift.LIST   : return self.writeList(p, v, p.WriteListBegin, p.WriteListEnd)
+        case thrift.SET    : return self.writeList(p, v, p.WriteSetBegin, p.WriteSetEnd)
+        case thrift.MAP    : return self.writeMap(p, v)
+        case thrift.STRUCT : return self.writeStruct(p, v.Elem())
+        default            : panic("unreachable")
+    }
+}
+
+func (self *diffType) writeString(p thrift.TProtocol, v reflect.Value) error {
+    if self.isBinary() {
+        return p.WriteBinary(v.Bytes())
+    } else {
+        return p.WriteString(v.String())
+    }
+}
+
+func (self *diffType) writeList(p thrift.TProtocol, v reflect.Value, begin func(thrift.TType, int) error, end func() error) error {
+    if err := begin(self.V.T, v.Len()); err != nil {
+        return err
+    }
+    for i := 0; i < v.Len(); i++ {
+        if err := self.V.write(p, v.Index(i)); err != nil {
+            return err
+        }
+    }
+    return end()
+}
+
+func (self *diffType) writeMap(p thrift.TProtocol, v reflect.Value) error {
+    if err := p.WriteMapBegin(self.K.T, self.V.T, v.Len()); err != nil {
+        return err
+    }
+    for it := v.MapRange(); it.Next(); {
+        if err := self.K.write(p, it.Key()); err != nil {
+            return err
+        }
+        if err := self.V.write(p, it.Value()); err != nil {
+            return err
+        }
+    }
+    return p.WriteMapEnd()
+}
+
+/* optional fields are omitted when nil, everything else is always written */
+func (self *diffType) writeStruct(p thrift.TProtocol, v reflect.Value) error {
+    if err := p.WriteStructBegin(self.Tag); err != nil {
+        return err
+    }
+    for i, fv := range self.F {
+        vv := v.Field(i)
+        if fv.Req == "optional" && !fv.Type.isScalar() && vv.IsNil() {
+            continue
+        }
+        if fv.isPointer() {
+            if vv.IsNil() {
+                continue
+            } else {
+                vv = vv.Elem()
+            }
+        }
+        if err := p.WriteFieldBegin(v.Type().Field(i).Name, fv.Type.T, fv.ID); err != nil {
+            return err
+        }
+        if err := fv.Type.write(p, vv); err != nil {
+            return err
+        }
+        if err := p.WriteFieldEnd(); err != nil {
+            return err
+        }
+    }
+    if err := p.WriteFieldStop(); err != nil {
+        return err
+    }
+    return p.WriteStructEnd()
+}
+
+func (self *diffType) read(p thrift.TProtocol, v reflect.Value) error {
+    switch self.T {
+        case thrift.BOOL   : x, err := p.ReadBool()   ; v.SetBool(x)         ; return err
+        case thrift.BYTE   : x, err := p.ReadByte()   ; v.SetInt(int64(x))   ; return err
+        case thrift.I16    : x, err := p.ReadI16()    ; v.SetInt(int64(x))   ; return err
+        case thrift.I32    : x, err := p.ReadI32()    ; v.SetInt(int64(x))   ; return err
+        case thrift.I64    : x, err := p.ReadI64()    ; v.SetInt(x)          ; return err
+        case thrift.DOUBLE : x, err := p.ReadDouble() ; v.SetFloat(x)        ; return err
+        case thrift.STRING : return self.readString(p, v)
+        case thrift.LIST   : return self.readList(p, v, p.ReadListBegin, p.ReadListEnd)
+        case thrift.SET    : return self.readList(p, v, p.ReadSetBegin, p.ReadSetEnd)
+        case thrift.MAP    : return self.readMap(p, v)
+        case thrift.STRUCT : return self.readPointer(p, v)
+        default            : panic("unreachable")
+    }
+}
+
+func (self *diffType) readString(p thrift.TProtocol, v reflect.Value) error {
+    if !self.isBinary() {
+        x, err := p.ReadString()
+        v.SetString(x)
+        return err
+    } else {
+        x, err := p.ReadBinary()
+        v.SetBytes(x)
+        return err
+    }
+}
+
+func (self *diffType) readList(p thrift.TProtocol, v reflect.Value, begin func() (thrift.TType, int, error), end func() error) error {
+    _, n, err := begin()
+    if err != nil {
+        return err
+    }
+    vv := reflect.MakeSlice(self.Go, 0, n)
+    for i := 0; i < n; i++ {
+        ev := reflect.New(self.V.Go).Elem()
+        if err = self.V.read(p, ev); err != nil {
+            return err
+        }
+        vv = reflect.Append(vv, ev)
+    }
+    v.Set(vv)
+    return end()
+}
+
+func (self *diffType) readMap(p thrift.TProtocol, v reflect.Value) error {
+    _, _, n, err := p.ReadMapBegin()
+    if err != nil {
+        return err
+    }
+    vv := reflect.MakeMapWithSize(self.Go, n)
+    for i := 0; i < n; i++ {
+        kv := reflect.New(self.K.Go).Elem()
+        ev := reflect.New(self.V.Go).Elem()
+        if err = self.K.read(p, kv); err != nil {
+            return err
+        }
+        if err = self.V.read(p, ev); err != nil {
+            return err
+        }
+        vv.SetMapIndex(kv, ev)
+    }
+    v.Set(vv)
+    return p.ReadMapEnd()
+}
+
+func (self *diffType) readPointer(p thrift.TProtocol, v reflect.Value) error {
+    vv := reflect.New(self.Go.Elem())
+    v.Set(vv)
+    return self.readStruct(p, vv.Elem())
+}
+
+/* unknown fields and fields with mismatched types are skipped */
+func (self *diffType) readStruct(p thrift.TProtocol, v reflect.Value) error {
+    if _, err := p.ReadStructBegin(); err != nil {
+        return err
+    }
+    set := make([]bool, len(self.F))
+    for {
+        _, tt, id, err := p.ReadFieldBegin()
+        if err != nil {
+            return err
+        }
+        if tt == thrift.STOP {
+            break
+        }
+        if err = self.readField(p, v, tt, id, set); err != nil {
+            return err
+        }
+        if err = p.ReadFieldEnd(); err != nil {
+            return err
+        }
+    }
+    for i, fv := range self.F {
+        if fv.Req == "required" && !set[i] {
+            return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %d is not set", fv.ID))
+        }
+    }
+    return p.ReadStructEnd()
+}
+
+func (self *diffType) readField(p thrift.TProtocol, v reflect.Value, tt thrift.TType, id int16, set []bool) error {
+    for i, fv := range self.F {
+        if fv.ID != id || fv.Type.T != tt {
+            continue
+        }
+        set[i] = true
+        vv := v.Field(i)
+        if fv.isPointer() {
+            vv.Set(reflect.New(fv.Type.Go))
+            vv = vv.Elem()
+        }
+        return fv.Type.read(p, vv)
+    }
+    return thrift.SkipDefaultDepth(p, tt)
+}
+
+/** Backends **/
+
+func referenceEncode(ref diffReference, v interface{}) ([]byte, error) {
+    mm := thrift.NewTMemoryBuffer()
+    err := ref.Write(thrift.NewTBinaryProtocolTransport(mm), v)
+    return mm.Bytes(), err
+}
+
+func referenceDecode(ref diffReference, vt reflect.Type, buf []byte) (interface{}, error) {
+    mm := thrift.NewTMemoryBuffer()
+    vv := reflect.New(vt.Elem()).Interface()
+    _, _ = mm.Write(buf)
+    return vv, ref.Read(thrift.NewTBinaryProtocolTransport(mm), vv)
+}
+
+func backendEncode(v interface{}) ([]byte, error) {
+    buf := make([]byte, frugal.EncodedSize(v))
+    nb, err := frugal.EncodeObject(buf, nil, v)
+    return buf[:nb], err
+}
+
+func backendDecode(vt reflect.Type, buf []byte) (interface{}, int, error) {
+    vv := reflect.New(vt.Elem()).Interface()
+    nb, err := frugal.DecodeObject(buf, vv)
+    return vv, nb, err
+}
+
+func backendName(emu bool) string {
+    if emu {
+        return "emulator"
+    } else {
+        return "native"
+    }
+}
+
+/* every struct type reachable from vt, which might be compiled separately */
+func structTypes(vt reflect.Type, ret map[reflect.Type]struct{}) map[reflect.Type]struct{} {
+    switch vt.Kind() {
+        case reflect.Map    : return structTypes(vt.Elem(), structTypes(vt.Key(), ret))
+        case reflect.Ptr    : return structTypes(vt.Elem(), ret)
+        case reflect.Slice  : return structTypes(vt.Elem(), ret)
+        case reflect.Struct : break
+        default             : return ret
+    }
+    if _, ok := ret[vt]; ok {
+        return ret
+    }
+    ret[vt] = struct{}{}
+    for i := 0; i < vt.NumField(); i++ {
+        structTypes(vt.Field(i).Type, ret)
+    }
+    return ret
+}
+
+/* compiled types are unloaded before and after, so they are compiled again with the selected backend */
+func withBackend(vt reflect.Type, emu bool, fn func()) {
+    st := structTypes(vt, make(map[reflect.Type]struct{}))
+    old := utils.ForceEmulator
+    unload := func() {
+        for t := range st {
+            frugal.Unload(t)
+        }
+    }
+    defer func() {
+        unload()
+        utils.ForceEmulator = old
+    }()
+    unload()
+    utils.ForceEmulator = emu
+    fn()
+}
+
+/** Test Drivers **/
+
+func testDifferential(t *testing.T, v interface{}, ref diffReference) {
+    vt := reflect.TypeOf(v)
+    exp, err := referenceEncode(ref, v)
+    require.NoError(t, err, "reference encoder failed")
+    obj, err := referenceDecode(ref, vt, exp)
+    require.NoError(t, err, "reference decoder failed")
+
+    /* both backends must agree with the reference */
+    for _, emu := range []bool { false, true } {
+        withBackend(vt, emu, func() {
+            name := backendName(emu)
+            buf, err := backendEncode(v)
+            require.NoError(t, err, "%s: encoding failed", name)
+            require.Equal(t, exp, buf, "%s: encoded bytes differ from the reference", name)
+            ret, nb, err := backendDecode(vt, exp)
+            require.NoError(t, err, "%s: decoding failed", name)
+            require.Equal(t, len(exp), nb, "%s: decoded size mismatch", name)
+            require.Equal(t, dumpval(obj), dumpval(ret), "%s: decoded object differs from the reference", name)
+            buf, err = backendEncode(ret)
+            require.NoError(t, err, "%s: encoding the decoded object failed", name)
+            require.Equal(t, exp, buf, "%s: encoding the decoded object produces different bytes", name)
+        })
+    }
+}
+
+/* arbitrary input has no reference, but both backends must still agree with each other */
+func testDifferentialDecode(t *testing.T, vt reflect.Type, buf []byte) {
+    var nb [2]int
+    var val [2]interface{}
+    var err [2]error
+
+    /* decode with both backends */
+    for i, emu := range []bool { false, true } {
+        withBackend(vt, emu, func() {
+            val[i], nb[i], err[i] = backendDecode(vt, buf)
+        })
+    }
+
+    /* compare the results */
+    require.Equal(t, err[0] == nil, err[1] == nil, "native: %v, emulator: %v", err[0], err[1])
+    if err[0] == nil {
+        require.Equal(t, nb[0], nb[1], "decoded size mismatch")
+        require.Equal(t, dumpval(val[0]), dumpval(val[1]), "decoded objects differ")
+    }
+}
+
+func testDifferentialSeed(t *testing.T, seed int64, buf []byte) {
+    r := rand.New(rand.NewSource(seed))
+    vt := diffBuilder{r}.buildStruct(0)
+    vv := reflect.New(vt.Go.Elem())
+    diffGen{r}.value(vv.Elem())
+    testDifferential(t, vv.Interface(), vt)
+    if buf != nil {
+        testDifferentialDecode(t, vt.Go, buf)
+    }
+}
+
+func TestDifferential_Generated(t *testing.T) {
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(0))
+    seed := time.Now().UnixNano()
+    for _, v := range []interface{} { new(baseline.Simple), new(baseline.Nesting), new(baseline.Nesting2) } {
+        vt := reflect.TypeOf(v)
+        t.Run(fmt.Sprintf("%s/seed=%d", vt.Elem().Name(), seed), func(t *testing.T) {
+            r := rand.New(rand.NewSource(seed))
+            for i := 0; i < 16; i++ {
+                vv := reflect.New(vt.Elem())
+                diffGen{r}.value(vv.Elem())
+                testDifferential(t, vv.Interface(), generatedReference{})
+            }
+        })
+    }
+}
+
+func TestDifferential_Random(t *testing.T) {
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(0))
+    seed := time.Now().UnixNano()
+    for i := int64(0); i < 64; i++ {
+        t.Run(fmt.Sprintf("seed=%d", seed + i), func(t *testing.T) {
+            testDifferentialSeed(t, seed + i, nil)
+        })
+    }
+}
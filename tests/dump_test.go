@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package tests
+
+import (
+    `os`
+    `path/filepath`
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+type DumpTestStruct struct {
+    A int64             `frugal:"1,default,i64"`
+    B []string          `frugal:"2,default,list<string>"`
+    C map[string]string `frugal:"3,optional,map<string:string>"`
+}
+
+type DumpTestFiltered struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+func TestDump(t *testing.T) {
+    dir := t.TempDir()
+    defer frugal.SetDumpDir(frugal.SetDumpDir(dir))
+    defer frugal.SetDumpFilter(frugal.SetDumpFilter(`^tests\.DumpTestStruct$`))
+    defer frugal.SetDumpGraphviz(frugal.SetDumpGraphviz(true))
+    defer frugal.Unload(reflect.TypeOf(DumpTestStruct{}))
+    defer frugal.Unload(reflect.TypeOf(DumpTestFiltered{}))
+    v := DumpTestStruct { A: 1, B: []string { "foo" } }
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, new(DumpTestStruct))
+    require.NoError(t, err)
+    _, err = frugal.EncodeObject(make([]byte, frugal.EncodedSize(DumpTestFiltered{})), nil, DumpTestFiltered{})
+    require.NoError(t, err)
+    for _, kind := range []string { "encoder", "decoder" } {
+        fn, err := filepath.Glob(filepath.Join(dir, kind + "_tests.DumpTestStruct_*"))
+        require.NoError(t, err)
+        require.Len(t, fn, 1)
+        require.FileExists(t, filepath.Join(fn[0], "program.txt"))
+        require.FileExists(t, filepath.Join(fn[0], "hir.txt"))
+        if os.Getenv("FRUGAL_BACKEND") == "emu" {
+            require.NoFileExists(t, filepath.Join(fn[0], "asm.txt"))
+        } else {
+            require.FileExists(t, filepath.Join(fn[0], "asm.txt"))
+            require.FileExists(t, filepath.Join(fn[0], "ssa", "00_ssa_construction.txt"))
+            require.FileExists(t, filepath.Join(fn[0], "ssa", "00_ssa_construction.dot"))
+        }
+    }
+    if os.Getenv("FRUGAL_BACKEND") != "emu" {
+        st, ok := findTypeStats(reflect.TypeOf(DumpTestStruct{}))
+        require.True(t, ok)
+        require.Greater(t, int64(st.Encoder.SSA), int64(0))
+        require.Greater(t, int64(st.Decoder.SSA), int64(0))
+    }
+    fn, err := filepath.Glob(filepath.Join(dir, "*DumpTestFiltered*"))
+    require.NoError(t, err)
+    require.Empty(t, fn)
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `errors`
+    `fmt`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/iov`
+    `github.com/stretchr/testify/require`
+)
+
+type ErrorTestItem struct {
+    Name string `frugal:"1,default,string"`
+    ID   int64  `frugal:"2,default,i64"`
+}
+
+type ErrorTestReq struct {
+    Items []*ErrorTestItem          `frugal:"1,default,list<ErrorTestItem>"`
+    Attrs map[string]*ErrorTestItem `frugal:"2,default,map<string:ErrorTestItem>"`
+}
+
+type ErrorTestRequired struct {
+    A int32 `frugal:"1,required,i32"`
+}
+
+type ErrorTestOuter struct {
+    R *ErrorTestRequired `frugal:"1,default,ErrorTestRequired"`
+}
+
+func newErrorTestReq() *ErrorTestReq {
+    ret := new(ErrorTestReq)
+    for i := 0; i < 5; i++ {
+        ret.Items = append(ret.Items, &ErrorTestItem { Name: fmt.Sprintf("item-%d", i), ID: int64(i) })
+    }
+    return ret
+}
+
+func requireDecodeError(t *testing.T, err error) *frugal.DecodeError {
+    var de *frugal.DecodeError
+    require.True(t, errors.As(err, &de), "not a decode error: %v", err)
+    return de
+}
+
+func TestDecodeErrorEOF(t *testing.T) {
+    v := newErrorTestReq()
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf[:92], new(ErrorTestReq))
+    de := requireDecodeError(t, err)
+    require.Equal(t, 90, de.Offset)
+    require.Equal(t, "ErrorTestReq.Items[3].Name", de.FieldPath)
+    require.Zero(t, de.Expected)
+    require.Zero(t, de.Actual)
+    require.Error(t, de.Cause)
+    require.Contains(t, err.Error(), "field ErrorTestReq.Items[3].Name")
+}
+
+func TestDecodeErrorType(t *testing.T) {
+    v := newErrorTestReq()
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    buf[3] = 11
+    _, err = frugal.DecodeObject(buf, new(ErrorTestReq))
+    de := requireDecodeError(t, err)
+    require.Equal(t, 3, de.Offset)
+    require.Equal(t, "ErrorTestReq.Items", de.FieldPath)
+    require.Equal(t, "struct", de.Expected.String())
+    require.Equal(t, "string", de.Actual.String())
+}
+
+func TestDecodeErrorMap(t *testing.T) {
+    v := &ErrorTestReq { Attrs: map[string]*ErrorTestItem { "foo": { Name: "bar" } } }
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf[:len(buf) - 15], new(ErrorTestReq))
+    require.Equal(t, "ErrorTestReq.Attrs[0].value.Name", requireDecodeError(t, err).FieldPath)
+}
+
+func TestDecodeErrorMissing(t *testing.T) {
+    buf := []byte { 0x0c, 0, 1, 0, 0 }
+    _, err := frugal.DecodeObject(buf, new(ErrorTestOuter))
+    de := requireDecodeError(t, err)
+    require.Equal(t, 4, de.Offset)
+    require.Equal(t, "ErrorTestOuter.R", de.FieldPath)
+}
+
+func TestDecodeErrorLimit(t *testing.T) {
+    var le *frugal.LimitError
+    buf := []byte { 0x0f, 0, 1, 0x0a, 0x7f, 0xff, 0xff, 0xff }
+    _, err := frugal.DecodeObjectWithOptions(buf, new(LimitTestStruct), frugal.WithMaxContainerSize(10))
+    require.True(t, errors.As(err, &le))
+    require.Equal(t, "LimitTestStruct.L", requireDecodeError(t, err).FieldPath)
+}
+
+func TestDecodeErrorContainerAlloc(t *testing.T) {
+    var le *frugal.LimitError
+    lbuf := []byte { 0x0f, 0, 1, 0x0a, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0 }
+    mbuf := []byte { 0x0d, 0, 1, 0x02, 0x03, 0, 0, 0, 2, 1, 1, 0, 0, 0 }
+
+    /* allocation failures happen before the first element is decoded */
+    _, err := frugal.DecodeObjectWithOptions(lbuf, new(LimitTestStruct), frugal.WithMaxAllocSize(8))
+    de := requireDecodeError(t, err)
+    require.True(t, errors.As(err, &le))
+    require.Equal(t, frugal.LimitAllocSize, le.Kind)
+    require.Equal(t, 8, de.Offset)
+    require.Equal(t, "LimitTestStruct.L", de.FieldPath)
+    _, err = frugal.DecodeObjectWithOptions(mbuf, new(LimitMapTestStruct), frugal.WithMaxAllocSize(1))
+    require.True(t, errors.As(err, &le))
+    require.Equal(t, "LimitMapTestStruct.M", requireDecodeError(t, err).FieldPath)
+
+    /* so do containers that cannot fit in the remaining input */
+    _, err = frugal.DecodeObject(lbuf[:16], new(LimitTestStruct))
+    require.Equal(t, "LimitTestStruct.L", requireDecodeError(t, err).FieldPath)
+    _, err = frugal.DecodeObject(mbuf[:11], new(LimitMapTestStruct))
+    require.Equal(t, "LimitMapTestStruct.M", requireDecodeError(t, err).FieldPath)
+    _, err = frugal.DecodeCompact([]byte { 0x19, 0x36, 0x02, 0x04 }, new(LimitTestStruct))
+    require.Equal(t, "LimitTestStruct.L", requireDecodeError(t, err).FieldPath)
+}
+
+func TestDecodeErrorCompact(t *testing.T) {
+    v := newErrorTestReq()
+    buf := make([]byte, frugal.CompactEncodedSize(v))
+    _, err := frugal.EncodeCompact(buf, nil, v)
+    require.NoError(t, err)
+    for n := 1; n < len(buf); n++ {
+        _, err = frugal.DecodeCompact(buf[:n], new(ErrorTestReq))
+        require.NotEmpty(t, requireDecodeError(t, err).FieldPath)
+    }
+    _, err = frugal.DecodeCompact(buf[:len(buf) - 5], new(ErrorTestReq))
+    require.Equal(t, "ErrorTestReq.Items[4].ID", requireDecodeError(t, err).FieldPath)
+}
+
+func TestDecodeErrorReader(t *testing.T) {
+    v := newErrorTestReq()
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeReader(iov.NewBufferReader(splitbuf(buf[:92], 7)...), new(ErrorTestReq))
+    de := requireDecodeError(t, err)
+    require.Equal(t, 90, de.Offset)
+    require.Empty(t, de.FieldPath)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `bytes`
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/generic`
+    `github.com/cloudwego/frugal/internal/binary/defs`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+func encodeGeneric(t *testing.T, v generic.Struct) []byte {
+    buf := make([]byte, frugal.GenericEncodedSize(v))
+    nb, err := frugal.EncodeGeneric(buf, v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), nb)
+    return buf
+}
+
+func TestGeneric_RoundTrip(t *testing.T) {
+    buf := loaddata(t, nil)
+    val, nb, err := frugal.DecodeGeneric(buf)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), nb)
+    _, x := buildvalue(defs.T_struct, buf, 0)
+    _, y := buildvalue(defs.T_struct, encodeGeneric(t, val), 0)
+    require.True(t, reflect.DeepEqual(x, y))
+}
+
+func TestGeneric_Values(t *testing.T) {
+    v := &baseline.Nesting {
+        String_         : "hello",
+        ListSimple      : []*baseline.Simple {{ ByteField: 1, I64Field: 2 }},
+        MapStringString : map[string]string { "a": "b" },
+        ListI32         : []int32 { 1, 2, 3 },
+        I64             : -1,
+        Binary          : []byte { 0xff },
+    }
+    val, _, err := frugal.DecodeGeneric(encodeObject(t, v))
+    require.NoError(t, err)
+    require.Equal(t, generic.Binary("hello"), val.Fields[1])
+    require.Equal(t, generic.I64(-1), val.Fields[6])
+    require.Equal(t, generic.Binary { 0xff }, val.Fields[11])
+    require.Equal(t, generic.List { Elem: generic.TypeI32, Values: []generic.Value { generic.I32(1), generic.I32(2), generic.I32(3) } }, val.Fields[5])
+    require.Equal(t, generic.Map { Key: generic.TypeBinary, Elem: generic.TypeBinary, Pairs: []generic.Pair {{ Key: generic.Binary("a"), Value: generic.Binary("b") }} }, val.Fields[7])
+    require.Equal(t, generic.I64(2), val.Fields[2].(generic.List).Values[0].(generic.Struct).Fields[2])
+
+    /* rewrite some of the fields, and decode the result with the Go type */
+    r := &baseline.Nesting{}
+    val.Fields[6] = generic.I64(42)
+    val.Fields[10] = generic.List { Elem: generic.TypeBinary, Values: []generic.Value { generic.Binary("x") } }
+    delete(val.Fields, 11)
+    _, err = frugal.DecodeObject(encodeGeneric(t, val), r)
+    require.NoError(t, err)
+    require.Equal(t, int64(42), r.I64)
+    require.Equal(t, []string { "x" }, r.ListString)
+    require.Nil(t, r.Binary)
+    require.Equal(t, v.ListI32, r.ListI32)
+}
+
+func TestGeneric_DecodeErrors(t *testing.T) {
+    buf := loaddata(t, nil)
+    for _, n := range []int { 0, 1, 10, len(buf) / 2, len(buf) - 1 } {
+        _, _, err := frugal.DecodeGeneric(buf[:n])
+        require.EqualError(t, err, "frugal: error when skipping fields: -2 (unexpected EOF)")
+    }
+
+    /* invalid field type */
+    _, _, err := frugal.DecodeGeneric([]byte { 0x42, 0, 1, 0 })
+    require.EqualError(t, err, "frugal: error when skipping fields: -1 (invalid tag)")
+
+    /* nesting too deep */
+    deep := bytes.Repeat([]byte { byte(defs.T_struct), 0, 1 }, defs.StackSize)
+    _, _, err = frugal.DecodeGeneric(append(deep, make([]byte, defs.StackSize + 1)...))
+    require.EqualError(t, err, "frugal: error when skipping fields: -3 (value nesting too deep)")
+}
+
+func TestGeneric_EncodeErrors(t *testing.T) {
+    val := generic.Struct { Fields: map[int16]generic.Value {
+        1: generic.List { Elem: generic.TypeI32, Values: []generic.Value { generic.I64(1) } },
+    }}
+    _, err := frugal.EncodeGeneric(make([]byte, 64), val)
+    require.EqualError(t, err, "frugal: value of type i64 where i32 is expected")
+
+    /* nil values */
+    val.Fields[1] = nil
+    _, err = frugal.EncodeGeneric(make([]byte, 64), val)
+    require.EqualError(t, err, "frugal: nil value of field 1")
+
+    /* buffer too small */
+    val.Fields[1] = generic.Binary("hello")
+    _, err = frugal.EncodeGeneric(make([]byte, 4), val)
+    require.EqualError(t, err, "frugal: buffer is too small")
+    require.Equal(t, []byte { 11, 0, 1, 0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o', 0 }, encodeGeneric(t, val))
+}
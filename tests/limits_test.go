@@ -0,0 +1,197 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `bytes`
+    `errors`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/iov`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+type LimitTestStruct struct {
+    L []int64 `frugal:"1,default,list<i64>"`
+    S string  `frugal:"2,default,string"`
+    B []byte  `frugal:"3,default,binary"`
+    N string  `frugal:"4,default,string,nocopy"`
+}
+
+type LimitMapTestStruct struct {
+    M map[bool]int8 `frugal:"1,default,map<bool:i8>"`
+}
+
+type LargeMapValue struct {
+    A string `frugal:"1,default,string"`
+    B string `frugal:"2,default,string"`
+    C string `frugal:"3,default,string"`
+    D string `frugal:"4,default,string"`
+    E string `frugal:"5,default,string"`
+    F string `frugal:"6,default,string"`
+    G string `frugal:"7,default,string"`
+    H string `frugal:"8,default,string"`
+    I string `frugal:"9,default,string"`
+}
+
+type LargeMapTest struct {
+    M map[int64]LargeMapValue `frugal:"1,default,map<i64:LargeMapValue>"`
+}
+
+func requireLimitError(t *testing.T, err error, kind frugal.LimitKind, value int) {
+    var le *frugal.LimitError
+    require.True(t, errors.As(err, &le), "not a limit error: %v", err)
+    require.Equal(t, kind, le.Kind)
+    require.Equal(t, value, le.Value)
+}
+
+func TestLimitContainerSize(t *testing.T) {
+    var v LimitTestStruct
+    buf := []byte { 0x0f, 0, 1, 0x0a, 0x7f, 0xff, 0xff, 0xff }
+    _, err := frugal.DecodeObjectWithOptions(buf, &v, frugal.WithMaxContainerSize(1000))
+    requireLimitError(t, err, frugal.LimitContainerSize, 0x7fffffff)
+    require.Nil(t, v.L)
+    buf = []byte { 0x0f, 0, 1, 0x0a, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 7, 0 }
+    _, err = frugal.DecodeObjectWithOptions(buf, &v, frugal.WithMaxContainerSize(1))
+    require.NoError(t, err)
+    require.Equal(t, []int64 { 7 }, v.L)
+}
+
+func TestLimitStringSize(t *testing.T) {
+    for _, id := range []byte { 2, 3, 4 } {
+        buf := []byte { 0x0b, 0, id, 0x7f, 0xff, 0xff, 0xff }
+        _, err := frugal.DecodeObjectWithOptions(buf, new(LimitTestStruct), frugal.WithMaxStringSize(16))
+        requireLimitError(t, err, frugal.LimitStringSize, 0x7fffffff)
+    }
+}
+
+func TestLimitNestingDepth(t *testing.T) {
+    var v baseline.Nesting2
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObjectWithOptions(buf, &v, frugal.WithMaxNestingDepth(2))
+    requireLimitError(t, err, frugal.LimitNestingDepth, 3)
+    _, err = frugal.DecodeObjectWithOptions(buf, &v, frugal.WithMaxNestingDepth(100))
+    require.NoError(t, err)
+}
+
+func TestLimitAllocSize(t *testing.T) {
+    var le *frugal.LimitError
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObjectWithOptions(buf, new(baseline.Nesting2), frugal.WithMaxAllocSize(len(buf) / 2))
+    require.True(t, errors.As(err, &le))
+    require.Equal(t, frugal.LimitAllocSize, le.Kind)
+    require.Equal(t, len(buf) / 2, le.Limit)
+    require.Greater(t, le.Value, le.Limit)
+    _, err = frugal.DecodeObjectWithOptions(buf, new(baseline.Nesting2), frugal.WithMaxAllocSize(len(buf) * 100))
+    require.NoError(t, err)
+}
+
+func TestLimitGlobal(t *testing.T) {
+    old := frugal.SetMaxStringSize(16)
+    defer frugal.SetMaxStringSize(old)
+    _, err := frugal.DecodeObject([]byte { 0x0b, 0, 2, 0, 0, 0, 17 }, new(LimitTestStruct))
+    requireLimitError(t, err, frugal.LimitStringSize, 17)
+    _, err = frugal.DecodeObjectWithOptions([]byte { 0x0b, 0, 2, 0, 0, 0, 1, 'x', 0 }, new(LimitTestStruct))
+    require.NoError(t, err)
+}
+
+func TestLimitCompact(t *testing.T) {
+    old := frugal.SetMaxContainerSize(1000)
+    defer frugal.SetMaxContainerSize(old)
+    _, err := frugal.DecodeCompact([]byte { 0x19, 0xf6, 0xff, 0xff, 0xff, 0xff, 0x07 }, new(LimitTestStruct))
+    requireLimitError(t, err, frugal.LimitContainerSize, 0x7fffffff)
+}
+
+func TestLimitPerCall(t *testing.T) {
+    buf := []byte { 0x0b, 0, 2, 0, 0, 0, 3, 'x', 'y', 'z', 0 }
+    cbuf := []byte { 0x28, 3, 'x', 'y', 'z', 0 }
+    opt := frugal.WithMaxStringSize(2)
+
+    /* every entry point takes the same options */
+    _, err := frugal.DecodeCompactWithOptions(cbuf, new(LimitTestStruct), opt)
+    requireLimitError(t, err, frugal.LimitStringSize, 3)
+    _, err = frugal.DecodeReaderWithOptions(iov.NewBufferReader(buf), new(LimitTestStruct), opt)
+    requireLimitError(t, err, frugal.LimitStringSize, 3)
+    _, err = frugal.DecodeCompactReaderWithOptions(iov.NewBufferReader(cbuf), new(LimitTestStruct), opt)
+    requireLimitError(t, err, frugal.LimitStringSize, 3)
+    msg := make([]byte, frugal.MessageEncodedSize("m", &LimitTestStruct { S: "xyz" }))
+    _, err = frugal.EncodeMessage(msg, nil, "m", frugal.MessageCall, 1, &LimitTestStruct { S: "xyz" })
+    require.NoError(t, err)
+    _, _, _, _, err = frugal.DecodeMessageWithOptions(msg, new(LimitTestStruct), opt)
+    requireLimitError(t, err, frugal.LimitStringSize, 3)
+
+    /* and the defaults are used without options */
+    _, err = frugal.DecodeCompactWithOptions(cbuf, new(LimitTestStruct))
+    require.NoError(t, err)
+    _, err = frugal.DecodeReaderWithOptions(iov.NewBufferReader(buf), new(LimitTestStruct))
+    require.NoError(t, err)
+    _, err = frugal.DecodeCompactReaderWithOptions(iov.NewBufferReader(cbuf), new(LimitTestStruct))
+    require.NoError(t, err)
+    _, _, _, _, err = frugal.DecodeMessageWithOptions(msg, new(LimitTestStruct))
+    require.NoError(t, err)
+}
+
+func TestLimitStream(t *testing.T) {
+    dec := frugal.NewDecoder(bytes.NewReader([]byte { 0x0b, 0, 3, 0x7f, 0xff, 0xff, 0xff }), frugal.WithMaxStringSize(1 << 20))
+    requireLimitError(t, dec.Decode(new(LimitTestStruct)), frugal.LimitStringSize, 0x7fffffff)
+}
+
+func TestLimitRemainingInput(t *testing.T) {
+    buf := []byte { 0x0f, 0, 1, 0x0a, 0x7f, 0xff, 0xff, 0xff }
+    decode := map[string]func(v interface{}) error {
+        "contiguous": func(v interface{}) error { _, err := frugal.DecodeObject(buf, v); return err },
+        "reader": func(v interface{}) error { _, err := frugal.DecodeReader(iov.NewBufferReader(buf[:5], buf[5:]), v); return err },
+        "stream": func(v interface{}) error { return frugal.NewDecoder(bytes.NewReader(buf)).Decode(v) },
+    }
+
+    /* containers that cannot fit in the remaining input are rejected before allocating, even without limits */
+    for name, fn := range decode {
+        var v LimitTestStruct
+        require.ErrorContains(t, fn(&v), "unexpected EOF", name)
+        require.Nil(t, v.L, name)
+    }
+
+    /* every key-value pair takes at least 1 + 1 bytes */
+    var m LimitMapTestStruct
+    _, err := frugal.DecodeObject([]byte { 0x0d, 0, 1, 0x02, 0x03, 0, 0, 0, 3, 1, 1, 0, 0, 0 }, &m)
+    require.ErrorContains(t, err, "unexpected EOF")
+    require.Nil(t, m.M)
+
+    /* the same applies to the Compact Protocol */
+    var v LimitTestStruct
+    _, err = frugal.DecodeCompact([]byte { 0x19, 0xf6, 0xff, 0xff, 0xff, 0xff, 0x07 }, &v)
+    require.ErrorContains(t, err, "unexpected EOF")
+    _, err = frugal.DecodeCompact([]byte { 0x19, 0x36, 0x02, 0x04 }, &v)
+    require.ErrorContains(t, err, "unexpected EOF")
+    require.Nil(t, v.L)
+}
+
+func TestDecodeLargeMapValue(t *testing.T) {
+    var x LargeMapTest
+    v := LargeMapTest { M: map[int64]LargeMapValue {
+        1 << 40 : { A: "foo", I: "bar" },
+        -1      : { B: "baz" },
+    }}
+    buf := make([]byte, frugal.EncodedSize(&v))
+    _, err := frugal.EncodeObject(buf, nil, &v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, &x)
+    require.NoError(t, err)
+    require.Equal(t, v, x)
+}
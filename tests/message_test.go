@@ -0,0 +1,128 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `errors`
+    `testing`
+
+    `github.com/apache/thrift/lib/go/thrift`
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+func TestMessageEncode(t *testing.T) {
+    var v, x, y baseline.Nesting2
+    loaddata(t, &v)
+    buf := make([]byte, frugal.MessageEncodedSize("TestMethod", &v))
+    ret, err := frugal.EncodeMessage(buf, nil, "TestMethod", frugal.MessageReply, 12345, &v)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    mm := thrift.NewTMemoryBuffer()
+    _, err = mm.Write(buf)
+    require.NoError(t, err)
+    pp := thrift.NewTBinaryProtocolTransport(mm)
+    name, mt, seq, err := pp.ReadMessageBegin()
+    require.NoError(t, err)
+    require.Equal(t, "TestMethod", name)
+    require.Equal(t, thrift.REPLY, mt)
+    require.Equal(t, int32(12345), seq)
+    require.NoError(t, x.Read(pp))
+    require.NoError(t, pp.ReadMessageEnd())
+    mm = thrift.NewTMemoryBuffer()
+    require.NoError(t, v.Write(thrift.NewTBinaryProtocolTransport(mm)))
+    require.NoError(t, y.Read(thrift.NewTBinaryProtocolTransport(mm)))
+    requireSameValue(t, &y, &x)
+}
+
+func TestMessageDecode(t *testing.T) {
+    for _, strict := range []bool { true, false } {
+        var v, x, y baseline.Nesting2
+        loaddata(t, &v)
+        mm := thrift.NewTMemoryBuffer()
+        pp := thrift.NewTBinaryProtocol(mm, strict, strict)
+        require.NoError(t, pp.WriteMessageBegin("TestMethod", thrift.CALL, -1))
+        require.NoError(t, v.Write(pp))
+        require.NoError(t, pp.WriteMessageEnd())
+        buf := append([]byte(nil), mm.Bytes()...)
+        _, _, _, err := pp.ReadMessageBegin()
+        require.NoError(t, err)
+        require.NoError(t, y.Read(pp))
+        name, mt, seq, ret, err := frugal.DecodeMessage(buf, &x)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+        require.Equal(t, "TestMethod", name)
+        require.Equal(t, frugal.MessageCall, mt)
+        require.Equal(t, int32(-1), seq)
+        requireSameValue(t, &y, &x)
+    }
+}
+
+func TestMessageException(t *testing.T) {
+    var x baseline.Nesting2
+    var e *frugal.ApplicationException
+    mm := thrift.NewTMemoryBuffer()
+    pp := thrift.NewTBinaryProtocolTransport(mm)
+    require.NoError(t, pp.WriteMessageBegin("TestMethod", thrift.EXCEPTION, 1))
+    require.NoError(t, thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "unknown method").Write(pp))
+    require.NoError(t, pp.WriteMessageEnd())
+    buf := mm.Bytes()
+    _, mt, _, ret, err := frugal.DecodeMessage(buf, &x)
+    require.Equal(t, len(buf), ret)
+    require.Equal(t, frugal.MessageException, mt)
+    require.True(t, errors.As(err, &e))
+    require.Equal(t, frugal.UnknownMethod, e.TypeId())
+    require.Equal(t, "unknown method", e.Error())
+    ae := frugal.NewApplicationException(frugal.InternalError, "internal error")
+    buf = make([]byte, frugal.MessageEncodedSize("TestMethod", ae))
+    _, err = frugal.EncodeMessage(buf, nil, "TestMethod", frugal.MessageException, 2, ae)
+    require.NoError(t, err)
+    mm = thrift.NewTMemoryBuffer()
+    _, err = mm.Write(buf)
+    require.NoError(t, err)
+    pp = thrift.NewTBinaryProtocolTransport(mm)
+    _, mt2, seq, err := pp.ReadMessageBegin()
+    require.NoError(t, err)
+    require.Equal(t, thrift.EXCEPTION, mt2)
+    require.Equal(t, int32(2), seq)
+    te := thrift.NewTApplicationException(0, "")
+    require.NoError(t, te.Read(pp))
+    require.Equal(t, int32(thrift.INTERNAL_ERROR), te.TypeId())
+    require.Equal(t, "internal error", te.Error())
+}
+
+func TestMessageEncodeError(t *testing.T) {
+    v := &UnionTestStruct{}
+    buf := make([]byte, frugal.MessageEncodedSize("TestMethod", v))
+    ret, err := frugal.EncodeMessage(buf, nil, "TestMethod", frugal.MessageCall, 1, v)
+    require.Error(t, err)
+    require.Zero(t, ret)
+}
+
+func TestMessageHeaderErrors(t *testing.T) {
+    buf := make([]byte, frugal.MessageEncodedSize("TestMethod", &baseline.Simple{}))
+    _, err := frugal.EncodeMessage(buf, nil, "TestMethod", frugal.MessageCall, 1, &baseline.Simple{})
+    require.NoError(t, err)
+    for i := 0; i < 22; i++ {
+        _, _, _, _, err = frugal.DecodeMessageHeader(buf[:i])
+        require.Error(t, err)
+    }
+    buf[1] = 0x02
+    _, _, _, _, err = frugal.DecodeMessageHeader(buf)
+    require.Error(t, err)
+}
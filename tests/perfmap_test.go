@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `fmt`
+    `io/ioutil`
+    `os`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+type PerfMapTestStruct struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+func TestPerfMap(t *testing.T) {
+    if os.Getenv("FRUGAL_BACKEND") == "emu" {
+        t.Skip("nothing is compiled by the emulator")
+    }
+    defer frugal.SetPerfMap(frugal.SetPerfMap(true))
+    v := PerfMapTestStruct { A: 1 }
+    _, err := frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+    require.NoError(t, err)
+    fn := fmt.Sprintf("/tmp/perf-%d.map", os.Getpid())
+    defer os.Remove(fn)
+    buf, err := ioutil.ReadFile(fn)
+    require.NoError(t, err)
+    require.Contains(t, string(buf), " (frugal).encoder_tests.PerfMapTestStruct_")
+}
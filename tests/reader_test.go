@@ -0,0 +1,122 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/iov`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+func splitbuf(buf []byte, n int) [][]byte {
+    var ret [][]byte
+    for len(buf) > n {
+        ret = append(ret, buf[:n:n])
+        buf = buf[n:]
+    }
+    return append(ret, buf)
+}
+
+func TestDecodeReader(t *testing.T) {
+    var v, x baseline.Nesting2
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &v)
+    require.NoError(t, err)
+    for _, n := range []int { 1, 2, 3, 7, 64, 1000, len(buf) } {
+        x = baseline.Nesting2{}
+        rd := iov.NewBufferReader(append(splitbuf(buf, n), []byte("trailing"))...)
+        ret, err := frugal.DecodeReader(rd, &x)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+        require.Equal(t, len("trailing"), rd.Len())
+        requireSameValue(t, &v, &x)
+    }
+}
+
+func TestDecodeReaderSkip(t *testing.T) {
+    var v, x baseline.Simple
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &v)
+    require.NoError(t, err)
+    for _, n := range []int { 1, 5, 100, len(buf) } {
+        x = baseline.Simple{}
+        rd := iov.NewBufferReader(splitbuf(buf, n)...)
+        ret, err := frugal.DecodeReader(rd, &x)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+        require.Equal(t, 0, rd.Len())
+        require.Equal(t, v, x)
+    }
+}
+
+func TestDecodeReaderEOF(t *testing.T) {
+    buf := loaddata(t, nil)
+    for _, n := range []int { 0, 1, len(buf) / 2, len(buf) - 1 } {
+        var x baseline.Nesting2
+        _, err := frugal.DecodeReader(iov.NewBufferReader(splitbuf(buf[:n], 7)...), &x)
+        require.Error(t, err)
+    }
+}
+
+type NoCopyReaderTest struct {
+    A string `frugal:"1,default,string,nocopy"`
+    B []byte `frugal:"2,default,binary,nocopy"`
+    C string `frugal:"3,default,string,nocopy"`
+}
+
+func TestDecodeReaderNoCopy(t *testing.T) {
+    var v NoCopyReaderTest
+    seg0 := []byte { 0x0b, 0, 1, 0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o' }
+    seg1 := []byte { 0x0b, 0, 2, 0, 0, 0, 5, 'w', 'o' }
+    seg2 := []byte { 'r', 'l', 'd', 0x0b, 0, 3, 0 }
+    seg3 := []byte { 0, 0, 3, 'f', 'o', 'o', 0x00 }
+    ret, err := frugal.DecodeReader(iov.NewBufferReader(seg0, seg1, seg2, seg3), &v)
+    require.NoError(t, err)
+    require.Equal(t, len(seg0) + len(seg1) + len(seg2) + len(seg3), ret)
+    require.Equal(t, NoCopyReaderTest { A: "hello", B: []byte("world"), C: "foo" }, v)
+    require.Equal(t, unsafe.Pointer(&seg0[7]), *(*unsafe.Pointer)(unsafe.Pointer(&v.A)))
+    require.Equal(t, unsafe.Pointer(&seg3[3]), *(*unsafe.Pointer)(unsafe.Pointer(&v.C)))
+}
+
+func TestDecodeCompactReader(t *testing.T) {
+    var v, x baseline.Nesting2
+    var y baseline.Simple
+    loaddata(t, &v)
+    buf := make([]byte, frugal.CompactEncodedSize(&v))
+    _, err := frugal.EncodeCompact(buf, nil, &v)
+    require.NoError(t, err)
+    v = baseline.Nesting2{}
+    _, err = frugal.DecodeCompact(buf, &v)
+    require.NoError(t, err)
+    for _, n := range []int { 1, 2, 3, 7, 64, 1000, len(buf) } {
+        x = baseline.Nesting2{}
+        rd := iov.NewBufferReader(splitbuf(buf, n)...)
+        ret, err := frugal.DecodeCompactReader(rd, &x)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+        require.Equal(t, 0, rd.Len())
+        requireSameValue(t, &v, &x)
+        y = baseline.Simple{}
+        ret, err = frugal.DecodeCompactReader(iov.NewBufferReader(splitbuf(buf, n)...), &y)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+    }
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `encoding/json`
+    `expvar`
+    `os`
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/debug`
+    `github.com/stretchr/testify/require`
+)
+
+type StatsTestInner struct {
+    A string `frugal:"1,default,string"`
+}
+
+type StatsTestNode struct {
+    V     int64           `frugal:"1,default,i64"`
+    Next  *StatsTestNode  `frugal:"2,optional,StatsTestNode"`
+    Inner *StatsTestInner `frugal:"3,default,StatsTestInner"`
+}
+
+func findTypeStats(vt reflect.Type) (debug.TypeStats, bool) {
+    for _, v := range debug.GetTypeStats() {
+        if v.Type == vt {
+            return v, true
+        }
+    }
+    return debug.TypeStats{}, false
+}
+
+func TestTypeStats(t *testing.T) {
+    vt := reflect.TypeOf(StatsTestNode{})
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(0))
+    defer frugal.SetStatsSampleRate(frugal.SetStatsSampleRate(1))
+    frugal.Unload(vt)
+    _, ok := findTypeStats(vt)
+    require.False(t, ok)
+    v := &StatsTestNode { V: 1, Next: &StatsTestNode { V: 2, Inner: &StatsTestInner{} }, Inner: &StatsTestInner { A: "foo" } }
+    buf := make([]byte, frugal.EncodedSize(v))
+    for i := 0; i < 3; i++ {
+        var r StatsTestNode
+        _, err := frugal.EncodeObject(buf, nil, v)
+        require.NoError(t, err)
+        _, err = frugal.DecodeObject(buf, &r)
+        require.NoError(t, err)
+        require.Equal(t, v, &r)
+    }
+    st, ok := findTypeStats(vt)
+    require.True(t, ok)
+    for _, cs := range []debug.CodecStats { st.Encoder, st.Decoder } {
+        require.Greater(t, int64(cs.Frontend), int64(0))
+        require.Greater(t, int64(cs.Translate), int64(0))
+        require.Zero(t, cs.SSA)
+        require.Greater(t, cs.Inlined, 0)
+        require.Greater(t, cs.Deferred, 0)
+        require.Equal(t, 3, cs.Calls)
+        require.Equal(t, 3 * len(buf), cs.Bytes)
+        if os.Getenv("FRUGAL_BACKEND") == "emu" {
+            require.Zero(t, cs.Codegen)
+            require.Zero(t, cs.CodeSize)
+        } else {
+            require.Greater(t, int64(cs.Codegen), int64(0))
+            require.Greater(t, cs.CodeSize, 0)
+        }
+    }
+    require.True(t, frugal.Unload(vt))
+    _, ok = findTypeStats(vt)
+    require.False(t, ok)
+}
+
+func TestTypeStats_Publish(t *testing.T) {
+    if expvar.Get("frugal_test") == nil {
+        debug.Publish("frugal_test")
+    }
+    var v struct {
+        Stats debug.Stats
+        Types map[string]debug.TypeStats
+    }
+    _, err := frugal.EncodeObject(make([]byte, frugal.EncodedSize(StatsTestInner{})), nil, StatsTestInner{})
+    require.NoError(t, err)
+    require.NoError(t, json.Unmarshal([]byte(expvar.Get("frugal_test").String()), &v))
+    require.Greater(t, v.Stats.Encoder.Size, 0)
+    require.Contains(t, v.Types, "tests.StatsTestInner")
+    require.Greater(t, int64(v.Types["tests.StatsTestInner"].Encoder.Frontend), int64(0))
+}
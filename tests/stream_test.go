@@ -0,0 +1,183 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package tests
+
+import (
+    `bytes`
+    `errors`
+    `io`
+    `strconv`
+    `testing`
+    `testing/iotest`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+type StreamTestStruct struct {
+    L []*baseline.Simple `frugal:"1,default,list<Simple>"`
+    I []int64            `frugal:"2,default,list<i64>"`
+    S []string           `frugal:"3,default,list<string>"`
+    B []byte             `frugal:"4,default,binary"`
+}
+
+type StreamTestNesting struct {
+    L []*baseline.Nesting2 `frugal:"1,default,list<Nesting2>"`
+}
+
+type countingWriter struct {
+    bytes.Buffer
+    n int
+}
+
+func (self *countingWriter) Write(p []byte) (int, error) {
+    self.n++
+    return self.Buffer.Write(p)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+    return 0, errors.New("write failed")
+}
+
+func newStreamTestStruct(n int) *StreamTestStruct {
+    ret := &StreamTestStruct {
+        L: make([]*baseline.Simple, n),
+        I: make([]int64, n),
+        S: make([]string, n),
+        B: bytes.Repeat([]byte("frugal"), 50000),
+    }
+    for i := 0; i < n; i++ {
+        ret.I[i] = int64(i) * 12345
+        ret.S[i] = "str-" + strconv.Itoa(i)
+        ret.L[i] = &baseline.Simple {
+            ByteField   : int8(i),
+            I64Field    : int64(i),
+            DoubleField : float64(i) / 3,
+            I32Field    : int32(i),
+            StringField : "hello",
+            BinaryField : []byte("world"),
+        }
+    }
+    return ret
+}
+
+func TestEncoderStream(t *testing.T) {
+    v := newStreamTestStruct(100000)
+    exp := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(exp, nil, v)
+    require.NoError(t, err)
+    w := new(countingWriter)
+    enc := frugal.NewEncoder(w)
+    require.NoError(t, enc.Encode(v))
+    require.Greater(t, w.n, 1)
+    require.Equal(t, exp, w.Bytes())
+    require.NoError(t, enc.Encode(v))
+    require.Equal(t, append(exp, exp...), w.Bytes())
+}
+
+func TestEncoderStreamNesting(t *testing.T) {
+    var x, y StreamTestNesting
+    v := StreamTestNesting { L: make([]*baseline.Nesting2, 50) }
+    buf := loaddata(t, nil)
+    for i := range v.L {
+        v.L[i] = new(baseline.Nesting2)
+        _, err := frugal.DecodeObject(buf, v.L[i])
+        require.NoError(t, err)
+    }
+    w := new(countingWriter)
+    require.NoError(t, frugal.NewEncoder(w).Encode(&v))
+    require.Greater(t, w.n, 1)
+    require.Equal(t, frugal.EncodedSize(&v), w.Len())
+    buf = make([]byte, w.Len())
+    _, err := frugal.EncodeObject(buf, nil, &v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, &y)
+    require.NoError(t, err)
+    ret, err := frugal.DecodeObject(w.Bytes(), &x)
+    require.NoError(t, err)
+    require.Equal(t, w.Len(), ret)
+    requireSameValue(t, &y, &x)
+}
+
+func TestEncoderStreamError(t *testing.T) {
+    require.Error(t, frugal.NewEncoder(failingWriter{}).Encode(newStreamTestStruct(100000)))
+}
+
+func TestDecoderStream(t *testing.T) {
+    v := newStreamTestStruct(100000)
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    rd, wr := io.Pipe()
+    go func() {
+        for i := 0; i < 2; i++ {
+            for _, p := range splitbuf(buf, 4093) {
+                _, _ = wr.Write(p)
+            }
+        }
+        _ = wr.Close()
+    }()
+    dec := frugal.NewDecoder(rd)
+    for i := 0; i < 2; i++ {
+        var x StreamTestStruct
+        require.NoError(t, dec.Decode(&x))
+        require.Equal(t, v, &x)
+    }
+    require.Equal(t, 0, dec.Buffered())
+    require.Error(t, dec.Decode(new(StreamTestStruct)))
+}
+
+func TestDecoderStreamOneByte(t *testing.T) {
+    var v, x baseline.Nesting2
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &v)
+    require.NoError(t, err)
+    require.NoError(t, frugal.NewDecoder(iotest.OneByteReader(bytes.NewReader(buf))).Decode(&x))
+    requireSameValue(t, &v, &x)
+}
+
+func TestDecoderStreamSkip(t *testing.T) {
+    var v, x baseline.Simple
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &v)
+    require.NoError(t, err)
+    for _, rd := range []io.Reader { bytes.NewReader(buf), iotest.HalfReader(bytes.NewReader(buf)), iotest.OneByteReader(bytes.NewReader(buf)) } {
+        x = baseline.Simple{}
+        dec := frugal.NewDecoder(io.MultiReader(rd, bytes.NewReader([]byte("trailing"))))
+        require.NoError(t, dec.Decode(&x))
+        require.Equal(t, v, x)
+    }
+}
+
+func TestDecoderStreamEOF(t *testing.T) {
+    buf := loaddata(t, nil)
+    for _, n := range []int { 0, 1, len(buf) / 2, len(buf) - 1 } {
+        require.Error(t, frugal.NewDecoder(iotest.HalfReader(bytes.NewReader(buf[:n]))).Decode(new(baseline.Nesting2)))
+    }
+}
+
+func TestDecoderStreamReadError(t *testing.T) {
+    buf := loaddata(t, nil)
+    ef := errors.New("read failed")
+    rd := io.MultiReader(bytes.NewReader(buf[:100]), iotest.ErrReader(ef))
+    err := frugal.NewDecoder(rd).Decode(new(baseline.Nesting2))
+    require.ErrorIs(t, err, ef)
+}
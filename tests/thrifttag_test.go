@@ -0,0 +1,152 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+type ThriftTagSimple struct {
+    ByteField   int8           `thrift:"ByteField,1"`
+    I64Field    int64          `thrift:"I64Field,2"`
+    DoubleField float64        `thrift:"DoubleField,3"`
+    I32Field    int32          `thrift:"I32Field,4"`
+    StringField string         `thrift:"StringField,5"`
+    BinaryField []byte         `thrift:"BinaryField,6"`
+    EnumField   baseline.Enums `thrift:"enumField,7"`
+}
+
+type ThriftTagNesting struct {
+    String_         string                      `thrift:"String,1"`
+    ListSimple      []*ThriftTagSimple          `thrift:"ListSimple,2"`
+    Double          float64                     `thrift:"Double,3"`
+    I32             int32                       `thrift:"I32,4"`
+    ListI32         []int32                     `thrift:"ListI32,5"`
+    I64             int64                       `thrift:"I64,6"`
+    MapStringString map[string]string           `thrift:"MapStringString,7"`
+    SimpleStruct    *ThriftTagSimple            `thrift:"SimpleStruct,8"`
+    MapI32I64       map[int32]int64             `thrift:"MapI32I64,9"`
+    ListString      []string                    `thrift:"ListString,10"`
+    Binary          []byte                      `thrift:"Binary,11"`
+    MapI64String    map[int64]string            `thrift:"MapI64String,12"`
+    ListI64         []int64                     `thrift:"ListI64,13"`
+    Byte            int8                        `thrift:"Byte,14"`
+    MapStringSimple map[string]*ThriftTagSimple `thrift:"MapStringSimple,15"`
+}
+
+type ThriftTagOptional struct {
+    A *int64          `thrift:"A,1,optional"`
+    B string          `thrift:"B,2,required"`
+    C *baseline.Enums `thrift:"C,3,optional"`
+    D []int32         `frugal:"4,default,set<i32>" thrift:"D,4"`
+}
+
+type ThriftTagSet struct {
+    A []int32 `frugal:"1,default,set<i32>"`
+    B []int32 `frugal:"2,default,list<i32>"`
+}
+
+type ThriftTagSetInferred struct {
+    A []int32 `thrift:"A,1"`
+    B []int32 `thrift:"B,2"`
+}
+
+type ThriftTagSetList struct {
+    A []int32 `frugal:"1,default,list<i32>"`
+}
+
+type ThriftTagUntagged struct {
+    A int64
+    B string
+}
+
+func encodeObject(t *testing.T, v interface{}) []byte {
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    return buf
+}
+
+func TestThriftTag(t *testing.T) {
+    for _, c := range []struct{ src interface{}; dst reflect.Type } {
+        { src: new(baseline.Simple) , dst: reflect.TypeOf(ThriftTagSimple{}) },
+        { src: new(baseline.Nesting), dst: reflect.TypeOf(ThriftTagNesting{}) },
+    } {
+        GenValue(c.src)
+        buf := make([]byte, frugal.EncodedSize(c.src))
+        _, err := frugal.EncodeObject(buf, nil, c.src)
+        require.NoError(t, err)
+        exp := reflect.New(reflect.TypeOf(c.src).Elem())
+        _, err = frugal.DecodeObject(buf, exp.Interface())
+        require.NoError(t, err)
+        val := reflect.New(c.dst)
+        _, err = frugal.DecodeObject(buf, val.Interface())
+        require.NoError(t, err)
+        require.Equal(t, encodeObject(t, exp.Interface()), encodeObject(t, val.Interface()))
+    }
+}
+
+func TestThriftTag_Optional(t *testing.T) {
+    var r ThriftTagOptional
+    a, c := int64(1), baseline.Enums(2)
+    v := ThriftTagOptional { A: &a, B: "foo", C: &c, D: []int32 { 3 } }
+    buf := make([]byte, frugal.EncodedSize(v))
+    _, err := frugal.EncodeObject(buf, nil, v)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(buf, &r)
+    require.NoError(t, err)
+    require.Equal(t, v, r)
+    buf = make([]byte, frugal.EncodedSize(ThriftTagOptional{}))
+    _, err = frugal.DecodeObject(buf, &r)
+    require.Error(t, err)
+}
+
+func TestThriftTag_Set(t *testing.T) {
+    v := ThriftTagSet { A: []int32 { 1, 2 }, B: []int32 { 3 } }
+    exp := ThriftTagSetInferred { A: v.A, B: v.B }
+    buf := encodeObject(t, v)
+    cbuf := make([]byte, frugal.CompactEncodedSize(v))
+    _, err := frugal.EncodeCompact(cbuf, nil, v)
+    require.NoError(t, err)
+    var r ThriftTagSetInferred
+    _, err = frugal.DecodeObject(buf, &r)
+    require.NoError(t, err)
+    require.Equal(t, exp, r)
+    r = ThriftTagSetInferred{}
+    _, err = frugal.DecodeCompact(cbuf, &r)
+    require.NoError(t, err)
+    require.Equal(t, exp, r)
+    var x ThriftTagSetList
+    _, err = frugal.DecodeObject(buf, &x)
+    require.NoError(t, err)
+    require.Nil(t, x.A)
+    _, err = frugal.DecodeCompact(cbuf, &x)
+    require.NoError(t, err)
+    require.Nil(t, x.A)
+}
+
+func TestThriftTag_Untagged(t *testing.T) {
+    _, err := frugal.EncodeObject(nil, nil, ThriftTagUntagged{})
+    require.Error(t, err)
+    _, err = frugal.DecodeObject(nil, new(ThriftTagUntagged))
+    require.Error(t, err)
+}
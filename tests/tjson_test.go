@@ -0,0 +1,187 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `math`
+    `context`
+    `testing`
+
+    `github.com/apache/thrift/lib/go/thrift`
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+func writeTJSON(t *testing.T, v thrift.TStruct) []byte {
+    mm := thrift.NewTMemoryBuffer()
+    pp := thrift.NewTJSONProtocol(mm)
+    require.NoError(t, v.Write(pp))
+    require.NoError(t, pp.Flush(context.Background()))
+    return mm.Bytes()
+}
+
+func readTJSON(t *testing.T, buf []byte, v thrift.TStruct) {
+    mm := thrift.NewTMemoryBuffer()
+    _, _ = mm.Write(buf)
+    require.NoError(t, v.Read(thrift.NewTJSONProtocol(mm)))
+}
+
+func TestTJSON_Apache(t *testing.T) {
+    for i := 0; i < 100; i++ {
+        var v0 baseline.Nesting
+        var v1 baseline.Nesting
+        var v2 baseline.Nesting
+        GenValue(&v0)
+
+        /* normalize the value with Apache Thrift */
+        js := writeTJSON(t, &v0)
+        readTJSON(t, js, &v1)
+
+        /* Apache Thrift -> frugal */
+        require.NoError(t, frugal.DecodeTJSON(js, &v2))
+        require.Equal(t, v1, v2)
+
+        /* frugal -> Apache Thrift, which must be exactly the same */
+        out, err := frugal.EncodeTJSON(nil, &v1)
+        require.NoError(t, err)
+        require.Equal(t, string(writeTJSON(t, &v1)), string(out))
+    }
+}
+
+type TJSONSpecial struct {
+    Bool    bool               `frugal:"1,default,bool"`
+    Doubles []float64          `frugal:"2,default,set<double>"`
+    Keys    map[bool]float64   `frugal:"3,default,map<bool:double>"`
+    Values  map[float64][]byte `frugal:"4,default,map<double:binary>"`
+}
+
+func TestTJSON_Special(t *testing.T) {
+    var r TJSONSpecial
+    v := TJSONSpecial {
+        Bool    : true,
+        Doubles : []float64 { math.Inf(1), math.Inf(-1), 0.5 },
+        Keys    : map[bool]float64 { false: math.Inf(1) },
+        Values  : map[float64][]byte { math.Inf(-1): { 1, 2 } },
+    }
+    js := `{"1":{"tf":1},"2":{"set":["dbl",3,"Infinity","-Infinity",0.5]},` +
+        `"3":{"map":["tf","dbl",1,{"0":"Infinity"}]},"4":{"map":["dbl","str",1,{"-Infinity":"AQI="}]}}`
+    out, err := frugal.EncodeTJSON(nil, v)
+    require.NoError(t, err)
+    require.Equal(t, js, string(out))
+    require.NoError(t, frugal.DecodeTJSON(out, &r))
+    require.Equal(t, v, r)
+
+    /* unpadded binaries and quoted doubles are accepted as well */
+    r = TJSONSpecial{}
+    require.NoError(t, frugal.DecodeTJSON([]byte(`{"2":{"set":["dbl",1,"1.5"]},"4":{"map":["dbl","str",1,{"2":"AQI"}]}}`), &r))
+    require.Equal(t, TJSONSpecial { Doubles: []float64 { 1.5 }, Values: map[float64][]byte { 2: { 1, 2 } } }, r)
+
+    /* NaN is not equal to itself, check it separately */
+    out, err = frugal.EncodeTJSON(nil, TJSONSpecial { Doubles: []float64 { math.NaN() } })
+    require.NoError(t, err)
+    require.Contains(t, string(out), `["dbl",1,"NaN"]`)
+    require.NoError(t, frugal.DecodeTJSON(out, &r))
+    require.True(t, math.IsNaN(r.Doubles[0]))
+}
+
+func TestTJSON_Errors(t *testing.T) {
+    var r TJSONSpecial
+    require.EqualError(t, frugal.DecodeTJSON([]byte(`{"2":{"set":["i32",0]}}`), &r), "frugal: type mismatch: 4 expected, got 8")
+    require.EqualError(t, frugal.DecodeTJSON([]byte(`{"1":{"tf":2}}`), &r), "frugal: invalid JSON at offset 11 (near '2'): invalid bool value")
+    require.EqualError(t, frugal.DecodeTJSON([]byte(`{"1":{"xx":1}}`), &r), `frugal: invalid JSON at offset 10 (near ':'): invalid type name "xx"`)
+    require.EqualError(t, frugal.DecodeTJSON([]byte(`{"2":{"set":["dbl",2,1]}}`), &r), "frugal: invalid JSON at offset 22 (near ']'): ',' expected")
+    _, err := frugal.EncodeTJSON(nil, &baseline.Nesting2 { MapSimpleNesting: map[*baseline.Simple]*baseline.Nesting {{}: {}} })
+    require.EqualError(t, err, "frugal: TJSON does not support map keys of type baseline.Simple")
+
+    /* unknown fields and fields with mismatched types are skipped */
+    require.NoError(t, frugal.DecodeTJSON([]byte(`{"9":{"lst":["i32",1,2]},"1":{"i32":1}}`), &r))
+}
+
+func writeSimpleJSON(t *testing.T, v thrift.TStruct) []byte {
+    mm := thrift.NewTMemoryBuffer()
+    pp := thrift.NewTSimpleJSONProtocol(mm)
+    require.NoError(t, v.Write(pp))
+    require.NoError(t, pp.Flush(context.Background()))
+    return mm.Bytes()
+}
+
+func roundSimpleJSON(t *testing.T, v *baseline.Nesting2) *baseline.Nesting2 {
+    r := new(baseline.Nesting2)
+    out, err := frugal.EncodeSimpleJSON(nil, v)
+    require.NoError(t, err)
+    require.NoError(t, frugal.DecodeSimpleJSON(out, r))
+    return r
+}
+
+func TestSimpleJSON(t *testing.T) {
+    var r0 baseline.Nesting2
+    loaddata(t, &r0)
+
+    /* nil structs are written as empty objects, and their fields are only filled
+     * with zero values on the next round trip, normalize the value first */
+    r1 := roundSimpleJSON(t, roundSimpleJSON(t, &r0))
+    r2 := roundSimpleJSON(t, r1)
+    require.Equal(t, dumpval(r1), dumpval(r2))
+
+    /* containers are prefixed with the type IDs, and special doubles are strings */
+    v := TJSONSpecial { Doubles: []float64 { math.Inf(1) }, Keys: map[bool]float64 { true: math.Inf(-1) } }
+    out, err := frugal.EncodeSimpleJSON(nil, v)
+    require.NoError(t, err)
+    require.Equal(t, `{"Bool":false,"Doubles":[4,1,"Infinity"],"Keys":[2,4,1,true,"-Infinity"],"Values":[4,11,0]}`, string(out))
+    s := TJSONSpecial{}
+    require.NoError(t, frugal.DecodeSimpleJSON(out, &s))
+    require.Equal(t, TJSONSpecial { Doubles: v.Doubles, Keys: v.Keys, Values: map[float64][]byte{} }, s)
+}
+
+func TestSimpleJSON_Apache(t *testing.T) {
+    for i := 0; i < 100; i++ {
+        var v0 baseline.Nesting
+        var v1 baseline.Nesting
+        var v2 baseline.Nesting
+        GenValue(&v0)
+
+        /* nil structs are written as empty objects, normalize the value first */
+        require.NoError(t, frugal.DecodeSimpleJSON(writeSimpleJSON(t, &v0), &v1))
+        js := writeSimpleJSON(t, &v1)
+
+        /* Apache Thrift -> frugal, Apache Thrift can not read it back since
+         * the field IDs are lost, so it must survive the round trip instead */
+        require.NoError(t, frugal.DecodeSimpleJSON(js, &v2))
+        require.Equal(t, string(js), string(writeSimpleJSON(t, &v2)))
+
+        /* frugal -> Apache Thrift, which must be exactly the same */
+        out, err := frugal.EncodeSimpleJSON(nil, &v2)
+        require.NoError(t, err)
+        require.Equal(t, string(js), string(out))
+    }
+
+    /* struct keys are written as objects */
+    v := &baseline.Nesting2 { MapSimpleNesting: map[*baseline.Simple]*baseline.Nesting {{ I64Field: 1 }: {}} }
+    out, err := frugal.EncodeSimpleJSON(nil, v)
+    require.NoError(t, err)
+    require.Equal(t, string(writeSimpleJSON(t, v)), string(out))
+}
+
+func TestSimpleJSON_Large(t *testing.T) {
+    var r StreamTestStruct
+    v := newStreamTestStruct(100000)
+    out, err := frugal.EncodeSimpleJSON(nil, v)
+    require.NoError(t, err)
+    require.NoError(t, frugal.DecodeSimpleJSON(out, &r))
+    require.Equal(t, v, &r)
+}
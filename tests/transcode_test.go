@@ -0,0 +1,140 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `encoding/json`
+    `math`
+    `reflect`
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+type TranscodeKeys struct {
+    Bools   map[bool]int8                   `frugal:"1,default,map<bool:i8>"`
+    Doubles map[float64]string              `frugal:"2,default,map<double:string>"`
+    Binary  map[string][]byte               `frugal:"3,default,map<string:binary>"`
+    Structs map[*baseline.Simple]int64      `frugal:"4,default,map<Simple:i64>"`
+    Enums   map[baseline.Enums]uint32       `frugal:"5,default,map<Enums:i32>"`
+    Fixed   [4]byte                         `frugal:"6,default,binary"`
+    Array   [2]uint8                        `frugal:"7,default,list<i8>"`
+}
+
+type TranscodeRequired struct {
+    A int32  `frugal:"1,required,i32"`
+    B string `frugal:"2,optional,string"`
+}
+
+func TestTranscode_RoundTrip(t *testing.T) {
+    var v1 baseline.Nesting2
+    var v2 baseline.Nesting2
+    buf := loaddata(t, &v1)
+    js, nb, err := frugal.BinaryToJSON(nil, reflect.TypeOf(v1), buf)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), nb)
+    require.True(t, json.Valid(js))
+    out, err := frugal.JSONToBinary(nil, reflect.TypeOf(v1), js)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(out, &v2)
+    require.NoError(t, err)
+    require.Equal(t, dumpval(v1), dumpval(v2))
+}
+
+func TestTranscode_Values(t *testing.T) {
+    var js map[string]interface{}
+    v := &baseline.Simple {
+        ByteField   : -1,
+        I64Field    : math.MaxInt64,
+        DoubleField : 1.5,
+        StringField : "a\"b\x01\xff",
+        BinaryField : []byte { 1, 2, 3 },
+        EnumField   : baseline.Enums_ValueC,
+    }
+    out, _, err := frugal.BinaryToJSON([]byte("prefix:"), reflect.TypeOf(v), encodeObject(t, v))
+    require.NoError(t, err)
+    require.Equal(t, `prefix:{"ByteField":-1,"I64Field":9223372036854775807,"DoubleField":1.5,"I32Field":0,"StringField":"a\"b\u0001\ufffd","BinaryField":"AQID","EnumField":2}`, string(out))
+    require.NoError(t, json.Unmarshal(out[7:], &js))
+    require.Equal(t, "AQID", js["BinaryField"])
+}
+
+func TestTranscode_MapKeys(t *testing.T) {
+    v := &TranscodeKeys {
+        Bools   : map[bool]int8 { true: 1 },
+        Doubles : map[float64]string { 0.5: "x" },
+        Binary  : map[string][]byte { "k": { 0xff } },
+        Structs : map[*baseline.Simple]int64 {{ I32Field: 7 }: 8},
+        Enums   : map[baseline.Enums]uint32 { baseline.Enums_ValueB: math.MaxInt32 },
+        Fixed   : [4]byte { 1, 2, 3, 4 },
+        Array   : [2]uint8 { 127, 1 },
+    }
+    js, _, err := frugal.BinaryToJSON(nil, reflect.TypeOf(v), encodeObject(t, v))
+    require.NoError(t, err)
+    require.Equal(t, `{"Bools":{"true":1},"Doubles":{"0.5":"x"},"Binary":{"k":"/w=="},` +
+        `"Structs":[[{"ByteField":0,"I64Field":0,"DoubleField":0,"I32Field":7,"StringField":"","BinaryField":"","EnumField":0},8]],` +
+        `"Enums":{"1":2147483647},"Fixed":"AQIDBA==","Array":[127,1]}`, string(js))
+
+    /* transcode it back, and compare with the decoded original */
+    r1 := new(TranscodeKeys)
+    r2 := new(TranscodeKeys)
+    out, err := frugal.JSONToBinary(nil, reflect.TypeOf(v), js)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(out, r1)
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(encodeObject(t, v), r2)
+    require.NoError(t, err)
+    require.Equal(t, dumpval(r2), dumpval(r1))
+}
+
+func TestTranscode_FromJSON(t *testing.T) {
+    r := new(baseline.Simple)
+    js := ` { "I32Field" : 42, "Unknown": [{"x": [1, "é"]}], "StringField": "é😀\n", "BinaryField": null } `
+    out, err := frugal.JSONToBinary(nil, reflect.TypeOf(r), []byte(js))
+    require.NoError(t, err)
+    _, err = frugal.DecodeObject(out, r)
+    require.NoError(t, err)
+    require.Equal(t, &baseline.Simple { I32Field: 42, StringField: "é\U0001F600\n" }, r)
+}
+
+func TestTranscode_Errors(t *testing.T) {
+    vt := reflect.TypeOf(TranscodeRequired{})
+    _, err := frugal.JSONToBinary(nil, vt, []byte(`{"B": "x"}`))
+    require.EqualError(t, err, "frugal: missing required field 1 for type tests.TranscodeRequired")
+    _, _, err = frugal.BinaryToJSON(nil, vt, []byte { 11, 0, 2, 0, 0, 0, 0, 0 })
+    require.EqualError(t, err, "frugal: missing required field 1 for type tests.TranscodeRequired")
+    _, _, err = frugal.BinaryToJSON(nil, vt, []byte { 8, 0, 1, 0, 0 })
+    require.EqualError(t, err, "frugal: unexpected EOF: 2 bytes short")
+    _, err = frugal.JSONToBinary(nil, vt, []byte(`{"A": 2147483648}`))
+    require.EqualError(t, err, `frugal: invalid JSON at offset 6 (near '2'): value out of range`)
+    _, err = frugal.JSONToBinary(nil, vt, []byte(`{"A": 1,}`))
+    require.EqualError(t, err, `frugal: invalid JSON at offset 8 (near '}'): '"' expected`)
+    _, err = frugal.JSONToBinary(nil, vt, []byte(`{"A": 1} x`))
+    require.EqualError(t, err, `frugal: invalid JSON at offset 9 (near 'x'): trailing characters after the value`)
+
+    /* NaN cannot be represented */
+    v := &baseline.Simple { DoubleField: math.NaN() }
+    _, _, err = frugal.BinaryToJSON(nil, reflect.TypeOf(v), encodeObject(t, v))
+    require.EqualError(t, err, "frugal: unsupported value: NaN")
+
+    /* arrays must have the exact length */
+    _, err = frugal.JSONToBinary(nil, reflect.TypeOf(TranscodeKeys{}), []byte(`{"Array": [1]}`))
+    require.EqualError(t, err, "frugal: length mismatch for [2]uint8: expected 2, got 1")
+    _, err = frugal.JSONToBinary(nil, reflect.TypeOf(TranscodeKeys{}), []byte(`{"Array": [1, 128]}`))
+    require.EqualError(t, err, `frugal: invalid JSON at offset 14 (near '1'): value out of range`)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `testing`
+
+    `github.com/cloudwego/frugal`
+    `github.com/stretchr/testify/require`
+)
+
+type UnionTestInner struct {
+    A int64 `frugal:"1,default,i64"`
+}
+
+type UnionTestStruct struct {
+    _ struct{}         `frugal:"_union"`
+    I *int64           `frugal:"1,optional,i64"`
+    S *string          `frugal:"2,optional,string"`
+    B []byte           `frugal:"3,optional,binary"`
+    L []int32          `frugal:"4,optional,list<i32>"`
+    M map[string]int32 `frugal:"5,optional,map<string:i32>"`
+    T *UnionTestInner  `frugal:"6,optional,UnionTestInner"`
+}
+
+type UnionTestPlain struct {
+    I *int64  `frugal:"1,optional,i64"`
+    S *string `frugal:"2,optional,string"`
+}
+
+type UnionTestInvalid struct {
+    _ struct{} `frugal:"_union"`
+    I int64    `frugal:"1,default,i64"`
+}
+
+type UnionTestNesting struct {
+    U []*UnionTestStruct `frugal:"1,default,list<UnionTestStruct>"`
+}
+
+func encodeUnionTest(t *testing.T, v interface{}, compact bool) []byte {
+    if compact {
+        buf := make([]byte, frugal.CompactEncodedSize(v))
+        ret, err := frugal.EncodeCompact(buf, nil, v)
+        require.NoError(t, err)
+        return buf[:ret]
+    } else {
+        buf := make([]byte, frugal.EncodedSize(v))
+        ret, err := frugal.EncodeObject(buf, nil, v)
+        require.NoError(t, err)
+        return buf[:ret]
+    }
+}
+
+func decodeUnionTest(buf []byte, v interface{}, compact bool) error {
+    var err error
+    if compact {
+        _, err = frugal.DecodeCompact(buf, v)
+    } else {
+        _, err = frugal.DecodeObject(buf, v)
+    }
+    return err
+}
+
+func TestUnion(t *testing.T) {
+    i, s := int64(12345), "hello"
+    for _, compact := range []bool { false, true } {
+        for _, v := range []*UnionTestStruct {
+            { I: &i },
+            { S: &s },
+            { B: []byte("world") },
+            { B: []byte{} },
+            { L: []int32 { 1, 2, 3 } },
+            { M: map[string]int32 { "foo": 1 } },
+            { T: &UnionTestInner { A: 100 } },
+        } {
+            x := new(UnionTestStruct)
+            require.NoError(t, decodeUnionTest(encodeUnionTest(t, v, compact), x, compact))
+            require.Equal(t, v, x)
+        }
+    }
+}
+
+func TestUnionBinaryOmitted(t *testing.T) {
+    i := int64(1)
+    buf := encodeUnionTest(t, &UnionTestStruct { I: &i }, false)
+    require.Equal(t, []byte { 0x0a, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 0 }, buf)
+}
+
+func TestUnionEncodeInvalid(t *testing.T) {
+    i, s := int64(1), "foo"
+    for _, v := range []*UnionTestStruct { {}, { I: &i, S: &s }, { B: []byte("bar"), T: new(UnionTestInner) } } {
+        _, err := frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+        require.Error(t, err)
+        _, err = frugal.EncodeCompact(make([]byte, frugal.CompactEncodedSize(v)), nil, v)
+        require.Error(t, err)
+    }
+    v := &UnionTestNesting { U: []*UnionTestStruct { { I: &i }, {} } }
+    _, err := frugal.EncodeObject(make([]byte, frugal.EncodedSize(v)), nil, v)
+    require.Error(t, err)
+}
+
+func TestUnionDecodeMultiple(t *testing.T) {
+    i, s := int64(1), "foo"
+    for _, compact := range []bool { false, true } {
+        buf := encodeUnionTest(t, &UnionTestPlain { I: &i, S: &s }, compact)
+        err := decodeUnionTest(buf, new(UnionTestStruct), compact)
+        require.Error(t, err)
+        require.Contains(t, err.Error(), "multiple fields set for union")
+    }
+}
+
+func TestUnionDecodeReuse(t *testing.T) {
+    i, s := int64(1), "foo"
+    for _, compact := range []bool { false, true } {
+        x := &UnionTestStruct { I: &i, L: []int32 { 1 }, M: map[string]int32 { "a": 1 } }
+        require.NoError(t, decodeUnionTest(encodeUnionTest(t, &UnionTestStruct { S: &s }, compact), x, compact))
+        require.Equal(t, &UnionTestStruct { S: &s }, x)
+    }
+}
+
+func TestUnionDecodeNesting(t *testing.T) {
+    i, s := int64(1), "foo"
+    v := &UnionTestNesting { U: []*UnionTestStruct { { I: &i }, { S: &s }, { B: []byte("bar") } } }
+    x := new(UnionTestNesting)
+    require.NoError(t, decodeUnionTest(encodeUnionTest(t, v, false), x, false))
+    require.Equal(t, v, x)
+}
+
+func TestUnionInvalid(t *testing.T) {
+    _, err := frugal.DecodeObject([]byte { 0 }, new(UnionTestInvalid))
+    require.Error(t, err)
+}
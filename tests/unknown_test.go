@@ -0,0 +1,113 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `bytes`
+    `testing`
+    `testing/iotest`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/iov`
+    `github.com/cloudwego/frugal/testdata/kitex_gen/baseline`
+    `github.com/stretchr/testify/require`
+)
+
+type UnknownFieldsTest struct {
+    Byte           int8   `frugal:"3,default,byte"`
+    I64            int64  `frugal:"6,default,i64"`
+    String_        string `frugal:"9,default,string"`
+    _unknownFields []byte
+}
+
+type UnknownFieldsTagged struct {
+    Unknown []byte `frugal:"_unknown"`
+}
+
+type UnknownFieldsInvalid struct {
+    _unknownFields string
+}
+
+func TestUnknownFields(t *testing.T) {
+    var v, x baseline.Nesting2
+    var u UnknownFieldsTest
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &v)
+    require.NoError(t, err)
+    ret, err := frugal.DecodeObject(buf, &u)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    require.Equal(t, v.Byte, u.Byte)
+    require.Equal(t, v.I64, u.I64)
+    require.Equal(t, v.String_, u.String_)
+    require.NotEmpty(t, u._unknownFields)
+    out := make([]byte, frugal.EncodedSize(&u))
+    ret, err = frugal.EncodeObject(out, nil, &u)
+    require.NoError(t, err)
+    require.Equal(t, len(buf), ret)
+    _, err = frugal.DecodeObject(out, &x)
+    require.NoError(t, err)
+    requireSameValue(t, &v, &x)
+}
+
+func TestUnknownFieldsReuse(t *testing.T) {
+    var u UnknownFieldsTest
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &u)
+    require.NoError(t, err)
+    exp := append([]byte(nil), u._unknownFields...)
+    _, err = frugal.DecodeObject(buf, &u)
+    require.NoError(t, err)
+    require.Equal(t, exp, u._unknownFields)
+}
+
+func TestUnknownFieldsTagged(t *testing.T) {
+    var u UnknownFieldsTagged
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, &u)
+    require.NoError(t, err)
+    require.Equal(t, buf[:len(buf) - 1], u.Unknown)
+    out := make([]byte, frugal.EncodedSize(&u))
+    _, err = frugal.EncodeObject(out, nil, &u)
+    require.NoError(t, err)
+    require.Equal(t, buf, out)
+}
+
+func TestUnknownFieldsReader(t *testing.T) {
+    buf := loaddata(t, nil)
+    for _, n := range []int { 1, 2, 7, 64, 1000, len(buf) } {
+        var u UnknownFieldsTagged
+        rd := iov.NewBufferReader(append(splitbuf(buf, n), []byte("trailing"))...)
+        ret, err := frugal.DecodeReader(rd, &u)
+        require.NoError(t, err)
+        require.Equal(t, len(buf), ret)
+        require.Equal(t, buf[:len(buf) - 1], u.Unknown)
+    }
+}
+
+func TestUnknownFieldsStream(t *testing.T) {
+    var u UnknownFieldsTagged
+    buf := loaddata(t, nil)
+    require.NoError(t, frugal.NewDecoder(iotest.OneByteReader(bytes.NewReader(buf))).Decode(&u))
+    require.Equal(t, buf[:len(buf) - 1], u.Unknown)
+}
+
+func TestUnknownFieldsInvalid(t *testing.T) {
+    buf := loaddata(t, nil)
+    _, err := frugal.DecodeObject(buf, new(UnknownFieldsInvalid))
+    require.Error(t, err)
+}
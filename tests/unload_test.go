@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+    `fmt`
+    `os`
+    `reflect`
+    `runtime`
+    `testing`
+    `time`
+
+    `github.com/cloudwego/frugal`
+    `github.com/cloudwego/frugal/debug`
+    `github.com/stretchr/testify/require`
+)
+
+func mkUnloadTestType(i int) reflect.Type {
+    return reflect.StructOf([]reflect.StructField {
+        { Name: "A", Type: reflect.TypeOf(int64(0)), Tag: reflect.StructTag(fmt.Sprintf(`frugal:"%d,default,i64"`, i + 1)) },
+        { Name: "B", Type: reflect.TypeOf(""), Tag: `frugal:"1000,optional,string"` },
+    })
+}
+
+func roundTripUnloadTestType(t *testing.T, vt reflect.Type, i int) {
+    v := reflect.New(vt)
+    v.Elem().Field(0).SetInt(int64(i))
+    v.Elem().Field(1).SetString("foo")
+    buf := make([]byte, frugal.EncodedSize(v.Interface()))
+    _, err := frugal.EncodeObject(buf, nil, v.Interface())
+    require.NoError(t, err)
+    r := reflect.New(vt)
+    _, err = frugal.DecodeObject(buf, r.Interface())
+    require.NoError(t, err)
+    require.Equal(t, v.Interface(), r.Interface())
+}
+
+func TestUnload(t *testing.T) {
+    if os.Getenv("FRUGAL_BACKEND") == "emu" {
+        t.Skip("nothing is compiled by the emulator")
+    }
+    defer frugal.SetAsyncCompileWorkers(frugal.SetAsyncCompileWorkers(0))
+    s0 := debug.GetStats()
+    vts := make([]reflect.Type, 100)
+    for i := range vts {
+        vts[i] = mkUnloadTestType(i)
+        roundTripUnloadTestType(t, vts[i], i)
+    }
+    s1 := debug.GetStats()
+    require.Equal(t, s0.Encoder.Size + len(vts), s1.Encoder.Size)
+    require.Equal(t, s0.Decoder.Size + len(vts), s1.Decoder.Size)
+    require.Greater(t, s1.Memory.Count, s0.Memory.Count)
+    for _, vt := range vts {
+        require.True(t, frugal.Unload(vt))
+        require.False(t, frugal.Unload(vt))
+    }
+    s2 := debug.GetStats()
+    require.Equal(t, s0.Encoder.Size, s2.Encoder.Size)
+    require.Equal(t, s0.Decoder.Size, s2.Decoder.Size)
+    for end := time.Now().Add(10 * time.Second); s2.Memory.Count > s0.Memory.Count; s2 = debug.GetStats() {
+        require.True(t, time.Now().Before(end), "machine code is not reclaimed after 10 seconds")
+        runtime.GC()
+        time.Sleep(time.Millisecond)
+    }
+    require.LessOrEqual(t, s2.Memory.Alloc, s0.Memory.Alloc)
+    roundTripUnloadTestType(t, vts[0], 0)
+    require.True(t, frugal.Unload(vts[0]))
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `sync`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/binary/transcoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+/* The JSON protocols are transcoded from and into Binary Protocol, with the same compiled
+ * encoders and decoders, and the same type metadata. */
+
+const (
+    _ScratchSize = 4096
+    _ScratchMax  = 1 << 20
+)
+
+/* objects are encoded into pooled buffers in a single pass, which are only kept if not too large */
+var scratchPool = sync.Pool {
+    New: func() interface{} {
+        return encoder.NewBuffer(_ScratchSize)
+    },
+}
+
+func encodeJSONWith(fn func([]byte, *rt.GoType, []byte) ([]byte, int, error), buf []byte, val interface{}) ([]byte, error) {
+    var err error
+    var tmp = scratchPool.Get().(*encoder.Stream)
+
+    /* encode with Binary Protocol first, then transcode into JSON */
+    if _, err = tmp.Encode(val); err == nil {
+        buf, _, err = fn(buf, rt.Dereference(rt.UnpackEface(val).Type), tmp.Bytes())
+    }
+
+    /* return the buffer into pool */
+    if tmp.Cap() <= _ScratchMax {
+        scratchPool.Put(tmp)
+    }
+
+    /* all done */
+    return buf, err
+}
+
+func decodeJSONWith(fn func([]byte, *rt.GoType, []byte) ([]byte, error), buf []byte, val interface{}) error {
+    if tmp, err := fn(nil, rt.Dereference(rt.UnpackEface(val).Type), buf); err != nil {
+        return err
+    } else if _, err = decoder.DecodeObject(tmp, val); err != nil {
+        return err
+    } else {
+        return nil
+    }
+}
+
+// EncodeTJSON serializes val with the TJSONProtocol of Apache Thrift, and appends the result to buf.
+func EncodeTJSON(buf []byte, val interface{}) ([]byte, error) {
+    return encodeJSONWith(transcoder.ToTJSON, buf, val)
+}
+
+// DecodeTJSON deserializes buf into val with the TJSONProtocol of Apache Thrift, buf must contain
+// exactly one struct.
+func DecodeTJSON(buf []byte, val interface{}) error {
+    return decodeJSONWith(transcoder.FromTJSON, buf, val)
+}
+
+// EncodeSimpleJSON serializes val with the TSimpleJSONProtocol of Apache Thrift, and appends the
+// result to buf. Fields are named after the "thrift" tags like Apache Thrift, or the Go struct
+// fields if there are none, and containers are flat arrays led by the element type IDs.
+func EncodeSimpleJSON(buf []byte, val interface{}) ([]byte, error) {
+    return encodeJSONWith(transcoder.ToSimpleJSON, buf, val)
+}
+
+// DecodeSimpleJSON deserializes buf into val with the TSimpleJSONProtocol of Apache Thrift, buf
+// must contain exactly one struct.
+func DecodeSimpleJSON(buf []byte, val interface{}) error {
+    return decodeJSONWith(transcoder.FromSimpleJSON, buf, val)
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `reflect`
+
+    `github.com/cloudwego/frugal/internal/binary/transcoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+// BinaryToJSON transcodes a struct of type vt from Thrift Binary Protocol in buf into JSON, without
+// decoding it into a Go object. The JSON is appended to dst, and the extended buffer is returned,
+// along with the number of bytes consumed from buf.
+//
+// Fields are named after the Go struct fields, enums are numbers, binaries are Base64-encoded strings,
+// and maps with struct keys are arrays of [key, value] pairs. Unknown fields are skipped.
+func BinaryToJSON(dst []byte, vt reflect.Type, buf []byte) ([]byte, int, error) {
+    return transcoder.ToJSON(dst, rt.Dereference(rt.UnpackType(vt)), buf)
+}
+
+// JSONToBinary is the inverse of BinaryToJSON, it transcodes a struct of type vt from JSON in src into
+// Thrift Binary Protocol, which is appended to dst. Unknown keys are ignored, and null values are
+// treated as absent fields.
+func JSONToBinary(dst []byte, vt reflect.Type, src []byte) ([]byte, error) {
+    return transcoder.FromJSON(dst, rt.Dereference(rt.UnpackType(vt)), src)
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 ByteDance Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package frugal
+
+import (
+    `reflect`
+
+    `github.com/cloudwego/frugal/internal/binary/decoder`
+    `github.com/cloudwego/frugal/internal/binary/encoder`
+    `github.com/cloudwego/frugal/internal/binary/transcoder`
+    `github.com/cloudwego/frugal/internal/rt`
+)
+
+// Unload removes the compiled encoders, decoders and transcoders of vt, so that the memory
+// used by them can be reclaimed, and returns whether vt has been compiled.
+//
+// The machine code is released by the garbage collector once no goroutine is
+// running it anymore. Types referenced by vt are not unloaded, they should be
+// unloaded separately if they are no longer used as well.
+//
+// vt is compiled again if it's used after being unloaded. This is useful for
+// services that create types dynamically (e.g. with reflect.StructOf), which
+// would otherwise keep growing the memory used by the JIT compiler.
+func Unload(vt reflect.Type) bool {
+    t := rt.Dereference(rt.UnpackType(vt))
+    d := decoder.Unload(t)
+    e := encoder.Unload(t)
+    j := transcoder.Unload(t)
+    return d || e || j
+}